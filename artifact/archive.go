@@ -0,0 +1,266 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Archive formats ...
+const (
+	Tar   = "tar"
+	TarGz = "tar.gz"
+	Zip   = "zip"
+)
+
+// UnsafeEntryError is returned for archives with entries that would be
+// extracted outside of the destination directory.
+type UnsafeEntryError struct {
+	Archive Artifact
+	Entry   string
+	Reason  string
+}
+
+func (e *UnsafeEntryError) Error() string {
+	return fmt.Sprintf("refusing to extract %s: entry %q %s", e.Archive.URL, e.Entry, e.Reason)
+}
+
+// MissingContentsError is returned when an extracted archive does not
+// contain the files the manifest expects.
+type MissingContentsError struct {
+	Archive Artifact
+	Missing []Artifact
+}
+
+func (e *MissingContentsError) Error() string {
+	var files []string
+	for _, c := range e.Missing {
+		files = append(files, fmt.Sprintf("%s %s", c.Kind, c.Path))
+	}
+	where := ""
+	if e.Archive.Dir != "" {
+		where = " below " + e.Archive.Dir
+	}
+	return fmt.Sprintf("archive %s does not contain%s the expected %s", e.Archive.URL, where, strings.Join(files, ", "))
+}
+
+// ArchiveFormat detects the format of the archive at file from its content,
+// falling back to its extension.
+func ArchiveFormat(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", errors.Wrapf(err, "cannot open the archive %s", file)
+	}
+	defer f.Close()
+
+	header := make([]byte, 262)
+	n, _ := io.ReadFull(f, header)
+	header = header[:n]
+	switch {
+	case bytes.HasPrefix(header, []byte("PK\x03\x04")), bytes.HasPrefix(header, []byte("PK\x05\x06")):
+		return Zip, nil
+	case bytes.HasPrefix(header, []byte{0x1f, 0x8b}):
+		return TarGz, nil
+	case len(header) >= 262 && string(header[257:262]) == "ustar":
+		return Tar, nil
+	}
+	// pre-POSIX tar archives have no magic
+	if strings.HasSuffix(strings.ToLower(file), ".tar") {
+		return Tar, nil
+	}
+	return "", errors.Errorf("%s is not a tar, tar.gz or zip archive", file)
+}
+
+// Extract unpacks the archive a, downloaded at a.Path, into dest. When a.Dir
+// is set only the entries below that directory are extracted, relative to
+// it. Archives with absolute entries, entries escaping dest or links are
+// rejected with an *UnsafeEntryError. Once extracted, every file in
+// a.Contents must exist or a *MissingContentsError is returned.
+func Extract(a Artifact, dest string) error {
+	format, err := ArchiveFormat(a.Path)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return errors.Wrapf(err, "cannot create %s", dest)
+	}
+	x := &extractor{archive: a, dest: filepath.Clean(dest)}
+	switch format {
+	case Zip:
+		err = x.zip()
+	case TarGz:
+		err = x.tarGz()
+	default:
+		err = x.tar(nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	var missing []Artifact
+	for _, c := range a.Contents {
+		if info, err := os.Stat(c.Path); err != nil || !info.Mode().IsRegular() {
+			missing = append(missing, c)
+		}
+	}
+	if len(missing) != 0 {
+		return &MissingContentsError{Archive: a, Missing: missing}
+	}
+	return nil
+}
+
+type extractor struct {
+	archive Artifact
+	dest    string
+}
+
+func (x *extractor) unsafe(entry, reason string) error {
+	return &UnsafeEntryError{Archive: x.archive, Entry: entry, Reason: reason}
+}
+
+// target returns where the entry name is extracted to, or "" when it is
+// outside of the archive directory.
+func (x *extractor) target(name string) (string, error) {
+	clean := strings.Replace(name, `\`, "/", -1)
+	if path.IsAbs(clean) || (len(clean) > 1 && clean[1] == ':') {
+		return "", x.unsafe(name, "is absolute")
+	}
+	clean = path.Clean(clean)
+	if clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", x.unsafe(name, "escapes the destination directory")
+	}
+	if dir := path.Clean(strings.Replace(x.archive.Dir, `\`, "/", -1)); dir != "." && dir != "/" {
+		if clean == dir {
+			return x.dest, nil
+		}
+		if !strings.HasPrefix(clean, dir+"/") {
+			return "", nil
+		}
+		clean = strings.TrimPrefix(clean, dir+"/")
+	}
+	target := filepath.Join(x.dest, filepath.FromSlash(clean))
+	if rel, err := filepath.Rel(x.dest, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", x.unsafe(name, "escapes the destination directory")
+	}
+	return target, nil
+}
+
+func (x *extractor) mkdir(name string) error {
+	target, err := x.target(name)
+	if err != nil || target == "" {
+		return err
+	}
+	return errors.Wrapf(os.MkdirAll(target, 0755), "cannot extract %s", name)
+}
+
+func (x *extractor) file(name string, mode os.FileMode, r io.Reader) error {
+	target, err := x.target(name)
+	if err != nil || target == "" {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return errors.Wrapf(err, "cannot extract %s", name)
+	}
+	// never write through a file left at the target, it may be a link
+	os.Remove(target)
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644|mode.Perm()&0111)
+	if err != nil {
+		return errors.Wrapf(err, "cannot extract %s", name)
+	}
+	_, err = io.Copy(f, r)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return errors.Wrapf(err, "cannot extract %s", name)
+}
+
+func (x *extractor) tarGz() error {
+	f, err := os.Open(x.archive.Path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot open the archive %s", x.archive.Path)
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return errors.Wrapf(err, "cannot read the archive %s", x.archive.Path)
+	}
+	defer gz.Close()
+	return x.tar(gz)
+}
+
+func (x *extractor) tar(r io.Reader) error {
+	if r == nil {
+		f, err := os.Open(x.archive.Path)
+		if err != nil {
+			return errors.Wrapf(err, "cannot open the archive %s", x.archive.Path)
+		}
+		defer f.Close()
+		r = bufio.NewReader(f)
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot read the archive %s", x.archive.Path)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = x.mkdir(hdr.Name)
+		case tar.TypeReg:
+			err = x.file(hdr.Name, hdr.FileInfo().Mode(), tr)
+		case tar.TypeSymlink, tar.TypeLink:
+			err = x.unsafe(hdr.Name, "is a link")
+		default:
+			// pax headers, devices and fifos carry no model files
+			_, err = x.target(hdr.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (x *extractor) zip() error {
+	zr, err := zip.OpenReader(x.archive.Path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot read the archive %s", x.archive.Path)
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			err = x.mkdir(f.Name)
+		case mode&os.ModeSymlink != 0:
+			err = x.unsafe(f.Name, "is a link")
+		default:
+			err = x.zipFile(f)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *extractor) zipFile(f *zip.File) error {
+	r, err := f.Open()
+	if err != nil {
+		return errors.Wrapf(err, "cannot read %s from the archive %s", f.Name, x.archive.Path)
+	}
+	defer r.Close()
+	return x.file(f.Name, f.Mode(), r)
+}
@@ -0,0 +1,218 @@
+package artifact
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type entry struct {
+	name    string
+	content string
+	link    string
+}
+
+func tarArchive(t *testing.T, entries []entry) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0644, Size: int64(len(e.content)), Typeflag: tar.TypeReg}
+		switch {
+		case e.link != "":
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, e.link, 0
+		case e.name[len(e.name)-1] == '/':
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0755
+		}
+		assert.NoError(t, tw.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(e.content))
+			assert.NoError(t, err)
+		}
+	}
+	assert.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func tarGzArchive(t *testing.T, entries []entry) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err := gz.Write(tarArchive(t, entries))
+	assert.NoError(t, err)
+	assert.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, entries []entry) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		w, err := zw.Create(e.name)
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(e.content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+var modelEntries = []entry{
+	{name: "resnet50/"},
+	{name: "resnet50/v1/"},
+	{name: "resnet50/v1/deploy.prototxt", content: "name: \"ResNet-50\""},
+	{name: "resnet50/v1/resnet50.caffemodel", content: "weights"},
+	{name: "resnet50/README.md", content: "# ResNet-50"},
+}
+
+// serveArchive downloads the archive from a local server into workDir, as the
+// predictor does.
+func serveArchive(t *testing.T, workDir, name string, content []byte, dir string) Artifact {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	}))
+	defer srv.Close()
+
+	a := Artifact{
+		Kind: Archive,
+		URL:  srv.URL + "/" + name,
+		Path: filepath.Join(workDir, name),
+		Dir:  dir,
+		Contents: []Artifact{
+			{Kind: Graph, Path: filepath.Join(workDir, "deploy.prototxt"), Checksum: md5sum([]byte("name: \"ResNet-50\""))},
+			{Kind: Weights, Path: filepath.Join(workDir, "resnet50.caffemodel")},
+		},
+	}
+	assert.NoError(t, NewDownloader().Download(context.Background(), a, a.URL, a.Path))
+	return a
+}
+
+func TestExtract(t *testing.T) {
+	archives := map[string][]byte{
+		"model.tar":    tarArchive(t, modelEntries),
+		"model.tar.gz": tarGzArchive(t, modelEntries),
+		"model.zip":    zipArchive(t, modelEntries),
+		// no extension, the format is detected from the content
+		"model": tarGzArchive(t, modelEntries),
+	}
+	formats := map[string]string{"model.tar": Tar, "model.tar.gz": TarGz, "model.zip": Zip, "model": TarGz}
+	for name, content := range archives {
+		t.Run(name, func(t *testing.T) {
+			workDir := tempDir(t)
+			defer os.RemoveAll(workDir)
+
+			a := serveArchive(t, workDir, name, content, "resnet50/v1/")
+			format, err := ArchiveFormat(a.Path)
+			assert.NoError(t, err)
+			assert.Equal(t, formats[name], format)
+
+			assert.NoError(t, Extract(a, workDir))
+			assert.NoError(t, Verify(a))
+			assert.True(t, Present(a))
+			_, err = os.Stat(filepath.Join(workDir, "README.md"))
+			assert.True(t, os.IsNotExist(err), "entries outside of the archive directory are not extracted")
+		})
+	}
+}
+
+func TestExtractWholeArchive(t *testing.T) {
+	workDir := tempDir(t)
+	defer os.RemoveAll(workDir)
+
+	a := serveArchive(t, workDir, "model.tar.gz", tarGzArchive(t, modelEntries), "")
+	err := Extract(a, workDir)
+	if assert.IsType(t, &MissingContentsError{}, err) {
+		assert.Len(t, err.(*MissingContentsError).Missing, 2)
+		assert.Contains(t, err.Error(), "graph "+filepath.Join(workDir, "deploy.prototxt"))
+	}
+	content, err := ioutil.ReadFile(filepath.Join(workDir, "resnet50", "v1", "resnet50.caffemodel"))
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(content))
+}
+
+func TestExtractMissingContents(t *testing.T) {
+	workDir := tempDir(t)
+	defer os.RemoveAll(workDir)
+
+	entries := []entry{{name: "resnet50/v1/deploy.prototxt", content: "name: \"ResNet-50\""}}
+	a := serveArchive(t, workDir, "model.zip", zipArchive(t, entries), "resnet50/v1")
+	err := Extract(a, workDir)
+	if assert.IsType(t, &MissingContentsError{}, err) {
+		missing := err.(*MissingContentsError).Missing
+		if assert.Len(t, missing, 1) {
+			assert.Equal(t, Weights, missing[0].Kind)
+		}
+		assert.Contains(t, err.Error(), "below resnet50/v1")
+	}
+}
+
+func TestExtractUnsafe(t *testing.T) {
+	cases := map[string][]byte{
+		"traversal.tar.gz": tarGzArchive(t, []entry{
+			{name: "resnet50/deploy.prototxt", content: "graph"},
+			{name: "resnet50/../../evil.sh", content: "rm -rf /"},
+		}),
+		"absolute.tar": tarArchive(t, []entry{{name: "/tmp/evil.sh", content: "rm -rf /"}}),
+		"symlink.tar.gz": tarGzArchive(t, []entry{
+			{name: "resnet50/link", link: "/etc"},
+			{name: "resnet50/link/passwd", content: "root::0:0"},
+		}),
+		"traversal.zip": zipArchive(t, []entry{{name: "../evil.sh", content: "rm -rf /"}}),
+		"backslash.zip": zipArchive(t, []entry{{name: `..\..\evil.sh`, content: "rm -rf /"}}),
+		"drive.zip":     zipArchive(t, []entry{{name: `C:\evil.sh`, content: "rm -rf /"}}),
+	}
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			root := tempDir(t)
+			defer os.RemoveAll(root)
+			workDir := filepath.Join(root, "work")
+
+			// entries are rejected even outside of the archive directory
+			a := serveArchive(t, workDir, name, content, "resnet50")
+			err := Extract(a, workDir)
+			assert.IsType(t, &UnsafeEntryError{}, err)
+
+			_, err = os.Stat(filepath.Join(root, "evil.sh"))
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestArchiveFormatUnknown(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "model.rar")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("Rar!\x1a\x07"), 0644))
+	_, err := ArchiveFormat(path)
+	assert.Error(t, err)
+}
+
+func TestStoreFetchArchive(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	store := NewStore(dir)
+
+	content := tarGzArchive(t, modelEntries)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(content)
+	}))
+	defer srv.Close()
+
+	// the contents of the archive are not verified until it is extracted
+	a := Artifact{
+		Kind:     Archive,
+		URL:      srv.URL + "/model.tar.gz",
+		Contents: []Artifact{{Kind: Graph, Checksum: md5sum([]byte("name: \"ResNet-50\""))}},
+	}
+	assert.NoError(t, store.Fetch(context.Background(), a))
+	assert.True(t, store.Has(a))
+}
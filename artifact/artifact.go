@@ -0,0 +1,60 @@
+// Package artifact fetches the files a model is made of (graph, weights,
+// features, archives) and keeps them in a local content addressed store.
+package artifact
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of artifacts ...
+const (
+	Graph          = "graph"
+	Weights        = "weights"
+	Features       = "features"
+	Archive        = "archive"
+	EngineMetadata = "engine_metadata"
+)
+
+// Artifact is a file of a model.
+type Artifact struct {
+	// Kind is one of Graph, Weights, Features, Archive or EngineMetadata
+	Kind string
+	// URL the artifact is downloaded from
+	URL string
+	// Checksum of the artifact, empty when the manifest does not declare one
+	Checksum string
+	// Path the artifact is placed at in the model work directory
+	Path string
+	// Contents are the files an archive is expected to extract
+	Contents []Artifact
+	// Dir is the directory of an archive its contents are extracted from,
+	// empty for the whole archive
+	Dir string
+}
+
+func (a Artifact) String() string {
+	if a.Checksum == "" {
+		return fmt.Sprintf("%s %s", a.Kind, a.URL)
+	}
+	return fmt.Sprintf("%s %s (checksum %s)", a.Kind, a.URL, a.Checksum)
+}
+
+// MissingError is returned in offline mode when artifacts of a model are not
+// in the store.
+type MissingError struct {
+	Model     string
+	Artifacts []Artifact
+}
+
+func (e *MissingError) Error() string {
+	lines := make([]string, 0, len(e.Artifacts)+1)
+	lines = append(lines, fmt.Sprintf(
+		"%d artifact(s) of %s are not in the local store, run `tensorrt-agent models fetch %s` on a machine with network access and copy the store over:",
+		len(e.Artifacts), e.Model, e.Model,
+	))
+	for _, a := range e.Artifacts {
+		lines = append(lines, "  "+a.String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,236 @@
+package artifact
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Checksum algorithms ...
+const (
+	MD5    = "md5"
+	SHA256 = "sha256"
+)
+
+// Checksum is a parsed artifact checksum.
+type Checksum struct {
+	Algorithm string
+	Hex       string
+}
+
+// ParseChecksum parses a manifest checksum. Checksums are hex encoded and
+// may be prefixed with their algorithm, `sha256:` or `md5:`. Unprefixed
+// checksums are MD5 or SHA-256 depending on their length.
+func ParseChecksum(s string) (Checksum, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	algorithm := ""
+	if idx := strings.Index(s, ":"); idx != -1 {
+		algorithm, s = s[:idx], s[idx+1:]
+	}
+
+	size := 0
+	switch algorithm {
+	case "":
+		switch len(s) {
+		case hex.EncodedLen(md5.Size):
+			algorithm, size = MD5, md5.Size
+		case hex.EncodedLen(sha256.Size):
+			algorithm, size = SHA256, sha256.Size
+		default:
+			return Checksum{}, errors.Errorf("checksum %q is neither an md5 nor a sha256 checksum", s)
+		}
+	case MD5:
+		size = md5.Size
+	case SHA256:
+		size = sha256.Size
+	default:
+		return Checksum{}, errors.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+	if len(s) != hex.EncodedLen(size) {
+		return Checksum{}, errors.Errorf("%s checksum %q should have %d hex digits", algorithm, s, hex.EncodedLen(size))
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return Checksum{}, errors.Errorf("%s checksum %q is not hex encoded", algorithm, s)
+	}
+	return Checksum{Algorithm: algorithm, Hex: s}, nil
+}
+
+func (c Checksum) String() string {
+	return c.Algorithm + ":" + c.Hex
+}
+
+// New returns the hash computing the checksum.
+func (c Checksum) New() hash.Hash {
+	if c.Algorithm == SHA256 {
+		return sha256.New()
+	}
+	return md5.New()
+}
+
+// ChecksumError is returned when the content of an artifact does not match its
+// checksum.
+type ChecksumError struct {
+	Artifact Artifact
+	Expected Checksum
+	Actual   string
+	// Quarantined is where the bad file was moved to, if anywhere
+	Quarantined string
+}
+
+func (e *ChecksumError) Error() string {
+	msg := fmt.Sprintf("%s checksum mismatch for %s %s, expected %s but got %s",
+		e.Expected.Algorithm, e.Artifact.Kind, e.Artifact.URL, e.Expected.Hex, e.Actual)
+	if e.Quarantined != "" {
+		msg += ", the file was quarantined to " + e.Quarantined
+	}
+	return msg
+}
+
+// MissingChecksumError is returned in strict mode for artifacts whose manifest
+// does not declare a checksum.
+type MissingChecksumError struct {
+	Model     string
+	Artifacts []Artifact
+}
+
+func (e *MissingChecksumError) Error() string {
+	lines := make([]string, 0, len(e.Artifacts)+1)
+	lines = append(lines, fmt.Sprintf("checksums are required but %d artifact(s) of %s do not declare one:", len(e.Artifacts), e.Model))
+	for _, a := range e.Artifacts {
+		lines = append(lines, "  "+a.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RequireChecksums returns a *MissingChecksumError listing the artifacts that
+// have no checksum. Archives are covered by the checksums of their contents.
+func RequireChecksums(model string, artifacts []Artifact) error {
+	var missing []Artifact
+	for _, a := range artifacts {
+		if a.Kind == Archive {
+			if err := RequireChecksums(model, a.Contents); err != nil {
+				missing = append(missing, err.(*MissingChecksumError).Artifacts...)
+			}
+			if len(a.Contents) != 0 {
+				continue
+			}
+		}
+		if a.Checksum == "" {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) != 0 {
+		return &MissingChecksumError{Model: model, Artifacts: missing}
+	}
+	return nil
+}
+
+// Verify checks the file at a.Path against the checksum of a. Artifacts
+// without a checksum are not verified and archives are verified through
+// their extracted contents. A file that does not match is moved to
+// quarantine and a *ChecksumError is returned.
+func Verify(a Artifact) error {
+	if a.Kind == Archive {
+		for _, c := range a.Contents {
+			if err := Verify(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if a.Checksum == "" {
+		return nil
+	}
+	expected, err := ParseChecksum(a.Checksum)
+	if err != nil {
+		return errors.Wrapf(err, "invalid checksum for %s %s", a.Kind, a.URL)
+	}
+
+	actual, err := sum(a.Path, expected)
+	if err != nil {
+		return errors.Wrapf(err, "cannot verify %s", a.Path)
+	}
+	if actual == expected.Hex {
+		return nil
+	}
+	cerr := &ChecksumError{Artifact: a, Expected: expected, Actual: actual}
+	if quarantined, err := Quarantine(a.Path); err == nil {
+		cerr.Quarantined = quarantined
+	}
+	return cerr
+}
+
+// Present reports whether the artifact is already at its path with the
+// expected content. Archives are present when all their contents are.
+func Present(a Artifact) bool {
+	if a.Kind == Archive {
+		if len(a.Contents) == 0 {
+			return false
+		}
+		for _, c := range a.Contents {
+			if !Present(c) {
+				return false
+			}
+		}
+		return true
+	}
+	info, err := os.Stat(a.Path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if a.Checksum == "" {
+		return true
+	}
+	expected, err := ParseChecksum(a.Checksum)
+	if err != nil {
+		return false
+	}
+	actual, err := sum(a.Path, expected)
+	return err == nil && actual == expected.Hex
+}
+
+func sum(path string, checksum Checksum) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := checksum.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// IsChecksumError reports whether err is a *ChecksumError.
+func IsChecksumError(err error) bool {
+	_, ok := errors.Cause(err).(*ChecksumError)
+	return ok
+}
+
+// QuarantineDir is the directory, next to a bad file, the file is moved to.
+const QuarantineDir = ".quarantine"
+
+// Quarantine moves the file at path out of the way, into QuarantineDir next
+// to it, and returns its new path. Quarantined files are kept for inspection
+// and are never used again.
+func Quarantine(path string) (string, error) {
+	dir := filepath.Join(filepath.Dir(path), QuarantineDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", errors.Wrapf(err, "cannot quarantine %s", path)
+	}
+	target := filepath.Join(dir, filepath.Base(path)+"."+time.Now().UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(path, target); err != nil {
+		return "", errors.Wrapf(err, "cannot quarantine %s", path)
+	}
+	return target, nil
+}
@@ -0,0 +1,134 @@
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func sha256sum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestParseChecksum(t *testing.T) {
+	data := []byte("weights")
+
+	c, err := ParseChecksum(md5sum(data))
+	assert.NoError(t, err)
+	assert.Equal(t, Checksum{Algorithm: MD5, Hex: md5sum(data)}, c)
+
+	c, err = ParseChecksum(sha256sum(data))
+	assert.NoError(t, err)
+	assert.Equal(t, SHA256, c.Algorithm)
+
+	c, err = ParseChecksum(" SHA256:" + sha256sum(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "sha256:"+sha256sum(data), c.String())
+
+	c, err = ParseChecksum("md5:" + md5sum(data))
+	assert.NoError(t, err)
+	assert.Equal(t, MD5, c.Algorithm)
+
+	for _, s := range []string{
+		"abc",
+		"sha256:" + md5sum(data),
+		"sha1:" + md5sum(data),
+		"zz" + md5sum(data)[2:],
+	} {
+		_, err := ParseChecksum(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	data := []byte("weights")
+	path := filepath.Join(dir, "alexnet.caffemodel")
+	assert.NoError(t, ioutil.WriteFile(path, data, 0644))
+
+	for _, checksum := range []string{"", md5sum(data), sha256sum(data), "sha256:" + sha256sum(data)} {
+		a := Artifact{Kind: Weights, URL: "http://example.com/alexnet.caffemodel", Checksum: checksum, Path: path}
+		assert.NoError(t, Verify(a), checksum)
+		assert.True(t, Present(a), checksum)
+	}
+
+	a := Artifact{Kind: Weights, URL: "http://example.com/alexnet.caffemodel", Checksum: sha256sum([]byte("other")), Path: path}
+	assert.False(t, Present(a))
+	_, err := os.Stat(path)
+	assert.NoError(t, err, "Present does not quarantine")
+
+	err = Verify(a)
+	assert.True(t, IsChecksumError(err))
+	assert.True(t, IsChecksumError(errors.Wrap(err, "download")))
+	cerr := err.(*ChecksumError)
+	assert.Equal(t, sha256sum(data), cerr.Actual)
+	assert.Equal(t, SHA256, cerr.Expected.Algorithm)
+	assert.Equal(t, filepath.Join(dir, QuarantineDir), filepath.Dir(cerr.Quarantined))
+	assert.Contains(t, err.Error(), cerr.Quarantined)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "the bad file is moved out of the way")
+	quarantined, err := ioutil.ReadFile(cerr.Quarantined)
+	assert.NoError(t, err)
+	assert.Equal(t, data, quarantined)
+
+	assert.False(t, IsChecksumError(Verify(Artifact{Kind: Graph, Checksum: md5sum(data), Path: path})))
+}
+
+func TestVerifyArchive(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	graph := []byte("name: \"AlexNet\"")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "deploy.prototxt"), graph, 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "alexnet.caffemodel"), []byte("truncated"), 0644))
+
+	archive := Artifact{
+		Kind: Archive,
+		URL:  "http://example.com/alexnet.tar.gz",
+		Contents: []Artifact{
+			{Kind: Graph, Checksum: md5sum(graph), Path: filepath.Join(dir, "deploy.prototxt")},
+			{Kind: Weights, Checksum: sha256sum([]byte("weights")), Path: filepath.Join(dir, "alexnet.caffemodel")},
+		},
+	}
+	assert.False(t, Present(archive))
+	err := Verify(archive)
+	if assert.True(t, IsChecksumError(err)) {
+		assert.Equal(t, Weights, err.(*ChecksumError).Artifact.Kind)
+	}
+	assert.False(t, Present(Artifact{Kind: Archive}))
+}
+
+func TestRequireChecksums(t *testing.T) {
+	graph := Artifact{Kind: Graph, URL: "http://example.com/deploy.prototxt", Checksum: md5sum([]byte("graph"))}
+	weights := Artifact{Kind: Weights, URL: "http://example.com/alexnet.caffemodel"}
+	features := Artifact{Kind: Features, URL: "http://example.com/synset.txt"}
+
+	assert.NoError(t, RequireChecksums("BVLC-AlexNet:1.0", []Artifact{graph}))
+
+	err := RequireChecksums("BVLC-AlexNet:1.0", []Artifact{graph, weights, features})
+	if assert.IsType(t, &MissingChecksumError{}, err) {
+		assert.Equal(t, []Artifact{weights, features}, err.(*MissingChecksumError).Artifacts)
+		assert.Contains(t, err.Error(), "BVLC-AlexNet:1.0")
+		assert.Contains(t, err.Error(), weights.URL)
+	}
+
+	// archives are covered by the checksums of their contents
+	archive := Artifact{Kind: Archive, URL: "http://example.com/alexnet.tar.gz", Contents: []Artifact{graph}}
+	assert.NoError(t, RequireChecksums("BVLC-AlexNet:1.0", []Artifact{archive}))
+	archive.Contents = append(archive.Contents, weights)
+	err = RequireChecksums("BVLC-AlexNet:1.0", []Artifact{archive})
+	if assert.IsType(t, &MissingChecksumError{}, err) {
+		assert.Equal(t, []Artifact{weights}, err.(*MissingChecksumError).Artifacts)
+	}
+	assert.Error(t, RequireChecksums("BVLC-AlexNet:1.0", []Artifact{{Kind: Archive, URL: archive.URL}}))
+}
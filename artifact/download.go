@@ -0,0 +1,262 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+// PartialExtension is appended to the path of a file while it is downloaded.
+// A partial file left by an interrupted download is resumed.
+const PartialExtension = ".partial"
+
+// Progress is reported while an artifact is downloaded.
+type Progress struct {
+	Artifact Artifact
+	URL      string
+	// Written is the number of bytes on disk, including resumed ones
+	Written int64
+	// Total is the size of the artifact, or -1 when the server does not say
+	Total int64
+	Done  bool
+}
+
+// Percent returns how much of the artifact is downloaded, or -1 when the
+// total size is unknown.
+func (p Progress) Percent() float64 {
+	if p.Total <= 0 {
+		return -1
+	}
+	return 100 * float64(p.Written) / float64(p.Total)
+}
+
+func (p Progress) String() string {
+	if p.Total < 0 {
+		return fmt.Sprintf("%s: %d bytes", p.Artifact.Kind, p.Written)
+	}
+	return fmt.Sprintf("%s: %d/%d bytes (%.1f%%)", p.Artifact.Kind, p.Written, p.Total, p.Percent())
+}
+
+// Downloader fetches artifacts over HTTP. Dropped connections are resumed
+// with range requests instead of starting over.
+type Downloader struct {
+	Client *http.Client
+	// Retries is how many times a dropped download is resumed
+	Retries int
+	// Progress, when set, is called at most every ProgressInterval and once
+	// the download is done
+	Progress         func(Progress)
+	ProgressInterval time.Duration
+}
+
+// DefaultRetries ...
+const DefaultRetries = 5
+
+// DefaultProgressInterval ...
+const DefaultProgressInterval = 2 * time.Second
+
+// NewDownloader returns a downloader with the default settings.
+func NewDownloader() *Downloader {
+	return &Downloader{
+		Client:           http.DefaultClient,
+		Retries:          DefaultRetries,
+		ProgressInterval: DefaultProgressInterval,
+	}
+}
+
+// Download fetches url into path. The content is written to path with
+// PartialExtension appended and renamed once complete, so path never holds a
+// truncated file.
+func (d *Downloader) Download(ctx context.Context, a Artifact, url, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "cannot create the directory of %s", path)
+	}
+	partial := path + PartialExtension
+
+	var err error
+	for attempt := 0; attempt <= d.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff(attempt)):
+			}
+		}
+		var resumable bool
+		resumable, err = d.fetch(ctx, a, url, partial)
+		if err == nil {
+			return os.Rename(partial, path)
+		}
+		if !resumable || ctx.Err() != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(err, "giving up on %s after %d retries", url, d.Retries)
+}
+
+func backoff(attempt int) time.Duration {
+	d := time.Duration(attempt) * 100 * time.Millisecond
+	if d > 2*time.Second {
+		d = 2 * time.Second
+	}
+	return d
+}
+
+// fetch downloads url into partial, resuming from its current size. It
+// reports whether a failure is worth retrying.
+func (d *Downloader) fetch(ctx context.Context, a Artifact, url, partial string) (bool, error) {
+	var offset int64
+	if info, err := os.Stat(partial); err == nil {
+		offset = info.Size()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid %s url %s", a.Kind, url)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	}
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	total := resp.ContentLength
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		start, size, ok := parseContentRange(resp.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			return false, errors.Errorf("unexpected Content-Range %q resuming %s at %d", resp.Header.Get("Content-Range"), url, offset)
+		}
+		flags |= os.O_APPEND
+		total = size
+	case resp.StatusCode == http.StatusOK:
+		// the server ignored the range, start over
+		flags |= os.O_TRUNC
+		offset = 0
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// the partial file is stale or already complete, start over
+		os.Remove(partial)
+		return true, errors.Errorf("cannot resume %s at %d: %s", url, offset, resp.Status)
+	default:
+		return resp.StatusCode >= 500, errors.New(resp.Status)
+	}
+
+	f, err := os.OpenFile(partial, flags, 0644)
+	if err != nil {
+		return false, errors.Wrapf(err, "cannot write %s", partial)
+	}
+	w := &progressWriter{
+		d:       d,
+		p:       Progress{Artifact: a, URL: url, Written: offset, Total: total},
+		last:    time.Now(),
+		written: offset,
+	}
+	_, err = io.Copy(io.MultiWriter(f, w), resp.Body)
+	if cerr := f.Close(); err == nil && cerr != nil {
+		return false, errors.Wrapf(cerr, "cannot write %s", partial)
+	}
+	if err != nil {
+		return true, errors.Wrapf(err, "download of %s interrupted after %d bytes", url, w.written)
+	}
+	if total >= 0 && w.written != total {
+		return true, errors.Errorf("download of %s interrupted after %d of %d bytes", url, w.written, total)
+	}
+	w.done()
+	return false, nil
+}
+
+// parseContentRange parses `bytes start-end/size`. size is -1 when unknown.
+func parseContentRange(s string) (start, size int64, ok bool) {
+	if !strings.HasPrefix(s, "bytes ") {
+		return 0, 0, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(s, "bytes "), "/", 2)
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	bounds := strings.SplitN(parts[0], "-", 2)
+	start, err := strconv.ParseInt(bounds[0], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if parts[1] == "*" {
+		return start, -1, true
+	}
+	size, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, size, true
+}
+
+type progressWriter struct {
+	d       *Downloader
+	p       Progress
+	last    time.Time
+	written int64
+}
+
+func (w *progressWriter) Write(b []byte) (int, error) {
+	w.written += int64(len(b))
+	if w.d.Progress != nil && time.Since(w.last) >= w.d.ProgressInterval {
+		w.last = time.Now()
+		p := w.p
+		p.Written = w.written
+		w.d.Progress(p)
+	}
+	return len(b), nil
+}
+
+func (w *progressWriter) done() {
+	if w.d.Progress == nil {
+		return
+	}
+	p := w.p
+	p.Written = w.written
+	p.Done = true
+	w.d.Progress(p)
+}
+
+// DefaultParallelism is how many artifacts are downloaded at once.
+const DefaultParallelism = 4
+
+// Parallel calls fn for every artifact, running at most parallelism calls at
+// once. The first error cancels the context of the other calls and is
+// returned.
+func Parallel(ctx context.Context, artifacts []Artifact, parallelism int, fn func(context.Context, Artifact) error) error {
+	if parallelism <= 0 {
+		parallelism = DefaultParallelism
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	sem := make(chan struct{}, parallelism)
+	for _, a := range artifacts {
+		a := a
+		g.Go(func() error {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			defer func() { <-sem }()
+			return fn(ctx, a)
+		})
+	}
+	return g.Wait()
+}
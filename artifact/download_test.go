@@ -0,0 +1,206 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyServer serves content with range support, dropping the connection
+// after chunk bytes for the first drops requests.
+type flakyServer struct {
+	content []byte
+	chunk   int
+	drops   int32
+	ranges  bool
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func (s *flakyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r.Header.Get("Range"))
+	s.mu.Unlock()
+
+	start := 0
+	if rng := r.Header.Get("Range"); rng != "" && s.ranges {
+		start, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, len(s.content)-1, len(s.content)))
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.content)-start))
+		w.WriteHeader(http.StatusPartialContent)
+	} else {
+		w.Header().Set("Content-Length", strconv.Itoa(len(s.content)))
+		w.WriteHeader(http.StatusOK)
+	}
+
+	body := s.content[start:]
+	if atomic.AddInt32(&s.drops, -1) >= 0 && len(body) > s.chunk {
+		w.Write(body[:s.chunk])
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+	w.Write(body)
+}
+
+func randomContent(size int) []byte {
+	content := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(content)
+	return content
+}
+
+func TestDownloadResume(t *testing.T) {
+	content := randomContent(1 << 20)
+	flaky := &flakyServer{content: content, chunk: 300 << 10, drops: 3, ranges: true}
+	srv := httptest.NewServer(flaky)
+	defer srv.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "alexnet.caffemodel")
+
+	var events []Progress
+	d := NewDownloader()
+	d.ProgressInterval = 0
+	d.Progress = func(p Progress) {
+		events = append(events, p)
+	}
+	a := Artifact{Kind: Weights, URL: srv.URL + "/alexnet.caffemodel", Checksum: md5sum(content), Path: path}
+	assert.NoError(t, d.Download(context.Background(), a, a.URL, path))
+
+	actual, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(content, actual))
+	assert.NoError(t, Verify(a))
+	_, err = os.Stat(path + PartialExtension)
+	assert.True(t, os.IsNotExist(err))
+
+	// every retry resumes where the dropped connection stopped
+	assert.Equal(t, []string{
+		"",
+		"bytes=307200-",
+		"bytes=614400-",
+		"bytes=921600-",
+	}, flaky.requests)
+
+	if assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.True(t, last.Done)
+		assert.Equal(t, int64(len(content)), last.Written)
+		assert.Equal(t, int64(len(content)), last.Total)
+		assert.Equal(t, float64(100), last.Percent())
+	}
+	for i := 1; i < len(events); i++ {
+		if events[i].URL == events[i-1].URL && events[i].Total == events[i-1].Total {
+			assert.True(t, events[i].Written >= events[i-1].Written, "progress goes forward")
+		}
+	}
+}
+
+func TestDownloadWithoutRangeSupport(t *testing.T) {
+	content := randomContent(256 << 10)
+	flaky := &flakyServer{content: content, chunk: 100 << 10, drops: 1}
+	srv := httptest.NewServer(flaky)
+	defer srv.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "synset.txt")
+
+	a := Artifact{Kind: Features, URL: srv.URL + "/synset.txt"}
+	assert.NoError(t, NewDownloader().Download(context.Background(), a, a.URL, path))
+	actual, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.True(t, bytes.Equal(content, actual), "the download starts over when the server ignores ranges")
+}
+
+func TestDownloadGivesUp(t *testing.T) {
+	content := randomContent(64 << 10)
+	flaky := &flakyServer{content: content, chunk: 1 << 10, drops: 100, ranges: true}
+	srv := httptest.NewServer(flaky)
+	defer srv.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "deploy.prototxt")
+
+	d := NewDownloader()
+	d.Retries = 2
+	a := Artifact{Kind: Graph, URL: srv.URL + "/deploy.prototxt"}
+	err := d.Download(context.Background(), a, a.URL, path)
+	assert.Error(t, err)
+	assert.Len(t, flaky.requests, 3)
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err), "a truncated file never replaces the target")
+
+	// the partial file is resumed by the next download
+	info, err := os.Stat(path + PartialExtension)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3<<10), info.Size())
+	atomic.StoreInt32(&flaky.drops, 0)
+	assert.NoError(t, d.Download(context.Background(), a, a.URL, path))
+	assert.Equal(t, "bytes=3072-", flaky.requests[len(flaky.requests)-1])
+}
+
+func TestParseContentRange(t *testing.T) {
+	start, size, ok := parseContentRange("bytes 100-199/200")
+	assert.True(t, ok)
+	assert.Equal(t, int64(100), start)
+	assert.Equal(t, int64(200), size)
+
+	_, size, ok = parseContentRange("bytes 100-199/*")
+	assert.True(t, ok)
+	assert.Equal(t, int64(-1), size)
+
+	_, _, ok = parseContentRange("items 1-2/3")
+	assert.False(t, ok)
+}
+
+func TestParallel(t *testing.T) {
+	artifacts := make([]Artifact, 10)
+	for i := range artifacts {
+		artifacts[i] = Artifact{Kind: Features, URL: fmt.Sprintf("http://example.com/%d", i)}
+	}
+
+	var running, peak int32
+	var calls int32
+	err := Parallel(context.Background(), artifacts, 3, func(ctx context.Context, a Artifact) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), calls)
+	assert.True(t, peak <= 3)
+
+	failure := errors.New("dropped")
+	err = Parallel(context.Background(), artifacts, 2, func(ctx context.Context, a Artifact) error {
+		if a.URL == artifacts[0].URL {
+			return failure
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.Equal(t, failure, err)
+}
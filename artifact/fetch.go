@@ -0,0 +1,35 @@
+package artifact
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Fetch downloads the artifact into the store, unless it is already there.
+// The mirrors of the store are tried before the artifact URL, and an
+// interrupted fetch is resumed by the next one.
+func (s *Store) Fetch(ctx context.Context, a Artifact) error {
+	if s.Has(a) {
+		return nil
+	}
+	d := s.Downloader
+	if d == nil {
+		d = NewDownloader()
+	}
+	download := s.Path(a) + ".download"
+	return s.Mirrors.Do(ctx, a, func(ctx context.Context, url string) error {
+		if err := d.Download(ctx, a, url, download); err != nil {
+			return err
+		}
+		defer os.Remove(download)
+
+		f, err := os.Open(download)
+		if err != nil {
+			return errors.Wrapf(err, "cannot read the downloaded %s", a.Kind)
+		}
+		defer f.Close()
+		return s.Put(a, f)
+	})
+}
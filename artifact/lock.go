@@ -0,0 +1,88 @@
+package artifact
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LockFile is the name of the lock file guarding a model work directory.
+const LockFile = ".lock"
+
+// lockPollInterval is how often a lock held by another process is retried.
+const lockPollInterval = 50 * time.Millisecond
+
+// inProcess serializes the goroutines of this process on a lock path before
+// they contend for the file lock.
+var inProcess = struct {
+	sync.Mutex
+	locks map[string]chan struct{}
+}{locks: map[string]chan struct{}{}}
+
+func processLock(path string) chan struct{} {
+	inProcess.Lock()
+	defer inProcess.Unlock()
+	lock, ok := inProcess.locks[path]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		inProcess.locks[path] = lock
+	}
+	return lock
+}
+
+// Lock takes the exclusive lock at path, waiting for other goroutines and
+// other processes holding it until ctx is done. The returned function
+// releases the lock.
+func Lock(ctx context.Context, path string) (func(), error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, errors.Wrapf(err, "cannot create the directory of %s", path)
+	}
+
+	lock := processLock(path)
+	select {
+	case lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, errors.Wrapf(ctx.Err(), "waiting for the lock %s", path)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		<-lock
+		return nil, errors.Wrapf(err, "cannot open the lock %s", path)
+	}
+	for {
+		locked, err := tryLockFile(f)
+		if err != nil {
+			f.Close()
+			<-lock
+			return nil, errors.Wrapf(err, "cannot take the lock %s", path)
+		}
+		if locked {
+			break
+		}
+		select {
+		case <-time.After(lockPollInterval):
+		case <-ctx.Done():
+			f.Close()
+			<-lock
+			return nil, errors.Wrapf(ctx.Err(), "waiting for the lock %s", path)
+		}
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			unlockFile(f)
+			f.Close()
+			<-lock
+		})
+	}, nil
+}
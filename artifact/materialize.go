@@ -0,0 +1,31 @@
+package artifact
+
+import (
+	"context"
+	"path/filepath"
+)
+
+// Materialize places the artifacts at their paths, calling fetch for the ones
+// that are not already there. It holds the lock of workDir throughout, so
+// concurrent materializations of a model, in this process or in others,
+// fetch every artifact once and wait for each other.
+func Materialize(ctx context.Context, workDir string, artifacts []Artifact, parallelism int, fetch func(context.Context, Artifact) error) error {
+	unlock, err := Lock(ctx, filepath.Join(workDir, LockFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return MaterializeLocked(ctx, artifacts, parallelism, fetch)
+}
+
+// MaterializeLocked is Materialize for callers that already hold the lock of
+// the work directory.
+func MaterializeLocked(ctx context.Context, artifacts []Artifact, parallelism int, fetch func(context.Context, Artifact) error) error {
+	var pending []Artifact
+	for _, a := range artifacts {
+		if !Present(a) {
+			pending = append(pending, a)
+		}
+	}
+	return Parallel(ctx, pending, parallelism, fetch)
+}
@@ -0,0 +1,153 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaterializeConcurrently(t *testing.T) {
+	files := map[string][]byte{
+		"/deploy.prototxt":    []byte("name: \"AlexNet\""),
+		"/alexnet.caffemodel": randomContent(512 << 10),
+		"/synset.txt":         []byte("n01440764 tench"),
+	}
+	var requests sync.Map
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := requests.LoadOrStore(r.URL.Path, new(int32))
+		atomic.AddInt32(n.(*int32), 1)
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		// slow enough for the loads to overlap
+		time.Sleep(20 * time.Millisecond)
+		w.Write(content)
+	}))
+	defer srv.Close()
+
+	workDir := tempDir(t)
+	defer os.RemoveAll(workDir)
+
+	var artifacts []Artifact
+	for name, kind := range map[string]string{"/deploy.prototxt": Graph, "/alexnet.caffemodel": Weights, "/synset.txt": Features} {
+		artifacts = append(artifacts, Artifact{
+			Kind:     kind,
+			URL:      srv.URL + name,
+			Checksum: md5sum(files[name]),
+			Path:     filepath.Join(workDir, filepath.Base(name)),
+		})
+	}
+
+	const loads = 32
+	var wg sync.WaitGroup
+	errs := make(chan error, loads)
+	for i := 0; i < loads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d := NewDownloader()
+			errs <- Materialize(context.Background(), workDir, artifacts, 2, func(ctx context.Context, a Artifact) error {
+				if err := d.Download(ctx, a, a.URL, a.Path); err != nil {
+					return err
+				}
+				return Verify(a)
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+
+	for name, content := range files {
+		n, ok := requests.Load(name)
+		if assert.True(t, ok, name) {
+			assert.Equal(t, int32(1), atomic.LoadInt32(n.(*int32)), "%s is downloaded once", name)
+		}
+		actual, err := ioutil.ReadFile(filepath.Join(workDir, filepath.Base(name)))
+		assert.NoError(t, err)
+		assert.Equal(t, content, actual)
+	}
+}
+
+func TestLock(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "model", LockFile)
+
+	unlock, err := Lock(context.Background(), path)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = Lock(ctx, path)
+	assert.Error(t, err, "the lock is exclusive within a process")
+
+	unlock()
+	unlock()
+	unlock, err = Lock(context.Background(), path)
+	assert.NoError(t, err)
+	unlock()
+}
+
+// TestLockHelperProcess holds the lock given in the environment until its
+// stdin is closed. It is run by TestLockAcrossProcesses.
+func TestLockHelperProcess(t *testing.T) {
+	path := os.Getenv("ARTIFACT_LOCK_HELPER")
+	if path == "" {
+		return
+	}
+	unlock, err := Lock(context.Background(), path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println("locked")
+	ioutil.ReadAll(os.Stdin)
+	unlock()
+	os.Exit(0)
+}
+
+func TestLockAcrossProcesses(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, LockFile)
+
+	cmd := exec.Command(os.Args[0], "-test.run=TestLockHelperProcess")
+	cmd.Env = append(os.Environ(), "ARTIFACT_LOCK_HELPER="+path)
+	stdin, err := cmd.StdinPipe()
+	assert.NoError(t, err)
+	stdout, err := cmd.StdoutPipe()
+	assert.NoError(t, err)
+	assert.NoError(t, cmd.Start())
+
+	buf := make([]byte, len("locked"))
+	_, err = stdout.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "locked", string(buf))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, err = Lock(ctx, path)
+	assert.Error(t, err, "the lock is held by the helper process")
+
+	stdin.Close()
+	unlock, err := Lock(context.Background(), path)
+	assert.NoError(t, err)
+	unlock()
+	assert.NoError(t, cmd.Wait())
+}
@@ -0,0 +1,142 @@
+package artifact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	olog "github.com/opentracing/opentracing-go/log"
+	"github.com/pkg/errors"
+)
+
+// MirrorRule rewrites URLs starting with Prefix to start with Replacement
+// instead.
+type MirrorRule struct {
+	Prefix      string
+	Replacement string
+}
+
+// ParseMirrorRule parses a rule written as `prefix=replacement`.
+func ParseMirrorRule(s string) (MirrorRule, error) {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+		return MirrorRule{}, errors.Errorf("invalid mirror rule %q, expected prefix=replacement", s)
+	}
+	return MirrorRule{
+		Prefix:      strings.TrimSpace(parts[0]),
+		Replacement: strings.TrimSpace(parts[1]),
+	}, nil
+}
+
+func (r MirrorRule) String() string {
+	return r.Prefix + "=" + r.Replacement
+}
+
+// Mirrors is an ordered list of mirror rules. Several rules with the same
+// prefix are fallbacks for one another.
+type Mirrors []MirrorRule
+
+// Candidates returns the URLs to try for url: the rewrites of every matching
+// rule in order, followed by url itself.
+func (m Mirrors) Candidates(url string) []string {
+	var urls []string
+	for _, rule := range m {
+		if !strings.HasPrefix(url, rule.Prefix) {
+			continue
+		}
+		rewritten := rule.Replacement + strings.TrimPrefix(url, rule.Prefix)
+		if !containsString(urls, rewritten) {
+			urls = append(urls, rewritten)
+		}
+	}
+	if !containsString(urls, url) {
+		urls = append(urls, url)
+	}
+	return urls
+}
+
+// AttemptError is the failure of one candidate URL of an artifact.
+type AttemptError struct {
+	URL string
+	Err error
+}
+
+func (e AttemptError) Error() string {
+	return e.URL + ": " + e.Err.Error()
+}
+
+// DownloadError is returned when no candidate URL of an artifact could be
+// fetched. It keeps the error of every attempt, in order.
+type DownloadError struct {
+	Artifact Artifact
+	Attempts []AttemptError
+}
+
+func (e *DownloadError) Error() string {
+	failures := make([]string, len(e.Attempts))
+	for ii, attempt := range e.Attempts {
+		failures[ii] = attempt.Error()
+	}
+	return fmt.Sprintf("failed to download %s %s:\n  %s", e.Artifact.Kind, e.Artifact.URL, strings.Join(failures, "\n  "))
+}
+
+// Cause returns the *ChecksumError of the first attempt that failed
+// verification, so IsChecksumError sees through the download, and the error
+// of the last attempt otherwise.
+func (e *DownloadError) Cause() error {
+	for _, attempt := range e.Attempts {
+		if IsChecksumError(attempt.Err) {
+			return errors.Cause(attempt.Err)
+		}
+	}
+	if len(e.Attempts) == 0 {
+		return nil
+	}
+	return e.Attempts[len(e.Attempts)-1].Err
+}
+
+// Unwrap is Cause for the errors package of the standard library.
+func (e *DownloadError) Unwrap() error {
+	return e.Cause()
+}
+
+// Do calls fetch with the candidate URLs of the artifact until one succeeds.
+// Every attempt is traced in its own span. When every candidate fails, the
+// error is a *DownloadError.
+func (m Mirrors) Do(ctx context.Context, a Artifact, fetch func(ctx context.Context, url string) error) error {
+	urls := m.Candidates(a.URL)
+	failed := &DownloadError{Artifact: a}
+	for i, url := range urls {
+		span, attemptCtx := opentracing.StartSpanFromContext(ctx, "download_attempt", opentracing.Tags{
+			"artifact": a.Kind,
+			"url":      url,
+			"attempt":  i + 1,
+			"mirror":   url != a.URL,
+		})
+		err := fetch(attemptCtx, url)
+		if err != nil {
+			ext.Error.Set(span, true)
+			span.LogFields(olog.Error(err))
+		}
+		span.Finish()
+		if err == nil {
+			return nil
+		}
+		failed.Attempts = append(failed.Attempts, AttemptError{URL: url, Err: err})
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return failed
+}
+
+func containsString(lst []string, s string) bool {
+	for _, e := range lst {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
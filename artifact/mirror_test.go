@@ -0,0 +1,136 @@
+package artifact
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMirrorRule(t *testing.T) {
+	rule, err := ParseMirrorRule(" http://s3.amazonaws.com/store.carml.org/ = http://mirror.lab/carml/ ")
+	assert.NoError(t, err)
+	assert.Equal(t, MirrorRule{Prefix: "http://s3.amazonaws.com/store.carml.org/", Replacement: "http://mirror.lab/carml/"}, rule)
+	assert.Equal(t, "http://s3.amazonaws.com/store.carml.org/=http://mirror.lab/carml/", rule.String())
+
+	for _, s := range []string{"", "http://s3.amazonaws.com", "=http://mirror.lab", "http://s3.amazonaws.com="} {
+		_, err := ParseMirrorRule(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestMirrorsCandidates(t *testing.T) {
+	mirrors := Mirrors{
+		{Prefix: "http://dl.caffe.berkeleyvision.org/", Replacement: "http://mirror-a.lab/caffe/"},
+		{Prefix: "http://s3.amazonaws.com/store.carml.org/", Replacement: "http://mirror-a.lab/carml/"},
+		{Prefix: "http://dl.caffe.berkeleyvision.org/", Replacement: "http://mirror-b.lab/caffe/"},
+	}
+	assert.Equal(t, []string{
+		"http://mirror-a.lab/caffe/bvlc_alexnet.caffemodel",
+		"http://mirror-b.lab/caffe/bvlc_alexnet.caffemodel",
+		"http://dl.caffe.berkeleyvision.org/bvlc_alexnet.caffemodel",
+	}, mirrors.Candidates("http://dl.caffe.berkeleyvision.org/bvlc_alexnet.caffemodel"))
+	assert.Equal(t, []string{
+		"https://raw.githubusercontent.com/BVLC/caffe/master/models/bvlc_alexnet/deploy.prototxt",
+	}, mirrors.Candidates("https://raw.githubusercontent.com/BVLC/caffe/master/models/bvlc_alexnet/deploy.prototxt"))
+	assert.Equal(t, []string{"http://example.com/a"}, Mirrors(nil).Candidates("http://example.com/a"))
+}
+
+func TestMirrorsFetch(t *testing.T) {
+	tracer := mocktracer.New()
+	opentracing.SetGlobalTracer(tracer)
+	defer opentracing.SetGlobalTracer(opentracing.NoopTracer{})
+
+	weights := []byte("weights")
+	gone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusGone)
+	}))
+	defer gone.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/caffe/bvlc_alexnet.caffemodel" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(weights)
+	}))
+	defer mirror.Close()
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the origin is not reached when a mirror has the artifact")
+	}))
+	defer origin.Close()
+
+	dir, err := ioutil.TempDir("", "artifact-mirrors")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewStore(dir)
+	store.Mirrors = Mirrors{
+		{Prefix: origin.URL + "/", Replacement: gone.URL + "/caffe/"},
+		{Prefix: origin.URL + "/", Replacement: mirror.URL + "/caffe/"},
+	}
+	a := Artifact{Kind: Weights, URL: origin.URL + "/bvlc_alexnet.caffemodel", Checksum: md5sum(weights)}
+	assert.NoError(t, store.Fetch(context.Background(), a))
+	assert.True(t, store.Has(a))
+
+	spans := tracer.FinishedSpans()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "download_attempt", spans[0].OperationName)
+		assert.Equal(t, gone.URL+"/caffe/bvlc_alexnet.caffemodel", spans[0].Tag("url"))
+		assert.Equal(t, true, spans[0].Tag("error"))
+		assert.Equal(t, 1, spans[0].Tag("attempt"))
+		assert.Equal(t, mirror.URL+"/caffe/bvlc_alexnet.caffemodel", spans[1].Tag("url"))
+		assert.Nil(t, spans[1].Tag("error"))
+		assert.Equal(t, true, spans[1].Tag("mirror"))
+	}
+
+	missing := Artifact{Kind: Graph, URL: origin.URL + "/missing.prototxt"}
+	store.Mirrors = Mirrors{{Prefix: origin.URL + "/", Replacement: gone.URL + "/"}}
+	origin.Config.Handler = http.NotFoundHandler()
+	err = store.Fetch(context.Background(), missing)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), gone.URL+"/missing.prototxt: 410 Gone")
+		assert.Contains(t, err.Error(), origin.URL+"/missing.prototxt: 404 Not Found")
+	}
+}
+
+func TestMirrorsChecksumError(t *testing.T) {
+	weights := []byte("weights")
+	corrupt := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("truncated"))
+	}))
+	defer corrupt.Close()
+	origin := httptest.NewServer(http.NotFoundHandler())
+	defer origin.Close()
+
+	dir, err := ioutil.TempDir("", "artifact-mirrors")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := NewStore(dir)
+	store.Mirrors = Mirrors{{Prefix: origin.URL + "/", Replacement: corrupt.URL + "/"}}
+	a := Artifact{Kind: Weights, URL: origin.URL + "/bvlc_alexnet.caffemodel", Checksum: md5sum(weights)}
+	err = store.Fetch(context.Background(), a)
+	assert.Error(t, err)
+	assert.True(t, IsChecksumError(err))
+
+	download, ok := err.(*DownloadError)
+	if assert.True(t, ok) {
+		assert.Equal(t, a, download.Artifact)
+		if assert.Len(t, download.Attempts, 2) {
+			assert.Equal(t, corrupt.URL+"/bvlc_alexnet.caffemodel", download.Attempts[0].URL)
+			assert.IsType(t, &ChecksumError{}, download.Attempts[0].Err)
+			assert.Contains(t, download.Attempts[1].Err.Error(), "404 Not Found")
+		}
+	}
+	cerr, ok := errors.Cause(err).(*ChecksumError)
+	if assert.True(t, ok) {
+		assert.Equal(t, a.URL, cerr.Artifact.URL)
+	}
+}
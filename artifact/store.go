@@ -0,0 +1,127 @@
+package artifact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Store is a local content addressed store of model artifacts. Artifacts are
+// keyed by their checksum, or by their URL when the manifest does not declare
+// a checksum.
+type Store struct {
+	Root string
+	// Mirrors are tried before the artifact URLs when fetching
+	Mirrors Mirrors
+	// Downloader fetches the artifacts, NewDownloader() when nil
+	Downloader *Downloader
+}
+
+// NewStore returns the store rooted at dir.
+func NewStore(dir string) *Store {
+	return &Store{Root: dir}
+}
+
+// Key returns the key an artifact is stored under.
+func Key(a Artifact) string {
+	if checksum, err := ParseChecksum(a.Checksum); err == nil {
+		return checksum.Algorithm + "/" + checksum.Hex
+	}
+	sum := sha256.Sum256([]byte(a.URL))
+	return "url/" + hex.EncodeToString(sum[:])
+}
+
+// Path returns where the artifact is kept in the store.
+func (s *Store) Path(a Artifact) string {
+	return filepath.Join(s.Root, filepath.FromSlash(Key(a)))
+}
+
+// Has reports whether the artifact is in the store.
+func (s *Store) Has(a Artifact) bool {
+	info, err := os.Stat(s.Path(a))
+	return err == nil && info.Mode().IsRegular()
+}
+
+// Put adds the content of r to the store as a. The content is verified
+// against the checksum of a before it becomes visible in the store, content
+// that does not match is quarantined.
+func (s *Store) Put(a Artifact, r io.Reader) error {
+	target := s.Path(a)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return errors.Wrapf(err, "cannot create the store directory for %s", a.Kind)
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(target), ".put-")
+	if err != nil {
+		return errors.Wrapf(err, "cannot add %s to the store", a.Kind)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, r)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot add %s to the store", a.Kind)
+	}
+	stored := a
+	stored.Path = tmp.Name()
+	// the contents of archives are verified once extracted
+	stored.Contents = nil
+	if err := Verify(stored); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+// Materialize places the stored artifact at a.Path. The file is hard linked
+// when the store and the work directory are on the same filesystem and
+// copied otherwise.
+func (s *Store) Materialize(a Artifact) error {
+	if err := os.MkdirAll(filepath.Dir(a.Path), 0755); err != nil {
+		return errors.Wrapf(err, "cannot create the directory of %s", a.Path)
+	}
+	os.Remove(a.Path)
+	if err := os.Link(s.Path(a), a.Path); err == nil {
+		return nil
+	}
+	src, err := os.Open(s.Path(a))
+	if err != nil {
+		return errors.Wrapf(err, "cannot read %s from the store", a.Kind)
+	}
+	defer src.Close()
+	dst, err := os.Create(a.Path)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create %s", a.Path)
+	}
+	_, err = io.Copy(dst, src)
+	if cerr := dst.Close(); err == nil {
+		err = cerr
+	}
+	return errors.Wrapf(err, "cannot copy %s to %s", a.Kind, a.Path)
+}
+
+// Resolve places every artifact of the model at its path from the store,
+// without touching the network. Artifacts missing from the store are
+// reported together in a *MissingError.
+func (s *Store) Resolve(model string, artifacts []Artifact) error {
+	var missing []Artifact
+	for _, a := range artifacts {
+		if !s.Has(a) {
+			missing = append(missing, a)
+		}
+	}
+	if len(missing) != 0 {
+		return &MissingError{Model: model, Artifacts: missing}
+	}
+	for _, a := range artifacts {
+		if err := s.Materialize(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package artifact
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func md5sum(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "artifact")
+	assert.NoError(t, err)
+	return dir
+}
+
+func TestStorePut(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	store := NewStore(dir)
+
+	graph := []byte("name: \"AlexNet\"")
+	a := Artifact{Kind: Graph, URL: "http://example.com/deploy.prototxt", Checksum: md5sum(graph)}
+	assert.False(t, store.Has(a))
+	assert.NoError(t, store.Put(a, bytes.NewReader(graph)))
+	assert.True(t, store.Has(a))
+	assert.Equal(t, filepath.Join(dir, "md5", a.Checksum), store.Path(a))
+
+	weights := []byte("weights")
+	sha := Artifact{Kind: Weights, URL: "http://example.com/alexnet.caffemodel", Checksum: "sha256:" + sha256sum(weights)}
+	assert.NoError(t, store.Put(sha, bytes.NewReader(weights)))
+	assert.Equal(t, filepath.Join(dir, "sha256", sha256sum(weights)), store.Path(sha))
+
+	bad := Artifact{Kind: Weights, URL: "http://example.com/alexnet.caffemodel", Checksum: md5sum(weights)}
+	err := store.Put(bad, bytes.NewReader([]byte("truncated")))
+	assert.True(t, IsChecksumError(err))
+	assert.Contains(t, err.Error(), "checksum mismatch")
+	assert.False(t, store.Has(bad))
+	quarantined, err := ioutil.ReadFile(err.(*ChecksumError).Quarantined)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("truncated"), quarantined)
+
+	// artifacts without a checksum are keyed by their url
+	features := Artifact{Kind: Features, URL: "http://example.com/synset.txt"}
+	assert.NoError(t, store.Put(features, bytes.NewReader([]byte("n01440764 tench"))))
+	assert.True(t, store.Has(features))
+	assert.Equal(t, "url", filepath.Base(filepath.Dir(store.Path(features))))
+}
+
+func TestStoreResolve(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	store := NewStore(filepath.Join(dir, "store"))
+	workDir := filepath.Join(dir, "work")
+
+	graph := []byte("name: \"AlexNet\"")
+	weights := []byte("weights")
+	artifacts := []Artifact{
+		{Kind: Graph, URL: "http://example.com/deploy.prototxt", Checksum: md5sum(graph), Path: filepath.Join(workDir, "deploy.prototxt")},
+		{Kind: Weights, URL: "http://example.com/alexnet.caffemodel", Checksum: md5sum(weights), Path: filepath.Join(workDir, "alexnet.caffemodel")},
+	}
+	assert.NoError(t, store.Put(artifacts[0], bytes.NewReader(graph)))
+
+	err := store.Resolve("BVLC-AlexNet:1.0", artifacts)
+	if assert.IsType(t, &MissingError{}, err) {
+		missing := err.(*MissingError)
+		assert.Equal(t, artifacts[1:], missing.Artifacts)
+		assert.Contains(t, err.Error(), "tensorrt-agent models fetch BVLC-AlexNet:1.0")
+		assert.Contains(t, err.Error(), "http://example.com/alexnet.caffemodel")
+		assert.NotContains(t, err.Error(), "deploy.prototxt")
+	}
+	_, err = os.Stat(artifacts[0].Path)
+	assert.True(t, os.IsNotExist(err), "nothing is placed while artifacts are missing")
+
+	assert.NoError(t, store.Put(artifacts[1], bytes.NewReader(weights)))
+	assert.NoError(t, store.Resolve("BVLC-AlexNet:1.0", artifacts))
+	for i, expected := range [][]byte{graph, weights} {
+		actual, err := ioutil.ReadFile(artifacts[i].Path)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func TestStoreFetch(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	store := NewStore(dir)
+
+	graph := []byte("name: \"AlexNet\"")
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/deploy.prototxt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(graph)
+	}))
+	defer srv.Close()
+
+	a := Artifact{Kind: Graph, URL: srv.URL + "/deploy.prototxt", Checksum: md5sum(graph)}
+	assert.NoError(t, store.Fetch(context.Background(), a))
+	assert.True(t, store.Has(a))
+	assert.NoError(t, store.Fetch(context.Background(), a))
+	assert.Equal(t, 1, requests, "stored artifacts are not downloaded again")
+
+	err := store.Fetch(context.Background(), Artifact{Kind: Weights, URL: srv.URL + "/missing"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
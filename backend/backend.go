@@ -0,0 +1,246 @@
+// Package backend is the interface the standalone inference servers of the
+// agent run predictions through. The TensorRT predictors implement it, and
+// package fake implements it without a GPU for tests.
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/memory"
+)
+
+// FP32 is the datatype of the tensors, named as in the KServe v2 protocol.
+const FP32 = "FP32"
+
+// Tensor is a batch of float32 values, the first dimension of Shape is the
+// batch size.
+type Tensor struct {
+	Name  string    `json:"name"`
+	Shape []int     `json:"shape"`
+	Data  []float32 `json:"data"`
+}
+
+// MaxSize bounds the number of values of a tensor, it is the number of
+// float32 values of the largest request the servers accept.
+var MaxSize = 16 << 20
+
+// Size returns the number of values the shape of the tensor holds, -1 when a
+// dimension is negative or the shape holds more than MaxSize values.
+func (t Tensor) Size() int {
+	size := 1
+	for _, d := range t.Shape {
+		if d < 0 || (d != 0 && size > MaxSize/d) {
+			return -1
+		}
+		size *= d
+	}
+	return size
+}
+
+// BatchSize returns the first dimension of the tensor.
+func (t Tensor) BatchSize() int {
+	if len(t.Shape) == 0 {
+		return 0
+	}
+	return t.Shape[0]
+}
+
+// TensorInfo describes an input or output of a model. Dimensions that vary,
+// such as the batch size, are -1.
+type TensorInfo struct {
+	Name     string `json:"name"`
+	Datatype string `json:"datatype"`
+	Shape    []int  `json:"shape"`
+}
+
+// Metadata describes a loaded model.
+type Metadata struct {
+	Name        string       `json:"name"`
+	Version     string       `json:"version"`
+	Description string       `json:"description,omitempty"`
+	Inputs      []TensorInfo `json:"inputs"`
+	Outputs     []TensorInfo `json:"outputs"`
+	// MaxBatchSize is the largest batch a prediction may hold, 0 when
+	// unbounded
+	MaxBatchSize int `json:"max_batch_size"`
+	// Labels name the classes of the first output of classification models
+	Labels []string `json:"-"`
+	// Image describes how images are turned into the first input, nil when
+	// the model does not take images
+	Image *ImageInput `json:"-"`
+	// EstimatedMemory is the estimated device memory of the model, nil when
+	// the backend does not account it
+	EstimatedMemory *memory.Usage `json:"estimated_memory,omitempty"`
+	// MeasuredMemory is the device memory the build of the engine took, in
+	// bytes, 0 when it was not measured
+	MeasuredMemory int64 `json:"measured_memory_bytes,omitempty"`
+}
+
+// Key returns the name:version key the model is looked up with.
+func (m Metadata) Key() string {
+	return Key(m.Name, m.Version)
+}
+
+// Key returns the name:version key of a model, ignoring case.
+func Key(name, version string) string {
+	return strings.ToLower(name + ":" + version)
+}
+
+// Backend runs the predictions of a loaded model. Implementations are safe
+// for concurrent use.
+type Backend interface {
+	Metadata() Metadata
+	// Predict runs one batch, of at most Metadata().MaxBatchSize elements
+	// when it is set, and returns one tensor per output of the model
+	Predict(ctx context.Context, inputs []Tensor) ([]Tensor, error)
+	Close() error
+}
+
+// ErrUnavailable is returned by backends that do not accept predictions
+// anymore, such as predictors being drained.
+var ErrUnavailable = errors.New("the model is unavailable")
+
+// InputError is returned for inputs that do not match the model.
+type InputError struct {
+	Message string
+}
+
+func (e *InputError) Error() string {
+	return e.Message
+}
+
+func inputErrorf(format string, args ...interface{}) error {
+	return &InputError{Message: fmt.Sprintf(format, args...)}
+}
+
+// IsInputError reports whether err is caused by invalid inputs.
+func IsInputError(err error) bool {
+	_, ok := errors.Cause(err).(*InputError)
+	return ok
+}
+
+// CheckInputs matches the inputs with the inputs of the model, in the order
+// of the model. An unnamed input is accepted for models with a single input.
+// Every input must have the same batch size, which is returned.
+func CheckInputs(m Metadata, inputs []Tensor) ([]Tensor, int, error) {
+	if len(inputs) != len(m.Inputs) {
+		return nil, 0, inputErrorf("model %s takes %d input(s), got %d", m.Name, len(m.Inputs), len(inputs))
+	}
+	ordered := make([]Tensor, len(m.Inputs))
+	batch := -1
+	for ii, info := range m.Inputs {
+		var input *Tensor
+		for jj := range inputs {
+			if inputs[jj].Name == info.Name || (inputs[jj].Name == "" && len(inputs) == 1) {
+				input = &inputs[jj]
+				break
+			}
+		}
+		if input == nil {
+			return nil, 0, inputErrorf("missing input %s", info.Name)
+		}
+		t := *input
+		t.Name = info.Name
+		if len(t.Shape) != len(info.Shape) {
+			return nil, 0, inputErrorf("input %s has shape %v, expected %v", info.Name, t.Shape, info.Shape)
+		}
+		for d, dim := range info.Shape {
+			if t.Shape[d] < 0 || (dim >= 0 && t.Shape[d] != dim) {
+				return nil, 0, inputErrorf("input %s has shape %v, expected %v", info.Name, t.Shape, info.Shape)
+			}
+		}
+		size := t.Size()
+		if size < 0 {
+			return nil, 0, inputErrorf("input %s has shape %v, which holds more than %d values", info.Name, t.Shape, MaxSize)
+		}
+		if len(t.Data) != size {
+			return nil, 0, inputErrorf("input %s has %d values, its shape %v holds %d", info.Name, len(t.Data), t.Shape, size)
+		}
+		if t.BatchSize() == 0 {
+			return nil, 0, inputErrorf("input %s is empty", info.Name)
+		}
+		if batch >= 0 && t.BatchSize() != batch {
+			return nil, 0, inputErrorf("the inputs have different batch sizes")
+		}
+		batch = t.BatchSize()
+		ordered[ii] = t
+	}
+	return ordered, batch, nil
+}
+
+// Predict checks the inputs and runs them through b, split in batches of at
+// most the maximum batch size of the model. The outputs of the batches are
+// joined.
+func Predict(ctx context.Context, b Backend, inputs []Tensor) ([]Tensor, error) {
+	outputs, _, err := PredictWithTimings(ctx, b, inputs)
+	return outputs, err
+}
+
+// PredictWithTimings is Predict, also returning the timings of the batches
+// summed, zero when b does not implement Timed.
+func PredictWithTimings(ctx context.Context, b Backend, inputs []Tensor) ([]Tensor, Timings, error) {
+	m := b.Metadata()
+	inputs, batch, err := CheckInputs(m, inputs)
+	if err != nil {
+		return nil, Timings{}, err
+	}
+	if m.MaxBatchSize <= 0 || batch <= m.MaxBatchSize {
+		return predictTimed(ctx, b, inputs)
+	}
+
+	var (
+		outputs []Tensor
+		timings Timings
+	)
+	for start := 0; start < batch; start += m.MaxBatchSize {
+		end := start + m.MaxBatchSize
+		if end > batch {
+			end = batch
+		}
+		chunk := make([]Tensor, len(inputs))
+		for ii, input := range inputs {
+			chunk[ii] = Slice(input, start, end)
+		}
+		out, t, err := predictTimed(ctx, b, chunk)
+		if err != nil {
+			return nil, Timings{}, err
+		}
+		timings = timings.Add(t)
+		if outputs == nil {
+			outputs = out
+			continue
+		}
+		for ii := range outputs {
+			outputs[ii] = Concat(outputs[ii], out[ii])
+		}
+	}
+	return outputs, timings, nil
+}
+
+// Slice returns the batch elements start to end of t.
+func Slice(t Tensor, start, end int) Tensor {
+	element := 1
+	for _, d := range t.Shape[1:] {
+		element *= d
+	}
+	shape := append([]int{end - start}, t.Shape[1:]...)
+	return Tensor{Name: t.Name, Shape: shape, Data: t.Data[start*element : end*element]}
+}
+
+// Concat joins batches of the same tensor.
+func Concat(tensors ...Tensor) Tensor {
+	first := tensors[0]
+	batch, size := 0, 0
+	for _, t := range tensors {
+		batch += t.BatchSize()
+		size += len(t.Data)
+	}
+	data := make([]float32, 0, size)
+	for _, t := range tensors {
+		data = append(data, t.Data...)
+	}
+	return Tensor{Name: first.Name, Shape: append([]int{batch}, first.Shape[1:]...), Data: data}
+}
@@ -0,0 +1,177 @@
+package backend
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"testing"
+	"time"
+
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+const imageManifest = `
+name: ResNet50_v1
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+description: |
+  ResNet-50
+inputs:
+  - type: image
+    parameters:
+      input_layer: data
+      dimensions: [3, 2, 2]
+      mean: [10, 20, 30]
+      scale: 2
+      color_mode: BGR
+output:
+  type: classification
+  parameters:
+    probabilities_layer: prob
+model: {graph_path: resnet50.plan}
+`
+
+func TestNewMetadata(t *testing.T) {
+	m, err := manifest.Parse([]byte(imageManifest))
+	assert.NoError(t, err)
+	md, err := NewMetadata(m)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "resnet50_v1:1.0", md.Key())
+	assert.Equal(t, "ResNet-50", md.Description)
+	assert.Equal(t, []TensorInfo{{Name: "data", Datatype: FP32, Shape: []int{-1, 3, 2, 2}}}, md.Inputs)
+	assert.Equal(t, []TensorInfo{{Name: "prob", Datatype: FP32, Shape: []int{-1, -1}}}, md.Outputs)
+	if assert.NotNil(t, md.Image) {
+		assert.Equal(t, []float32{10, 20, 30}, md.Image.Mean)
+		assert.Equal(t, []float32{2, 2, 2}, md.Image.Scale)
+		assert.Equal(t, BGR, md.Image.ColorMode)
+		assert.Equal(t, CHW, md.Image.Layout)
+	}
+
+	m.Inputs[0].Parameters["mean"] = []interface{}{1, 2}
+	_, err = NewMetadata(m)
+	assert.Error(t, err)
+}
+
+func TestImageTensor(t *testing.T) {
+	in := ImageInput{Channels: 3, Height: 2, Width: 2, Mean: []float32{10, 20, 30}, Scale: []float32{2, 2, 2}, ColorMode: BGR, Layout: CHW}
+
+	// a 4x4 image resized to 2x2 keeps the color of each quadrant
+	img := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(100 * (x / 2)), G: 50, B: uint8(100 * (y / 2)), A: 255})
+		}
+	}
+	tensor := in.Tensor("data", []image.Image{img, img})
+	assert.Equal(t, []int{2, 3, 2, 2}, tensor.Shape)
+	assert.Len(t, tensor.Data, 24)
+
+	// blue first, then green and red, each plane row by row
+	expected := []float32{
+		(0 - 10) / 2, (0 - 10) / 2, (100 - 10) / 2, (100 - 10) / 2,
+		(50 - 20) / 2, (50 - 20) / 2, (50 - 20) / 2, (50 - 20) / 2,
+		(0 - 30) / 2, (100 - 30) / 2, (0 - 30) / 2, (100 - 30) / 2,
+	}
+	assert.InDeltaSlice(t, expected, tensor.Data[:12], 1e-4)
+	assert.Equal(t, tensor.Data[:12], tensor.Data[12:])
+
+	in.Layout = HWC
+	tensor = in.Tensor("data", []image.Image{img})
+	assert.Equal(t, []int{1, 2, 2, 3}, tensor.Shape)
+	assert.InDeltaSlice(t, []float32{-5, 15, -15}, tensor.Data[:3], 1e-4)
+}
+
+type echoBackend struct {
+	metadata Metadata
+	batches  []int
+}
+
+func (b *echoBackend) Metadata() Metadata { return b.metadata }
+func (b *echoBackend) Close() error       { return nil }
+func (b *echoBackend) Predict(ctx context.Context, inputs []Tensor) ([]Tensor, error) {
+	b.batches = append(b.batches, inputs[0].BatchSize())
+	return []Tensor{{Name: "out", Shape: inputs[0].Shape, Data: inputs[0].Data}}, nil
+}
+
+func TestPredict(t *testing.T) {
+	b := &echoBackend{metadata: Metadata{
+		Name:         "m",
+		Inputs:       []TensorInfo{{Name: "data", Datatype: FP32, Shape: []int{-1, 2}}},
+		Outputs:      []TensorInfo{{Name: "out", Datatype: FP32, Shape: []int{-1, 2}}},
+		MaxBatchSize: 2,
+	}}
+	input := Tensor{Shape: []int{5, 2}, Data: []float32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}}
+	outputs, err := Predict(context.Background(), b, []Tensor{input})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 2, 1}, b.batches)
+	if assert.Len(t, outputs, 1) {
+		assert.Equal(t, []int{5, 2}, outputs[0].Shape)
+		assert.Equal(t, input.Data, outputs[0].Data)
+	}
+
+	for _, inputs := range [][]Tensor{
+		{},
+		{{Name: "other", Shape: []int{1, 2}, Data: []float32{0, 1}}},
+		{{Name: "data", Shape: []int{1, 3}, Data: []float32{0, 1, 2}}},
+		{{Name: "data", Shape: []int{2, 2}, Data: []float32{0, 1}}},
+		{{Name: "data", Shape: []int{0, 2}}},
+	} {
+		_, err := Predict(context.Background(), b, inputs)
+		assert.True(t, IsInputError(err), "%v", inputs)
+	}
+}
+
+func TestPredictOverflowingShape(t *testing.T) {
+	b := &echoBackend{metadata: Metadata{
+		Name:         "m",
+		Inputs:       []TensorInfo{{Name: "data", Datatype: FP32, Shape: []int{-1, 3, 2, 2}}},
+		Outputs:      []TensorInfo{{Name: "out", Datatype: FP32, Shape: []int{-1, 3, 2, 2}}},
+		MaxBatchSize: 2,
+	}}
+	// the product of the dimensions wraps around to 0, the number of values
+	input := Tensor{Name: "data", Shape: []int{1 << 62, 3, 2, 2}}
+	assert.Equal(t, -1, input.Size())
+	_, err := Predict(context.Background(), b, []Tensor{input})
+	assert.True(t, IsInputError(err))
+	assert.Empty(t, b.batches)
+
+	assert.Equal(t, -1, Tensor{Shape: []int{MaxSize + 1}}.Size())
+	assert.Equal(t, -1, Tensor{Shape: []int{-2, -3}}.Size())
+	assert.Equal(t, 0, Tensor{Shape: []int{0, 1 << 62}}.Size())
+}
+
+// timedBackend is an echoBackend timing every batch with timings.
+type timedBackend struct {
+	echoBackend
+	timings Timings
+}
+
+func (b *timedBackend) PredictTimed(ctx context.Context, inputs []Tensor) ([]Tensor, Timings, error) {
+	outputs, err := b.Predict(ctx, inputs)
+	return outputs, b.timings, err
+}
+
+func TestPredictWithTimings(t *testing.T) {
+	metadata := Metadata{
+		Name:         "m",
+		Inputs:       []TensorInfo{{Name: "data", Datatype: FP32, Shape: []int{-1, 2}}},
+		Outputs:      []TensorInfo{{Name: "out", Datatype: FP32, Shape: []int{-1, 2}}},
+		MaxBatchSize: 2,
+	}
+	input := Tensor{Shape: []int{5, 2}, Data: []float32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}}
+
+	b := &timedBackend{echoBackend: echoBackend{metadata: metadata}, timings: Timings{Compute: 10, DeviceToHost: 2}}
+	outputs, timings, err := PredictWithTimings(context.Background(), b, []Tensor{input})
+	assert.NoError(t, err)
+	assert.Equal(t, input.Data, outputs[0].Data)
+	// the timings of the split batches add up
+	assert.Equal(t, Timings{Compute: 30, DeviceToHost: 6}, timings)
+	assert.Equal(t, time.Duration(36), timings.Total())
+
+	// backends that do not time their predictions report zero timings
+	_, timings, err = PredictWithTimings(context.Background(), &echoBackend{metadata: metadata}, []Tensor{input})
+	assert.NoError(t, err)
+	assert.True(t, timings.IsZero())
+}
@@ -0,0 +1,159 @@
+// Package fake implements backend.Backend without TensorRT or a GPU. Its
+// predictions are synthetic but deterministic, so the servers of the agent
+// can be tested end to end.
+package fake
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// DefaultClasses is the size of the outputs of models without labels.
+const DefaultClasses = 10
+
+// DefaultMaxBatchSize ...
+const DefaultMaxBatchSize = 8
+
+// ErrClosed is returned by predictions on a closed backend.
+var ErrClosed = errors.New("the fake backend is closed")
+
+// Backend classifies every batch element into the class Class returns for
+// it, with a probability of 0.9.
+type Backend struct {
+	// Delay is added to every prediction
+	Delay time.Duration
+	// Err, when set, is returned by every prediction
+	Err error
+	// Timings are reported as the synthetic timings of every batch
+	Timings backend.Timings
+
+	metadata backend.Metadata
+	classes  int
+
+	mu      sync.Mutex
+	batches []int
+	closed  bool
+}
+
+// New returns a fake backend for the model described by metadata. Outputs
+// of unknown size get one value per label, or DefaultClasses.
+func New(metadata backend.Metadata) *Backend {
+	classes := len(metadata.Labels)
+	if classes == 0 {
+		classes = DefaultClasses
+	}
+	metadata.Outputs = append([]backend.TensorInfo(nil), metadata.Outputs...)
+	for ii, output := range metadata.Outputs {
+		if len(output.Shape) == 2 && output.Shape[1] < 0 {
+			metadata.Outputs[ii].Shape = []int{-1, classes}
+		}
+	}
+	return &Backend{metadata: metadata, classes: classes}
+}
+
+// NewFromManifest returns a fake backend for the model of a manifest, with
+// a maximum batch size of DefaultMaxBatchSize.
+func NewFromManifest(m *manifest.Manifest) (*Backend, error) {
+	metadata, err := backend.NewMetadata(m)
+	if err != nil {
+		return nil, err
+	}
+	metadata.MaxBatchSize = DefaultMaxBatchSize
+	return New(metadata), nil
+}
+
+// Metadata ...
+func (b *Backend) Metadata() backend.Metadata {
+	return b.metadata
+}
+
+// Class returns the class a batch element is classified into: the integer
+// part of the absolute sum of its values, modulo the number of classes.
+func Class(element []float32, classes int) int {
+	var sum float64
+	for _, v := range element {
+		sum += float64(v)
+	}
+	return int(math.Abs(sum)) % classes
+}
+
+// Predict ...
+func (b *Backend) Predict(ctx context.Context, inputs []backend.Tensor) ([]backend.Tensor, error) {
+	outputs, _, err := b.PredictTimed(ctx, inputs)
+	return outputs, err
+}
+
+// PredictTimed implements backend.Timed, with the timings of the backend.
+func (b *Backend) PredictTimed(ctx context.Context, inputs []backend.Tensor) ([]backend.Tensor, backend.Timings, error) {
+	b.mu.Lock()
+	closed := b.closed
+	b.mu.Unlock()
+	if closed {
+		return nil, backend.Timings{}, ErrClosed
+	}
+	inputs, batch, err := backend.CheckInputs(b.metadata, inputs)
+	if err != nil {
+		return nil, backend.Timings{}, err
+	}
+	if b.metadata.MaxBatchSize > 0 && batch > b.metadata.MaxBatchSize {
+		return nil, backend.Timings{}, errors.Errorf("batch of %d is larger than the maximum batch size %d", batch, b.metadata.MaxBatchSize)
+	}
+	if b.Delay > 0 {
+		select {
+		case <-time.After(b.Delay):
+		case <-ctx.Done():
+			return nil, backend.Timings{}, ctx.Err()
+		}
+	}
+	if b.Err != nil {
+		return nil, backend.Timings{}, b.Err
+	}
+
+	b.mu.Lock()
+	b.batches = append(b.batches, batch)
+	b.mu.Unlock()
+
+	first := inputs[0]
+	element := len(first.Data) / batch
+	outputs := make([]backend.Tensor, len(b.metadata.Outputs))
+	for ii, info := range b.metadata.Outputs {
+		data := make([]float32, batch*b.classes)
+		for e := 0; e < batch; e++ {
+			probs := data[e*b.classes : (e+1)*b.classes]
+			for c := range probs {
+				probs[c] = 0.1 / float32(max(b.classes-1, 1))
+			}
+			probs[Class(first.Data[e*element:(e+1)*element], b.classes)] = 0.9
+		}
+		outputs[ii] = backend.Tensor{Name: info.Name, Shape: []int{batch, b.classes}, Data: data}
+	}
+	return outputs, b.Timings, nil
+}
+
+// Batches returns the batch sizes of the predictions run so far.
+func (b *Backend) Batches() []int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]int(nil), b.batches...)
+}
+
+// Close ...
+func (b *Backend) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	return nil
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
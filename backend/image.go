@@ -0,0 +1,189 @@
+package backend
+
+import (
+	"image"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Color modes and layouts of image inputs ...
+const (
+	RGB = "RGB"
+	BGR = "BGR"
+	CHW = "CHW"
+	HWC = "HWC"
+)
+
+// ImageInput describes how images are turned into the input tensor of a
+// model: they are resized, their channels ordered by ColorMode, Mean is
+// subtracted from them and they are divided by Scale.
+type ImageInput struct {
+	Channels int
+	Height   int
+	Width    int
+	// Mean and Scale have one value per channel
+	Mean      []float32
+	Scale     []float32
+	ColorMode string
+	Layout    string
+}
+
+func newImageInput(params map[string]interface{}, dims []int) (*ImageInput, error) {
+	if len(dims) != 3 {
+		return nil, errors.Errorf("image dimensions %v are not channels, height and width", dims)
+	}
+	in := &ImageInput{
+		Channels:  dims[0],
+		Height:    dims[1],
+		Width:     dims[2],
+		ColorMode: strings.ToUpper(parameter(params, "color_mode")),
+		Layout:    strings.ToUpper(parameter(params, "layout")),
+	}
+	if in.Channels != 1 && in.Channels != 3 {
+		return nil, errors.Errorf("images with %d channels are not supported", in.Channels)
+	}
+	switch in.ColorMode {
+	case "":
+		in.ColorMode = RGB
+	case RGB, BGR:
+	default:
+		return nil, errors.Errorf("color mode %s is not supported", in.ColorMode)
+	}
+	switch in.Layout {
+	case "":
+		in.Layout = CHW
+	case CHW, HWC:
+	default:
+		return nil, errors.Errorf("layout %s is not supported", in.Layout)
+	}
+
+	var err error
+	if in.Mean, err = perChannel(params["mean"], in.Channels, 0); err != nil {
+		return nil, errors.Wrap(err, "invalid mean")
+	}
+	if in.Scale, err = perChannel(params["scale"], in.Channels, 1); err != nil {
+		return nil, errors.Wrap(err, "invalid scale")
+	}
+	for _, s := range in.Scale {
+		if s == 0 {
+			return nil, errors.New("invalid scale 0")
+		}
+	}
+	return in, nil
+}
+
+func perChannel(v interface{}, channels int, def float32) ([]float32, error) {
+	fs, err := floats(v)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fs) {
+	case 0:
+		fs = []float32{def}
+		fallthrough
+	case 1:
+		for len(fs) < channels {
+			fs = append(fs, fs[0])
+		}
+		return fs, nil
+	case channels:
+		return fs, nil
+	}
+	return nil, errors.Errorf("%d values for %d channels", len(fs), channels)
+}
+
+// Shape returns the shape of one image in the input tensor.
+func (in ImageInput) Shape() []int {
+	if in.Layout == HWC {
+		return []int{in.Height, in.Width, in.Channels}
+	}
+	return []int{in.Channels, in.Height, in.Width}
+}
+
+// Tensor turns the images into a batch input tensor named name.
+func (in ImageInput) Tensor(name string, images []image.Image) Tensor {
+	size := in.Channels * in.Height * in.Width
+	data := make([]float32, len(images)*size)
+	for ii, img := range images {
+		in.fill(data[ii*size:(ii+1)*size], img)
+	}
+	return Tensor{Name: name, Shape: append([]int{len(images)}, in.Shape()...), Data: data}
+}
+
+func (in ImageInput) fill(data []float32, img image.Image) {
+	plane := in.Height * in.Width
+	for y := 0; y < in.Height; y++ {
+		for x := 0; x < in.Width; x++ {
+			pixel := in.sample(img, x, y)
+			for c := 0; c < in.Channels; c++ {
+				v := (pixel[c] - in.Mean[c]) / in.Scale[c]
+				if in.Layout == HWC {
+					data[(y*in.Width+x)*in.Channels+c] = v
+				} else {
+					data[c*plane+y*in.Width+x] = v
+				}
+			}
+		}
+	}
+}
+
+// sample returns the channels of the pixel at x, y of the resized image,
+// interpolated bilinearly, in the 0-255 range.
+func (in ImageInput) sample(img image.Image, x, y int) [3]float32 {
+	b := img.Bounds()
+	fx := (float32(x)+0.5)*float32(b.Dx())/float32(in.Width) - 0.5
+	fy := (float32(y)+0.5)*float32(b.Dy())/float32(in.Height) - 0.5
+	x0, y0 := clamp(int(floor(fx)), b.Dx()), clamp(int(floor(fy)), b.Dy())
+	x1, y1 := clamp(x0+1, b.Dx()), clamp(y0+1, b.Dy())
+	wx, wy := fx-floor(fx), fy-floor(fy)
+	if fx < 0 {
+		wx = 0
+	}
+	if fy < 0 {
+		wy = 0
+	}
+
+	var rgb [3]float32
+	corners := [4]struct {
+		x, y int
+		w    float32
+	}{
+		{x0, y0, (1 - wx) * (1 - wy)},
+		{x1, y0, wx * (1 - wy)},
+		{x0, y1, (1 - wx) * wy},
+		{x1, y1, wx * wy},
+	}
+	for _, c := range corners {
+		r, g, bl, _ := img.At(b.Min.X+c.x, b.Min.Y+c.y).RGBA()
+		rgb[0] += c.w * float32(r>>8)
+		rgb[1] += c.w * float32(g>>8)
+		rgb[2] += c.w * float32(bl>>8)
+	}
+
+	switch {
+	case in.Channels == 1:
+		rgb[0] = 0.299*rgb[0] + 0.587*rgb[1] + 0.114*rgb[2]
+	case in.ColorMode == BGR:
+		rgb[0], rgb[2] = rgb[2], rgb[0]
+	}
+	return rgb
+}
+
+func floor(f float32) float32 {
+	i := float32(int(f))
+	if i > f {
+		i--
+	}
+	return i
+}
+
+func clamp(i, n int) int {
+	if i < 0 {
+		return 0
+	}
+	if i >= n {
+		return n - 1
+	}
+	return i
+}
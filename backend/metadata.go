@@ -0,0 +1,116 @@
+package backend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// Default layer names, used when the manifest does not name them.
+var (
+	DefaultInputLayerName  = "data"
+	DefaultOutputLayerName = "prob"
+)
+
+// NewMetadata describes the model of a manifest. The size of the outputs is
+// not known before the model is loaded, it is left at -1, and MaxBatchSize
+// is left for the backend to set.
+func NewMetadata(m *manifest.Manifest) (Metadata, error) {
+	md := Metadata{
+		Name:        m.Name,
+		Version:     m.Version,
+		Description: strings.TrimSpace(m.Description),
+	}
+	for ii, input := range m.Inputs {
+		name := parameter(input.Parameters, "input_layer")
+		if name == "" {
+			name = DefaultInputLayerName
+			if len(m.Inputs) > 1 {
+				name = fmt.Sprintf("%s_%d", DefaultInputLayerName, ii)
+			}
+		}
+		dims, err := ints(input.Parameters["dimensions"])
+		if err != nil {
+			return Metadata{}, errors.Wrapf(err, "input %s of %s has invalid dimensions", name, m.CanonicalName())
+		}
+		md.Inputs = append(md.Inputs, TensorInfo{Name: name, Datatype: FP32, Shape: append([]int{-1}, dims...)})
+
+		if ii == 0 && strings.EqualFold(input.Type, "image") {
+			image, err := newImageInput(input.Parameters, dims)
+			if err != nil {
+				return Metadata{}, errors.Wrapf(err, "input %s of %s", name, m.CanonicalName())
+			}
+			md.Image = image
+		}
+	}
+
+	name := parameter(m.Output.Parameters, "probabilities_layer")
+	if name == "" {
+		name = DefaultOutputLayerName
+	}
+	md.Outputs = []TensorInfo{{Name: name, Datatype: FP32, Shape: []int{-1, -1}}}
+	return md, nil
+}
+
+func parameter(params map[string]interface{}, name string) string {
+	v, ok := params[name]
+	if !ok || v == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(v))
+}
+
+// floats reads a number or a list of numbers from a manifest parameter.
+func floats(v interface{}) ([]float32, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		var fs []float32
+		for _, e := range v {
+			f, err := float(e)
+			if err != nil {
+				return nil, err
+			}
+			fs = append(fs, f)
+		}
+		return fs, nil
+	default:
+		f, err := float(v)
+		if err != nil {
+			return nil, err
+		}
+		return []float32{f}, nil
+	}
+}
+
+func float(v interface{}) (float32, error) {
+	switch v := v.(type) {
+	case int:
+		return float32(v), nil
+	case float64:
+		return float32(v), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 32)
+		return float32(f), errors.Wrapf(err, "%q is not a number", v)
+	}
+	return 0, errors.Errorf("%v is not a number", v)
+}
+
+func ints(v interface{}) ([]int, error) {
+	fs, err := floats(v)
+	if err != nil {
+		return nil, err
+	}
+	var is []int
+	for _, f := range fs {
+		if f != float32(int(f)) || f <= 0 {
+			return nil, errors.Errorf("%v is not a positive integer", f)
+		}
+		is = append(is, int(f))
+	}
+	return is, nil
+}
@@ -0,0 +1,52 @@
+package backend
+
+import (
+	"context"
+	"time"
+)
+
+// Timings break the inference of a prediction down into the execution of the
+// engine and the copy of the outputs back to the host.
+//
+// The copy of the inputs to the device is not timed apart: go-tensorrt runs
+// it and the execution in one call, with no event between them, so there is
+// no host to device stage and Compute includes the copy.
+type Timings struct {
+	// Compute is the copy of the inputs to the device and the execution
+	Compute      time.Duration `json:"compute_ns"`
+	DeviceToHost time.Duration `json:"device_to_host_ns"`
+}
+
+// Total ...
+func (t Timings) Total() time.Duration {
+	return t.Compute + t.DeviceToHost
+}
+
+// IsZero reports whether no stage was timed.
+func (t Timings) IsZero() bool {
+	return t == Timings{}
+}
+
+// Add returns the sum of the timings, for predictions split into batches.
+func (t Timings) Add(o Timings) Timings {
+	return Timings{
+		Compute:      t.Compute + o.Compute,
+		DeviceToHost: t.DeviceToHost + o.DeviceToHost,
+	}
+}
+
+// Timed is implemented by backends that time the stages of their
+// predictions.
+type Timed interface {
+	// PredictTimed is Predict, also returning the timings of the batch
+	PredictTimed(ctx context.Context, inputs []Tensor) ([]Tensor, Timings, error)
+}
+
+// predictTimed runs one batch on b, with its timings when b times them.
+func predictTimed(ctx context.Context, b Backend, inputs []Tensor) ([]Tensor, Timings, error) {
+	if timed, ok := b.(Timed); ok {
+		return timed.PredictTimed(ctx, inputs)
+	}
+	outputs, err := b.Predict(ctx, inputs)
+	return outputs, Timings{}, err
+}
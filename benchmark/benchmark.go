@@ -0,0 +1,278 @@
+// Package benchmark measures the latency and throughput of a model over a
+// sweep of precisions, batch sizes and concurrency levels, and reports them
+// with a stable schema as JSON or CSV.
+package benchmark
+
+import (
+	"context"
+	"image"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+)
+
+// Loader loads the model at a precision, for batches of batchSize elements.
+type Loader func(ctx context.Context, precision string, batchSize int) (backend.Backend, error)
+
+// Source generates the inputs of the predictions.
+type Source interface {
+	// Name is reported as the inputs of the report
+	Name() string
+	// Batch returns the input tensors of a batch of batchSize elements
+	Batch(m backend.Metadata, batchSize int) ([]backend.Tensor, error)
+}
+
+// Config is the sweep of a benchmark.
+type Config struct {
+	Precisions  []string
+	BatchSizes  []int
+	Concurrency []int
+	// Warmup is the number of predictions run after each load, before
+	// measuring
+	Warmup int
+	// Iterations is the number of predictions measured per result, shared
+	// between the concurrent workers
+	Iterations int
+	Inputs     Source
+	// Progress, when set, is called with every result as it is measured
+	Progress func(Result)
+}
+
+func (c Config) validate() error {
+	if len(c.Precisions) == 0 || len(c.BatchSizes) == 0 || len(c.Concurrency) == 0 {
+		return errors.New("the sweep needs at least one precision, batch size and concurrency level")
+	}
+	for _, n := range append(append([]int(nil), c.BatchSizes...), c.Concurrency...) {
+		if n <= 0 {
+			return errors.Errorf("invalid batch size or concurrency %d", n)
+		}
+	}
+	if c.Iterations <= 0 {
+		return errors.New("the benchmark needs at least one iteration")
+	}
+	if c.Warmup < 0 {
+		return errors.New("invalid number of warmup predictions")
+	}
+	if c.Inputs == nil {
+		return errors.New("the benchmark has no inputs")
+	}
+	return nil
+}
+
+// Run loads the model once per precision and batch size with load, warms it
+// up and measures it at every concurrency level. Failed loads and
+// predictions are reported in the results, Run only fails when the sweep
+// is invalid or ctx is done.
+func Run(ctx context.Context, cfg Config, load Loader) (*Report, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	report := &Report{
+		SchemaVersion: SchemaVersion,
+		Inputs:        cfg.Inputs.Name(),
+		Warmup:        cfg.Warmup,
+		Iterations:    cfg.Iterations,
+		StartedAt:     time.Now().UTC(),
+		Results:       []Result{},
+	}
+	for _, precision := range cfg.Precisions {
+		for _, batchSize := range cfg.BatchSizes {
+			results := sweep(ctx, cfg, load, precision, batchSize, report)
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			for _, r := range results {
+				if cfg.Progress != nil {
+					cfg.Progress(r)
+				}
+				report.Results = append(report.Results, r)
+			}
+		}
+	}
+	return report, nil
+}
+
+// sweep measures one loaded model at every concurrency level.
+func sweep(ctx context.Context, cfg Config, load Loader, precision string, batchSize int, report *Report) []Result {
+	results := make([]Result, len(cfg.Concurrency))
+	for ii, concurrency := range cfg.Concurrency {
+		results[ii] = Result{Precision: precision, BatchSize: batchSize, Concurrency: concurrency}
+	}
+	fail := func(err error) []Result {
+		for ii := range results {
+			results[ii].Error = err.Error()
+		}
+		return results
+	}
+
+	b, err := load(ctx, precision, batchSize)
+	if err != nil {
+		return fail(errors.Wrap(err, "cannot load the model"))
+	}
+	defer b.Close()
+	m := b.Metadata()
+	report.Model, report.Version = m.Name, m.Version
+	var estimated int64
+	if m.EstimatedMemory != nil {
+		estimated = m.EstimatedMemory.Total()
+	}
+
+	inputs, err := cfg.Inputs.Batch(m, batchSize)
+	if err != nil {
+		return fail(errors.Wrap(err, "cannot generate the inputs"))
+	}
+	for ii := 0; ii < cfg.Warmup; ii++ {
+		if _, err := backend.Predict(ctx, b, inputs); err != nil {
+			return fail(errors.Wrap(err, "warmup failed"))
+		}
+	}
+	for ii := range results {
+		results[ii].EstimatedMemoryBytes = estimated
+		results[ii].MeasuredMemoryBytes = m.MeasuredMemory
+		measure(ctx, b, inputs, cfg.Iterations, &results[ii])
+	}
+	return results
+}
+
+// measure runs iterations predictions of inputs on r.Concurrency workers.
+func measure(ctx context.Context, b backend.Backend, inputs []backend.Tensor, iterations int, r *Result) {
+	var (
+		mu        sync.Mutex
+		latencies = make([]time.Duration, 0, iterations)
+		next      = 0
+		firstErr  error
+		wg        sync.WaitGroup
+	)
+	// take returns whether another prediction is to be run
+	take := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		if next >= iterations || firstErr != nil {
+			return false
+		}
+		next++
+		return true
+	}
+
+	start := time.Now()
+	for w := 0; w < r.Concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for take() {
+				t := time.Now()
+				_, err := backend.Predict(ctx, b, inputs)
+				d := time.Since(t)
+				mu.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				if err == nil {
+					latencies = append(latencies, d)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	if firstErr != nil {
+		r.Error = errors.Wrap(firstErr, "prediction failed").Error()
+	}
+	r.Predictions = len(latencies)
+	if len(latencies) == 0 {
+		return
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	var total time.Duration
+	for _, l := range latencies {
+		total += l
+	}
+	r.LatencyMean = milliseconds(total / time.Duration(len(latencies)))
+	r.LatencyP50 = milliseconds(Percentile(latencies, 50))
+	r.LatencyP90 = milliseconds(Percentile(latencies, 90))
+	r.LatencyP99 = milliseconds(Percentile(latencies, 99))
+	r.Throughput = float64(len(latencies)*r.BatchSize) / elapsed.Seconds()
+}
+
+// Percentile returns the p-th percentile of sorted with the nearest-rank
+// method, 0 when sorted is empty.
+func Percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// Synthetic generates inputs of uniformly random values in [0, 1), the same
+// for every batch of a size.
+type Synthetic struct {
+	Seed int64
+}
+
+// Name ...
+func (Synthetic) Name() string {
+	return "synthetic"
+}
+
+// Batch ...
+func (s Synthetic) Batch(m backend.Metadata, batchSize int) ([]backend.Tensor, error) {
+	rng := rand.New(rand.NewSource(s.Seed))
+	inputs := make([]backend.Tensor, len(m.Inputs))
+	for ii, info := range m.Inputs {
+		shape := append([]int{batchSize}, info.Shape[1:]...)
+		size := 1
+		for _, d := range shape {
+			if d < 0 {
+				return nil, errors.Errorf("input %s has a dynamic shape %v, synthetic inputs need static ones", info.Name, info.Shape)
+			}
+			size *= d
+		}
+		data := make([]float32, size)
+		for jj := range data {
+			data[jj] = rng.Float32()
+		}
+		inputs[ii] = backend.Tensor{Name: info.Name, Shape: shape, Data: data}
+	}
+	return inputs, nil
+}
+
+// Images generates inputs from images, repeated to fill the batches.
+type Images []image.Image
+
+// Name ...
+func (Images) Name() string {
+	return "images"
+}
+
+// Batch ...
+func (images Images) Batch(m backend.Metadata, batchSize int) ([]backend.Tensor, error) {
+	if m.Image == nil {
+		return nil, errors.Errorf("model %s does not take images", m.Key())
+	}
+	if len(images) == 0 {
+		return nil, errors.New("no images to benchmark with")
+	}
+	batch := make([]image.Image, batchSize)
+	for ii := range batch {
+		batch[ii] = images[ii%len(images)]
+	}
+	return []backend.Tensor{m.Image.Tensor(m.Inputs[0].Name, batch)}, nil
+}
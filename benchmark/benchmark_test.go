@@ -0,0 +1,251 @@
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"image"
+	"image/color"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/backend/fake"
+	"github.com/rai-project/tensorrt/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func metadata(batchSize int) backend.Metadata {
+	return backend.Metadata{
+		Name:         "ResNet50_v1",
+		Version:      "1.0",
+		Inputs:       []backend.TensorInfo{{Name: "data", Datatype: backend.FP32, Shape: []int{-1, 3, 2, 2}}},
+		Outputs:      []backend.TensorInfo{{Name: "prob", Datatype: backend.FP32, Shape: []int{-1, -1}}},
+		MaxBatchSize: batchSize,
+		Image: &backend.ImageInput{
+			Channels: 3, Height: 2, Width: 2,
+			Mean: []float32{0, 0, 0}, Scale: []float32{255, 255, 255},
+			ColorMode: backend.RGB, Layout: backend.CHW,
+		},
+		EstimatedMemory: &memory.Usage{Engine: 1000, Workspace: 100, Bindings: []memory.Binding{{Name: "data", Bytes: int64(batchSize * 48)}}},
+		MeasuredMemory:  int64(900 + batchSize*64),
+	}
+}
+
+// fakeLoader loads fake backends, int8 is refused.
+type fakeLoader struct {
+	mu     sync.Mutex
+	loaded []*fake.Backend
+}
+
+func (l *fakeLoader) load(ctx context.Context, precision string, batchSize int) (backend.Backend, error) {
+	if precision == "int8" {
+		return nil, errors.New("int8 needs a calibration table")
+	}
+	b := fake.New(metadata(batchSize))
+	b.Delay = time.Millisecond
+	l.mu.Lock()
+	l.loaded = append(l.loaded, b)
+	l.mu.Unlock()
+	return b, nil
+}
+
+func TestRun(t *testing.T) {
+	l := &fakeLoader{}
+	var progress []Result
+	report, err := Run(context.Background(), Config{
+		Precisions:  []string{"fp32", "int8"},
+		BatchSizes:  []int{1, 4},
+		Concurrency: []int{1, 2},
+		Warmup:      2,
+		Iterations:  6,
+		Inputs:      Synthetic{Seed: 1},
+		Progress:    func(r Result) { progress = append(progress, r) },
+	}, l.load)
+	assert.NoError(t, err)
+
+	assert.Equal(t, SchemaVersion, report.SchemaVersion)
+	assert.Equal(t, "ResNet50_v1", report.Model)
+	assert.Equal(t, "1.0", report.Version)
+	assert.Equal(t, "synthetic", report.Inputs)
+	if !assert.Len(t, report.Results, 8) {
+		return
+	}
+	assert.Equal(t, report.Results, progress)
+
+	// one load per precision and batch size, warmed up and then measured
+	// at each concurrency level
+	if assert.Len(t, l.loaded, 2) {
+		assert.Equal(t, []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}, l.loaded[0].Batches())
+		assert.Len(t, l.loaded[1].Batches(), 14)
+		_, err := l.loaded[0].Predict(context.Background(), nil)
+		assert.Equal(t, fake.ErrClosed, err, "the backends are closed once measured")
+	}
+
+	var order [][]interface{}
+	for _, r := range report.Results {
+		order = append(order, []interface{}{r.Precision, r.BatchSize, r.Concurrency})
+	}
+	assert.Equal(t, [][]interface{}{
+		{"fp32", 1, 1}, {"fp32", 1, 2}, {"fp32", 4, 1}, {"fp32", 4, 2},
+		{"int8", 1, 1}, {"int8", 1, 2}, {"int8", 4, 1}, {"int8", 4, 2},
+	}, order)
+
+	r := report.Results[2]
+	assert.Empty(t, r.Error)
+	assert.Equal(t, 6, r.Predictions)
+	assert.True(t, r.LatencyP50 >= 1, "%v", r.LatencyP50)
+	assert.True(t, r.LatencyP50 <= r.LatencyP90 && r.LatencyP90 <= r.LatencyP99, "%+v", r)
+	assert.True(t, r.Throughput > 0 && r.Throughput <= 4000, "%v", r.Throughput)
+	assert.Equal(t, int64(1000+100+4*48), r.EstimatedMemoryBytes)
+	assert.Equal(t, int64(900+4*64), r.MeasuredMemoryBytes)
+
+	for _, r := range report.Results[4:] {
+		assert.Equal(t, "cannot load the model: int8 needs a calibration table", r.Error)
+		assert.Equal(t, 0, r.Predictions)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	l := &fakeLoader{}
+	cfg := Config{Precisions: []string{"fp32"}, BatchSizes: []int{2}, Concurrency: []int{1}, Warmup: 1, Iterations: 3, Inputs: Synthetic{}}
+
+	for _, invalid := range []func(*Config){
+		func(c *Config) { c.Precisions = nil },
+		func(c *Config) { c.BatchSizes = []int{0} },
+		func(c *Config) { c.Concurrency = []int{-1} },
+		func(c *Config) { c.Iterations = 0 },
+		func(c *Config) { c.Warmup = -1 },
+		func(c *Config) { c.Inputs = nil },
+	} {
+		c := cfg
+		invalid(&c)
+		_, err := Run(context.Background(), c, l.load)
+		assert.Error(t, err)
+	}
+
+	// failed predictions are reported
+	report, err := Run(context.Background(), cfg, func(ctx context.Context, precision string, batchSize int) (backend.Backend, error) {
+		b := fake.New(metadata(batchSize))
+		b.Err = errors.New("cuda error")
+		return b, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "warmup failed: cuda error", report.Results[0].Error)
+	cfg.Warmup = 0
+	report, err = Run(context.Background(), cfg, func(ctx context.Context, precision string, batchSize int) (backend.Backend, error) {
+		b := fake.New(metadata(batchSize))
+		b.Err = errors.New("cuda error")
+		return b, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "prediction failed: cuda error", report.Results[0].Error)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Run(ctx, cfg, l.load)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for ii := 100; ii > 0; ii-- {
+		latencies = append(latencies, time.Duration(ii)*time.Millisecond)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	assert.Equal(t, 50*time.Millisecond, Percentile(latencies, 50))
+	assert.Equal(t, 90*time.Millisecond, Percentile(latencies, 90))
+	assert.Equal(t, 99*time.Millisecond, Percentile(latencies, 99))
+	assert.Equal(t, time.Millisecond, Percentile(latencies, 0))
+	assert.Equal(t, 100*time.Millisecond, Percentile(latencies, 100))
+	assert.Equal(t, 3*time.Millisecond, Percentile(latencies[:3], 99))
+	assert.Equal(t, time.Duration(0), Percentile(nil, 50))
+}
+
+func TestInputs(t *testing.T) {
+	m := metadata(4)
+	inputs, err := Synthetic{Seed: 1}.Batch(m, 3)
+	assert.NoError(t, err)
+	if assert.Len(t, inputs, 1) {
+		assert.Equal(t, "data", inputs[0].Name)
+		assert.Equal(t, []int{3, 3, 2, 2}, inputs[0].Shape)
+		assert.Len(t, inputs[0].Data, 36)
+		for _, v := range inputs[0].Data {
+			assert.True(t, v >= 0 && v < 1)
+		}
+	}
+	again, _ := Synthetic{Seed: 1}.Batch(m, 3)
+	assert.Equal(t, inputs, again, "synthetic inputs are deterministic")
+
+	dynamic := m
+	dynamic.Inputs = []backend.TensorInfo{{Name: "data", Shape: []int{-1, 3, -1, -1}}}
+	_, err = Synthetic{}.Batch(dynamic, 1)
+	assert.Error(t, err)
+
+	red := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			red.Set(x, y, color.RGBA{255, 0, 0, 255})
+		}
+	}
+	inputs, err = Images{red}.Batch(m, 2)
+	assert.NoError(t, err)
+	if assert.Len(t, inputs, 1) {
+		assert.Equal(t, []int{2, 3, 2, 2}, inputs[0].Shape)
+		assert.Equal(t, []float32{1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0}, inputs[0].Data[12:])
+	}
+	_, err = Images{}.Batch(m, 2)
+	assert.Error(t, err)
+	m.Image = nil
+	_, err = Images{red}.Batch(m, 2)
+	assert.Error(t, err)
+}
+
+func TestReport(t *testing.T) {
+	report := &Report{
+		SchemaVersion: SchemaVersion,
+		Model:         "ResNet50_v1",
+		Version:       "1.0",
+		Inputs:        "synthetic",
+		Warmup:        10,
+		Iterations:    100,
+		StartedAt:     time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC),
+		Results: []Result{
+			{Precision: "fp32", BatchSize: 8, Concurrency: 2, Predictions: 100, LatencyMean: 4.25, LatencyP50: 4, LatencyP90: 5.5, LatencyP99: 7.125, Throughput: 3764.7058, EstimatedMemoryBytes: 1 << 30, MeasuredMemoryBytes: 900 << 20},
+			{Precision: "int8", BatchSize: 8, Concurrency: 2, Error: "cannot load the model: no calibration table"},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteJSON(&buf))
+	var decoded map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, "2019-07-01T00:00:00Z", decoded["started_at"])
+	results := decoded["results"].([]interface{})
+	var keys []string
+	for k := range results[0].(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	assert.Equal(t, []string{
+		"batch_size", "concurrency", "estimated_memory_bytes", "latency_mean_ms", "latency_p50_ms", "latency_p90_ms",
+		"latency_p99_ms", "measured_memory_bytes", "precision", "predictions", "throughput",
+	}, keys)
+	var back Report
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &back))
+	assert.Equal(t, *report, back)
+
+	buf.Reset()
+	assert.NoError(t, report.WriteCSV(&buf))
+	rows, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{
+		CSVHeader,
+		{"ResNet50_v1", "1.0", "fp32", "8", "2", "100", "4.250", "4.000", "5.500", "7.125", "3764.706", "1073741824", "943718400", ""},
+		{"ResNet50_v1", "1.0", "int8", "8", "2", "0", "0.000", "0.000", "0.000", "0.000", "0.000", "0", "0", "cannot load the model: no calibration table"},
+	}, rows)
+}
@@ -0,0 +1,93 @@
+package benchmark
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+)
+
+// SchemaVersion is the version of the schema of the reports. It changes
+// when fields are removed or change meaning, not when fields are added.
+const SchemaVersion = 1
+
+// Report is the outcome of a benchmark.
+type Report struct {
+	SchemaVersion int       `json:"schema_version"`
+	Model         string    `json:"model"`
+	Version       string    `json:"version"`
+	Inputs        string    `json:"inputs"`
+	Warmup        int       `json:"warmup"`
+	Iterations    int       `json:"iterations"`
+	StartedAt     time.Time `json:"started_at"`
+	Results       []Result  `json:"results"`
+}
+
+// Result is the measure of a precision, batch size and concurrency level.
+// Latencies are in milliseconds and the throughput in batch elements per
+// second.
+type Result struct {
+	Precision   string  `json:"precision"`
+	BatchSize   int     `json:"batch_size"`
+	Concurrency int     `json:"concurrency"`
+	Predictions int     `json:"predictions"`
+	LatencyMean float64 `json:"latency_mean_ms"`
+	LatencyP50  float64 `json:"latency_p50_ms"`
+	LatencyP90  float64 `json:"latency_p90_ms"`
+	LatencyP99  float64 `json:"latency_p99_ms"`
+	Throughput  float64 `json:"throughput"`
+	// EstimatedMemoryBytes is the estimated device memory of the model, 0
+	// when the backend does not account it
+	EstimatedMemoryBytes int64 `json:"estimated_memory_bytes"`
+	// MeasuredMemoryBytes is the device memory the build of the engine took,
+	// 0 when it was not measured
+	MeasuredMemoryBytes int64 `json:"measured_memory_bytes"`
+	// Error is set when the model could not be loaded or a prediction
+	// failed
+	Error string `json:"error,omitempty"`
+}
+
+// CSVHeader are the columns of the CSV reports, one row per result.
+var CSVHeader = []string{
+	"model", "version", "precision", "batch_size", "concurrency", "predictions",
+	"latency_mean_ms", "latency_p50_ms", "latency_p90_ms", "latency_p99_ms",
+	"throughput", "estimated_memory_bytes", "measured_memory_bytes", "error",
+}
+
+// WriteJSON ...
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the results with CSVHeader.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(CSVHeader)
+	for _, res := range r.Results {
+		cw.Write([]string{
+			r.Model,
+			r.Version,
+			res.Precision,
+			strconv.Itoa(res.BatchSize),
+			strconv.Itoa(res.Concurrency),
+			strconv.Itoa(res.Predictions),
+			formatFloat(res.LatencyMean),
+			formatFloat(res.LatencyP50),
+			formatFloat(res.LatencyP90),
+			formatFloat(res.LatencyP99),
+			formatFloat(res.Throughput),
+			strconv.FormatInt(res.EstimatedMemoryBytes, 10),
+			strconv.FormatInt(res.MeasuredMemoryBytes, 10),
+			res.Error,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 3, 64)
+}
@@ -0,0 +1,22 @@
+package tensorrt
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed builtin_models
+var builtinModels embed.FS
+
+// BuiltinModels holds the manifests of the builtin models. It can be replaced
+// before Register is called, for example by an overlay or a synthetic model
+// set.
+var BuiltinModels fs.FS = mustSub(builtinModels, "builtin_models")
+
+func mustSub(fsys fs.FS, dir string) fs.FS {
+	sub, err := fs.Sub(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
@@ -1,925 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// builtin_models/BVLC-AlexNet.yml
-// builtin_models/BVLC-GoogLeNet.yml
-// builtin_models/BVLC-Reference-CaffeNet.yml
-// builtin_models/BVLC-Reference-RCNN-ILSVRC13.yml
-// builtin_models/DPN68.yml
-// builtin_models/DPN92.yml
-// builtin_models/Inception-ResNet-v2.yml
-// builtin_models/Inception-v3.yml
-// builtin_models/Inception-v4.yml
-// builtin_models/InceptionBN-21K.yml
-// builtin_models/NIN.yml
-// builtin_models/ResNeXt101-32x4d.yml
-// builtin_models/ResNeXt26-32x4d-priv.yml
-// builtin_models/ResNeXt50-32x4d.yml
-// builtin_models/ResNet101-v2.yml
-// builtin_models/ResNet101.yml
-// builtin_models/ResNet152-v2.yml
-// builtin_models/ResNet152.yml
-// builtin_models/ResNet18-priv.yml
-// builtin_models/ResNet269-v2.yml
-// builtin_models/ResNet50_v1.yml
-// builtin_models/SqueezeNet-v1.0.yml
-// builtin_models/SqueezeNet-v1.1.yml
-// builtin_models/VGG16.yml
-// builtin_models/VGG16_SOD.yml
-// builtin_models/VGG16_SOS.yml
-// builtin_models/VGG19.yml
-// builtin_models/WRN50-2.yml
-// builtin_models/Xception.yml
-// builtin_models/voc-fcn16s.yml
-// builtin_models/voc-fcn32s.yml
-// DO NOT EDIT!
-
-package tensorrt
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(strings.NewReader(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _bvlcAlexnetYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x5f\x8f\xdb\x46\x0e\x7f\xd7\xa7\x20\x60\x04\xd8\xdc\x59\x7f\xfd\x67\xbd\x3a\xe0\x70\xd7\xb4\x0f\x05\xda\x7d\x48\xd3\xbe\x04\xc1\x82\x9a\xa1\xac\xc9\x4a\x33\xc2\x0c\x65\xaf\xf3\xe9\x8b\x19\x49\x96\xb7\x49\xd1\xbc\xd8\x12\xf9\x23\x87\xfc\x91\x43\x4a\x63\x47\x25\xfc\xf0\xc7\x2f\xef\xe2\xff\xb7\xf4\xf2\x48\x0c\x2b\xf0\x42\x30\x35\x5c\xcc\x60\xa1\x33\x92\xda\xa8\xb6\xd8\xd1\xd9\xd8\xe7\x32\x02\x18\x8d\x3e\x90\x76\xc6\xbe\xff\x00\x2b\xb8\x6a\xa1\x36\x16\xb8\xa1\xc9\x0a\xe0\x44\xd6\x29\xa3\x4b\xd8\x27\x59\x92\xbf\x82\x4e\x2a\x10\x46\xb3\x45\xa5\x39\xba\x82\xf3\x24\x83\xd5\x15\xa0\x74\x6d\x6c\x87\x3c\x3e\x83\xa3\x0e\x35\x2b\x71\xd5\x8f\xda\xc8\xfb\x41\xa5\xc9\x96\xb0\x82\xeb\x8b\x83\xc1\x91\x04\x36\xd0\x93\xf5\xc8\x31\x34\xe8\x2d\x49\x25\xbc\xcf\x08\x60\x05\xdd\xd0\xb2\xea\x5b\x82\xbe\x45\xf6\x30\x07\x02\x35\x54\x04\xae\x27\xa1\x6a\x45\x32\x02\xc0\x4e\xee\xb7\x9e\x01\x80\x63\x3f\x94\x60\x51\xf5\xd6\x7c\x26\xc1\xa9\x40\xdb\xb5\x31\x07\x4e\x2c\x97\x01\x19\x8b\x7e\x08\x60\xf1\x3d\xe0\x63\x00\xf7\xbd\xd8\x6f\x5b\x2a\xbf\xc7\x6e\xc2\x4e\x96\xff\x18\xd3\x2d\x5c\x92\x13\x56\xf5\x1c\xe8\xfe\x6f\x04\xf0\xa1\x51\x6e\xa2\x46\x39\x40\xb0\xd4\xb7\x4a\x8c\xa4\x9b\x7a\xa9\x29\x8c\x96\x15\x49\x5f\x0b\x2f\x9e\xfb\xa6\x1f\xaa\xd9\x22\x89\x00\x7e\x54\x75\x4d\x96\xb4\x20\x57\x82\x36\x0c\xa1\xc8\x4a\x1f\xe1\xac\xb8\x09\x96\x96\x5a\x75\x6c\xd8\xcb\x24\x32\xc6\x38\x1c\x3b\xd2\x1c\x5c\xfc\x27\x02\x50\x5a\xb1\xc2\x56\x7d\xf1\x08\x6d\x74\xfc\x85\xac\x81\x4a\xa1\x23\xe7\x2b\xea\x3b\x4a\x69\xc7\x84\xd2\xc7\x98\xc3\x5d\x6d\x06\x2d\x41\x93\x20\xe7\xd0\x5e\xc6\x76\x9c\xce\x5d\x03\xba\xc5\xe5\x98\x19\x1b\xc8\xe1\x88\x27\x82\xba\x45\x86\xd6\x38\xf7\x36\x09\x6c\x10\x54\x83\x96\x2d\xc9\x85\x14\x1f\xb2\x62\xb2\xa3\xe9\x66\x9f\xad\xb3\x2c\x03\xa7\xb1\x77\x8d\xe1\x64\x34\x22\xc7\x70\xc2\x56\x49\x9c\x7a\x6b\x6a\x3b\xd4\x82\x40\x0e\xd6\xa7\xb2\x30\xe1\x23\x5a\x3c\xee\x0e\xc1\x63\xe0\x67\xf1\x01\x28\xc4\x60\x51\x5c\x22\x80\xdd\x7d\x52\xec\x0e\x6f\x00\xb5\x0c\xc1\x42\x9e\x1c\x36\x0f\xdb\x43\x72\x5b\x3f\x53\xf9\xd6\xf7\x45\x64\xd3\xc7\xf9\xd5\xde\x5b\xe7\xa3\x2d\x46\x10\x94\xbb\x45\x79\xc8\x92\xe2\x0d\x98\xb1\xa6\x37\xa7\x3b\xe2\x35\x0c\xce\x87\xfb\x79\x70\x1c\xd4\x82\x34\x93\x05\x61\x4d\xef\xd9\xba\xfb\x3d\xa8\xbd\x06\x4f\x64\xf1\x18\xa6\x47\x9e\x05\x80\x5b\xc3\xdd\x16\xfe\x0d\xf9\x64\xf5\x16\xfe\x05\x05\x74\xca\x5a\x63\xd7\xe0\x1a\x33\xb4\x72\x0a\xd9\xdf\x30\xa8\x14\x43\xa3\x8e\x0d\xd9\x6b\x6c\xc9\xdb\xd7\x0d\xea\x69\x0b\x1c\x92\x84\xea\x02\x3f\x9d\x50\xc3\x6f\x0d\xb5\x0d\x76\x64\xe1\x7f\x6e\x7e\x8c\x2c\x2d\x2d\xb8\x82\xe5\x2d\x8c\x03\xec\xfd\x74\x48\xe1\x4c\x95\x53\x4c\xfe\x91\x58\x24\xc9\xdc\xe1\x73\x4a\xf3\x28\x8b\xa1\x61\xee\x5d\x99\xa6\x47\xc5\xcd\x50\x25\xc2\x74\xa9\x9f\x9c\xa9\xc0\xba\xa6\x94\x2d\x51\xda\xa1\x63\xb2\x69\xb0\x71\x69\x75\x6a\xc5\x13\xb6\xf4\xa2\x89\xbf\xc3\xc3\x59\x3d\xab\xf4\xd7\x60\x1a\xcf\xb9\xc7\x46\xc7\x3f\x77\x78\xa4\x47\xe2\xb8\xc8\xf2\x22\x3e\xe1\x12\x4d\x99\xa6\x63\x1e\x89\x56\xbd\x4b\x84\x18\x5f\xd3\xed\xa1\xd8\xc6\xca\x9b\x69\xe2\x58\xb4\xe8\x9c\xaa\xa7\xcb\x19\xfb\xfe\x8a\x25\x51\x1f\x0b\xa3\x4f\xa6\x1d\xbc\x14\xdb\x58\xd3\x60\xc3\x1f\xfb\xf9\xec\x92\x5e\xd6\xd1\x0a\x5a\x25\x48\x3b\x7a\x35\x04\xa2\x49\x58\xc2\xa0\x2d\x39\xb6\x4a\x30\xc9\x68\x05\x4a\xf7\x03\x07\x7a\x17\xec\x28\x2b\xc3\x98\xad\x95\x75\x3c\xa2\x80\x2f\x3d\x7d\xb5\x2f\xe2\x20\x2e\x21\xc4\x1e\x86\xda\x0a\x6e\x66\xd5\x1c\xc5\x8d\x9f\x00\x7a\x35\xce\xc2\x3d\x0d\x47\x2c\x5e\x7a\xf4\x7b\x87\xc9\x86\x4e\x08\x47\x2f\xa2\x80\x00\x90\xaa\x23\xed\x37\x8a\x2b\xe1\xe3\x66\x0d\x45\x71\x1f\x7e\x3e\x4d\xfa\x8e\x50\x97\xf0\x31\x2f\x36\x6b\xc8\xf3\xfb\x35\xe4\xd9\xf6\x53\x64\x06\xee\x07\x1e\xd3\xf3\x27\x07\xdf\x53\x98\xa3\xce\xdf\xb5\x90\x54\x4d\xc8\x83\xa5\x00\xc5\x6f\xa5\x35\xe2\x97\xc8\xa2\x6f\x64\x36\x61\x5a\xac\x02\x61\x37\x89\x4d\x74\x7d\x2b\xb9\xe9\x64\xf7\x34\xd8\xb6\x9c\x3b\xc7\x6d\x12\xec\xf0\x8b\xd1\x78\x76\xa1\x15\x1d\x1b\x4b\x49\xd8\x1a\x89\xb1\xc7\xd4\x5d\xb4\x23\x76\xe9\xdc\x48\x93\x20\xe1\x17\x7e\xed\x55\x34\x24\x9e\xdd\xd0\x95\xb0\x95\xc5\x66\x5b\xed\x0e\x9b\x0d\x0a\xdc\x6e\x1f\x8a\x43\xb6\xdf\x61\x7e\xc8\x64\xb5\xc9\xf2\x3d\x46\xa1\xd0\xbe\x06\xf3\x5e\x9d\x2f\xf5\xd1\x62\xdf\x84\xe1\x74\x26\xbf\x15\x1c\x58\x72\x66\xb0\x82\x7c\x0a\x41\xfb\xd4\x23\x37\xe5\xf5\x12\x59\x3c\x27\xe3\x45\x1a\x1c\x59\xbf\xf1\x49\xf3\x5f\xef\xd4\xdf\x5f\xc8\x54\x52\xdf\x9a\x4b\xd2\x5b\xc3\x66\x4c\x69\x3a\xf9\xe6\x9c\x32\x4d\x65\x9b\x04\x57\x49\x45\xf6\x99\x5a\xba\x9c\x94\x6f\x92\xc0\xd0\xad\xbf\x11\x35\x37\xb2\x72\x4f\x68\x45\xa3\x4e\xd3\x2a\xaf\xb1\x75\x04\x2b\x50\xf5\x38\x51\xb9\xa1\x71\xd4\x56\xe8\xc8\x97\x65\x5c\xbb\xfe\x81\x0d\xa0\x86\xc9\x7a\x2e\x6a\x43\x37\x1c\xdc\xd2\x34\x0a\x82\x3b\x49\xda\x30\xf9\xe7\xc9\xaa\x56\x2d\x85\xaf\x35\x37\x77\xd8\xd7\x2c\xfb\x69\x30\x6d\xf2\xe5\xc8\xf1\xa8\xa5\xac\xb5\xac\x76\xc5\x0e\x05\xe2\x41\x6e\x64\x95\xd5\x87\x7b\xb1\xdb\xdd\xdf\x3f\x64\x79\xbd\xdb\x10\xdd\x70\xb7\x18\x15\x0f\x54\x6d\x1f\x76\x55\x9e\xef\xf3\xcd\xa1\xd8\x89\xfc\x21\xcb\x36\x87\xa2\xde\x15\x87\xfd\xc3\x7e\x13\x21\xb3\x55\xd5\xc0\xe3\x78\xa6\x17\xb6\x08\xd3\xf4\x81\x45\x17\x01\x3c\x2b\x2d\x4b\x78\xf7\xf8\x38\x31\xe1\xdf\x7d\x46\xe3\xc4\xba\xda\xdc\xbd\x7b\x7c\x5c\xc3\x7b\xff\x93\x24\x61\x69\xcc\x9b\xf6\xc9\x7f\x5f\x38\xe2\x12\xe6\x59\xea\xe7\xca\x28\xbb\x7e\x20\xde\x7e\x2c\x44\x00\x1d\x6a\x55\x93\xe3\x27\x1c\xb8\x31\xb6\x04\xac\xe4\xd0\xca\xe8\xcf\x00\x00\x00\xff\xff\xc3\x2e\x67\xa3\x33\x0b\x00\x00"
-
-func bvlcAlexnetYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_bvlcAlexnetYml,
-		"BVLC-AlexNet.yml",
-	)
-}
-
-func bvlcAlexnetYml() (*asset, error) {
-	bytes, err := bvlcAlexnetYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "BVLC-AlexNet.yml", size: 2867, mode: os.FileMode(436), modTime: time.Unix(1588605067, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bvlcGooglenetYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\xdd\x8f\xdb\x36\x12\x7f\xd7\x5f\x31\x88\x11\x74\x73\xe7\x95\x25\x5b\xeb\xd5\xaa\xc0\xe1\x2e\x5b\x5c\xee\xa3\xd8\x87\x34\x77\x7d\x28\x0a\x63\x44\x8e\x24\x76\x29\x52\x47\x8e\xec\x38\x7f\xfd\x81\x94\xfc\xb1\x69\xd2\x76\x1f\x16\xe2\xf0\x37\xc3\x99\x1f\xe7\x83\x36\xd8\x53\x05\x6f\xff\xfb\xfd\xe3\xed\x3b\x6b\xdb\xef\xe9\x89\x18\x16\x10\xc4\x60\x1b\x38\xda\xd1\x41\x6f\x25\xe9\xa4\x71\xd8\xd3\xc1\xba\xe7\x2a\x01\x98\xd4\x3e\x90\xf1\xd6\xbd\xff\x00\x0b\x38\xef\x42\x63\x1d\x70\x47\xb3\x16\xc0\x9e\x9c\x57\xd6\x54\xb0\x4d\xb3\x34\x7f\x01\x9d\xb7\x40\x58\xc3\x0e\x95\xe1\xe4\x0c\xce\xd3\x0c\x16\x67\x80\x32\x8d\x75\x3d\xf2\xf4\x0d\x9e\x7a\x34\xac\xc4\x79\x7f\xda\x4d\x82\x1d\x54\x86\x5c\x05\x0b\x38\x2f\x3c\x8c\x9e\x24\xb0\x85\x81\x5c\x40\x4e\xae\xc1\xe0\x48\x2a\x11\x6c\x26\x70\xf9\x5b\x40\x3f\x6a\x56\x83\x26\x18\x34\x72\xc0\x7b\x10\x68\xa0\x26\xf0\x03\x09\xd5\x28\x92\x09\x00\xf6\x72\x5b\x54\x51\xb3\x1d\xc6\x0a\x1c\xaa\xc1\xd9\x5f\x48\xf0\x4a\xa0\xeb\xf5\x2d\x47\x72\x1c\x57\x11\x79\x2b\x86\x31\x82\xc5\x1f\x01\xb7\x11\x3c\x0c\x62\x5b\x68\xaa\xfe\x88\xde\x8c\x9d\x35\x7f\xd7\xa7\x6b\xb8\x24\x2f\x9c\x1a\x38\xf2\xfe\x97\x04\xe0\x43\xa7\xfc\xcc\x91\xf2\x80\xe0\x68\xd0\x4a\x4c\xec\xdb\xe6\x72\xb9\x30\x69\xd6\x24\xc3\xa5\x04\x71\x48\x21\x1d\x53\x68\x18\xeb\x93\x4e\x0a\x3f\x12\x1c\xec\xa8\x25\x68\xf5\x4c\xe1\x22\xb8\x43\xf3\x0c\x8f\x9d\x53\x9e\x15\x1a\xf8\xe1\x13\xb5\x24\x8f\x31\x77\x50\x6b\x08\x0e\x74\xa4\x87\x93\xdd\xcf\x3c\xb8\x1c\x13\xfd\x48\x13\x80\xef\x54\xd3\x90\x23\x23\xc8\xc7\xfc\xb4\x0c\x31\xa5\x94\x69\xe1\xa0\xb8\x9b\xcd\x68\xd5\x76\x1c\x64\x12\x19\x6f\x71\x6c\x7b\x32\x1c\xed\x7e\xfb\x55\x2d\x2f\x50\x13\x04\xcf\xc2\xfd\xf3\xad\x0b\xf8\x2f\x1b\x18\x3d\x79\x78\xf5\x11\xf7\x8a\xdc\xab\x10\xa8\x32\x8a\x15\x6a\xf5\x89\xa2\xa9\x03\x85\xf3\x3d\x28\xe3\x99\x50\x86\x58\x5e\xb5\x38\x7a\xaf\xd0\xbc\x0a\x06\xfe\x37\x2a\xf1\xbc\xf3\x56\xef\xc9\xa5\x83\xb3\x6c\xf9\x23\x4f\x66\x11\xe4\x1c\x23\x83\x26\x74\xd1\x49\x87\x4c\x20\x49\xe0\x11\x06\xab\x95\x38\x46\x6a\xe3\x59\xd6\xa9\x56\x19\xd4\xf0\x99\xb5\x65\x80\x70\xa0\xd9\x1e\x82\xd5\x7e\x14\x1d\x34\xe8\x99\xdc\x25\xf8\x9b\x6d\x06\x34\x58\xd1\x79\xd8\x7b\x58\xdf\x9d\x56\x6f\xbe\x8d\xf9\x41\x50\x8f\x46\x6a\x92\x97\x34\x09\x47\x2a\x26\x37\x5d\xd2\x7a\x59\x64\xd9\x32\xcb\x32\xf0\x06\x07\xdf\x59\xbe\x32\xf9\x06\x46\x1f\x0e\xf9\x62\xb0\xa7\xfc\x7b\x79\x80\xad\x43\x2d\x07\x77\xd9\x0e\xb7\x39\xa0\x10\xa3\x43\x71\x84\x6d\x99\xde\xbf\x86\x9b\x4d\x9e\x6e\x5e\x03\x39\x67\xdd\x1b\x40\x23\x67\xe0\xdd\x05\x58\x96\xe9\xc3\x6b\xb8\xc9\xf3\x34\x3f\x03\xed\xc4\xd4\x1e\xb5\x92\x93\xdf\x9e\x78\x39\x7b\xf7\xcb\xe8\x39\x6e\x0b\x32\x81\x1b\xe1\xec\x10\x32\xed\xe6\x3f\x71\x3b\xec\xe0\x9e\x1c\xb6\xb1\x51\xe6\x59\x04\xf8\x25\xdc\x14\xf0\x67\xc8\x67\xad\x37\xf0\x27\x58\x43\xaf\xc2\x79\x4b\xf0\x5d\xac\x82\x29\x18\x40\xa8\x15\x43\xa7\xda\x8e\xdc\xd9\xcf\xf4\x4d\x20\x40\xf5\xca\xb4\x3e\x96\x43\xbd\xd7\x62\xd7\xc6\x84\x37\xc4\x53\x56\x8a\xf1\xbb\xa7\xa7\xd9\xcd\x1a\x59\x74\x3b\xaf\x3e\x51\x95\xaf\xcb\x10\x12\xc2\xbf\x8b\x4c\x84\x2a\xf8\xdb\xec\xdf\xdf\xad\x3b\xa0\x93\x30\xa0\xf7\x15\xdc\x6d\xd7\x69\x59\xe4\xd0\xfb\xf4\x0a\xf3\x16\xc5\xf3\x15\x28\xcf\xd7\x9b\xb4\x2c\x3e\x03\xcd\x86\x6e\x4f\xe0\x0a\xf2\x6d\x59\xa6\xe5\x0c\xbb\x6a\x1c\x07\xf4\x53\x36\x91\x84\xfa\x08\x3f\x90\x6b\x95\x85\x77\x23\x4a\x74\x0e\x7b\x84\xbf\xfa\x36\x2c\x12\x47\xe7\xba\x85\x05\x5c\x56\xb1\x5f\xe3\x10\xda\xf7\x0a\x0e\x54\x7b\xc5\x14\x3e\x89\x45\x9a\x9e\x3a\xcf\xe9\x22\x4e\xb3\xe6\x16\x3a\xe6\xc1\x57\xab\x55\xab\xb8\x1b\xeb\x54\xd8\x7e\x15\x86\xdb\x4a\x60\xd3\xd0\x8a\x1d\xd1\xaa\x8f\xb9\xbe\x8a\x3a\x7e\xf5\x92\xdf\x17\x36\xd0\x7d\x54\xfb\xd4\xba\x76\x85\xb5\x5f\xe5\x45\xf6\x90\x16\x65\xb1\x4e\x16\xa0\x95\x20\xe3\xe9\x45\x37\x4c\x66\x61\x05\xa3\x71\xe4\xd9\x29\xc1\x24\x93\x05\x28\x33\x8c\xec\xa7\xb6\x77\xc2\x4e\xb2\x70\x47\x0b\x68\x94\xf3\x3c\xa1\x80\x8f\x03\xfd\x6a\x82\xde\x46\x71\x05\xaa\xc7\x96\x92\x69\x48\x5d\x35\xed\x93\x17\x57\x76\x22\xe8\x45\x5f\x8f\xe5\x19\x8f\xb8\x58\x19\x30\x4c\x62\x26\x17\xa9\x8f\x47\x5f\x44\xf3\x4c\x24\x4d\xa1\xc3\xed\x26\x0f\x1a\x6d\x91\x37\xeb\x79\x2f\xda\xdb\x69\x3c\x86\x81\xfb\x4d\x68\x88\xdf\xcc\x3b\x1a\x8f\x76\xe4\x0a\x1e\xff\xf1\xe3\x2c\x11\x56\x5b\xb7\x0b\x11\x55\xf0\xf6\xdd\xfb\x59\x2a\x55\x4f\x26\x4c\x70\x5f\xc1\x4f\x9b\x25\xac\xd7\x45\xfc\xf7\xf3\xbc\xdf\x13\x9a\x0a\x7e\xca\xd7\x9b\x25\xe4\xf9\xfd\x12\xf2\xac\xf8\x39\xb1\x23\x0f\x23\x4f\xe4\x85\xb8\xa2\xe7\x33\x09\xd3\x5e\x02\x33\x65\x42\xa3\xf7\xaa\x99\x67\x47\xd4\xc0\x2f\x71\x37\xa9\x5d\xc2\x4f\xbe\x40\xdf\x8c\xd1\x58\xc7\x5b\xf9\x7d\xf6\xbe\xce\xdd\xe0\x6c\x8d\xb5\xd2\x8a\x15\xf9\x33\x83\x41\x3a\x31\xd8\x10\xf2\xe8\xc8\xef\x46\xa7\xab\x98\x8f\xd5\x6a\xe5\x37\x29\xf6\xf8\xc9\x1a\x3c\xf8\x98\xd8\x9e\xad\xa3\x34\x4e\xf6\x98\xa5\xfe\x68\x3c\xb1\x5f\xc5\x1b\x36\xc4\xb3\x20\x9d\xba\xea\x95\x55\xd1\x91\x78\xf6\x63\x5f\x41\x21\xd7\x9b\xa2\xbe\x2b\x37\x1b\x14\x58\x14\x0f\xeb\x32\xdb\xde\x61\x5e\x66\xb2\xde\x64\xf9\x16\x93\x98\x83\x21\xc0\xd3\xdb\xe7\x54\xe0\xad\xc3\xa1\x8b\xad\xf6\x34\xd4\x1c\x79\x3b\x3a\x41\x21\xf8\x88\x99\x03\x8f\xa5\x97\xc0\xa4\xb1\x1b\x90\xbb\xea\x5c\x62\x0e\x0f\xe9\x54\xaa\xa3\x27\x17\x9e\x6c\x64\xf8\xf3\xaa\xfd\xad\x82\x5d\x49\x1a\xb4\x3d\x5e\x8f\x8f\xd9\x9f\xab\x93\xaa\xd5\x4a\xea\x34\x1a\x4b\x6b\x72\xcf\xa4\xe9\xb8\x57\x21\xef\x22\x6f\x2f\x2d\x4e\xb8\x53\xed\x29\xbf\x43\x27\x3a\xb5\x0f\x37\x88\xda\x13\x2c\x40\x35\xd3\x9c\xe0\x8e\xa6\x01\x52\xa3\xa7\x70\x55\xd3\x73\x29\x7c\xb0\x05\x34\x30\x6b\x5e\x3f\x2f\xaf\xde\x99\x41\xf3\xc2\xc9\x35\x95\x93\x20\x9a\x97\x64\x2c\xc7\xc7\xc3\x57\xac\x34\x4a\x53\x7c\x8e\xfb\x53\x2e\xff\xfa\x66\xc2\xd0\x98\x5f\x52\x17\x97\xa6\xa3\xaf\x52\x01\xef\xd7\x65\x7e\x5f\x94\xf2\x21\xaf\x1f\xb6\x59\x8d\xe5\x56\x6e\xcb\x3c\xcb\x33\x59\x20\xae\xaf\x98\xbd\x28\x49\x91\xdd\x6d\xef\xf3\x26\x2b\x8a\xcd\x7d\x56\x4a\xcc\xeb\x4c\x66\x48\x19\x65\xe2\x41\xa0\x4c\x90\xd9\xa9\x7a\xe4\xa9\xbd\xd3\x47\x76\x08\x86\x38\x3e\xff\x2f\x7b\x09\xc0\xb3\x32\xb2\x82\xc7\xa7\xa7\x99\x99\xb0\x0e\x11\x19\x1a\x1d\xea\xb3\xce\xcd\xe3\xd3\xd3\x12\xde\x87\x7f\x69\x1a\x87\xe5\xe9\xcd\xb2\x0b\x0d\xc8\x13\x57\xf0\xcf\x90\xff\xd3\xef\x98\x59\x76\xfe\x05\x10\x5b\xeb\xac\x10\xd2\x14\x8d\x6a\xc8\xf3\x0e\x47\xee\xac\xab\x00\x6b\x39\x6a\xb9\x84\x7f\x29\xd3\x46\xcc\xff\x03\x00\x00\xff\xff\xc2\x94\x08\x4f\x20\x0d\x00\x00"
-
-func bvlcGooglenetYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_bvlcGooglenetYml,
-		"BVLC-GoogLeNet.yml",
-	)
-}
-
-func bvlcGooglenetYml() (*asset, error) {
-	bytes, err := bvlcGooglenetYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "BVLC-GoogLeNet.yml", size: 3360, mode: os.FileMode(436), modTime: time.Unix(1588605183, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bvlcReferenceCaffenetYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4b\x6f\xe3\xbe\x11\xbf\xeb\x53\x0c\x60\x14\xc8\xb6\xb6\x64\xf9\x1d\x15\x28\xda\x66\x2f\x5b\x14\x39\x2c\xd2\x5e\x16\x8b\x80\x22\x47\x16\x37\x14\x49\x90\xc3\x24\xee\xa7\x2f\x48\xca\x96\xbd\x0f\xfc\x37\x07\x47\xe2\xfc\x66\x38\x8f\xdf\xcc\x48\xb3\x01\x1b\xf8\xe7\x7f\xff\xfd\xb0\xf8\x8c\x1d\x3a\xd4\x1c\x17\x0f\xac\xeb\xf0\x11\x09\x66\x10\xe5\x60\x3a\x38\x99\xe0\x60\x30\x02\x55\xd1\x39\x36\xe0\x9b\x71\x2f\x4d\x01\x90\xf5\x9f\x50\x7b\xe3\x3e\x3f\xc1\x0c\x2e\x52\xe8\x8c\x03\xea\x71\xd4\x02\x78\x45\xe7\xa5\xd1\x0d\xec\xca\x65\x59\xdf\x40\x47\x11\x70\xa3\xc9\x31\xa9\xa9\xb8\x80\xeb\x72\x09\xb3\x0b\x40\xea\xce\xb8\x81\x51\x7e\x06\x8f\x03\xd3\x24\xf9\x45\x9e\xa5\x45\xb4\xc3\xa4\x46\xd7\xc0\x0c\x2e\x2f\x1e\x82\x47\x01\x64\xc0\xa2\x8b\xc8\xec\x1a\x58\x87\x42\xf2\x68\xb3\x80\xe9\x6f\x06\x43\x50\x24\xad\x42\xb0\x8a\x51\xc4\x7b\xe0\x4c\x43\x8b\xe0\x2d\x72\xd9\x49\x14\x05\x00\x1b\xc4\x6e\xd3\x24\xcd\xa3\x0d\x0d\x38\x26\xad\x33\xdf\x90\x53\xc5\x99\x1b\xd4\x82\x52\x72\x1c\x35\x09\xb9\xe0\x36\x24\x30\xff\x1d\xf0\x31\x81\xad\xe5\xbb\x8d\xc2\xe6\x77\xf4\x46\xec\xa8\xf9\x87\x3e\x5d\xc3\x05\x7a\xee\xa4\xa5\x94\xf7\xbf\x15\x00\x4f\xbd\xf4\x63\x8e\xa4\x4f\xb5\x74\xe8\x83\xa2\x48\x88\xce\x28\x65\xde\xa4\x3e\xa6\xf3\xc4\x18\xf8\x34\xb0\x63\xe2\x4d\xd6\x49\xa5\x8c\x08\xa9\x3d\xb9\x90\x32\xec\x4b\xf8\x44\xd1\x1a\x03\x87\x56\x49\x9e\x6b\x69\xba\x89\x2a\x90\xfd\x68\x51\xc4\x12\xc7\xe3\x7f\x28\x7c\x8f\x66\x6d\x68\x2f\x1a\x6f\x92\x7a\xf0\x66\x40\x10\xb2\x1b\x89\xeb\xcb\x02\xe0\xe3\xf4\x9a\x08\x6a\x68\x72\x24\x29\xe5\x38\x94\x3c\xf6\x14\xcf\x04\x23\xb6\x60\xe1\x38\xa0\xa6\x64\xfa\xaf\x05\x24\x8c\x71\x02\x5d\xf4\xcc\x1a\xa3\x22\x92\x69\x01\x3a\x12\x4c\xc9\xff\x65\x27\x14\x3b\x45\x5a\x49\x0f\xfe\x4d\x12\xef\x51\xc0\x9d\xd4\x70\xee\x9f\xf9\x45\x55\x7a\x10\x46\x23\xb4\xd8\x19\x87\xb7\x56\x3e\x94\x3f\xa4\xda\x6b\x66\x7d\x6f\x52\xa2\x25\xa1\xcb\xb7\xad\xeb\xe5\x7c\xb9\x5c\x96\xf0\xd4\x47\x4b\x9e\xe0\x95\x29\x29\xb2\x70\xa4\x34\xd3\x1c\x41\x04\x97\x0a\x73\x09\x9b\xf9\x1b\x33\xeb\x68\x26\x27\xe3\xca\x02\xe3\x3c\x38\xc6\x4f\xb0\xdd\x97\x9b\x7a\xf5\xa7\x14\xaf\x32\xde\x43\x5d\x1e\x56\xeb\xd5\xe1\x3b\x3f\x4d\x1b\xdb\x2a\x56\x92\x8c\x5d\xd4\xb7\xfa\x59\x3b\x8b\xb6\x93\xe8\xb0\x8c\x22\x93\xcb\x7a\x75\xb7\x8f\xc9\x0a\x3e\x3a\xfb\x2d\x78\x4a\x62\x8e\x9a\xd0\x01\x77\xc6\xc6\x9b\xef\xfe\xe3\xcf\x6c\x63\xaf\xe8\xd8\x31\xcd\xa5\x7a\x99\x00\x7e\x0e\x77\x1b\xf8\x0b\xd4\xa3\xd6\x07\xf8\x33\xac\x60\x90\xce\x19\x37\x07\xdf\x9b\xa0\xc4\xe8\x30\x30\x68\x25\x41\x2f\x8f\x3d\xba\xc9\x33\x4f\x52\xa9\xf2\xc3\x6d\x88\x31\x71\x29\x8b\x28\xa0\x3d\xc1\xbf\xb0\xeb\xe0\xa3\xd1\xac\x0f\x08\x7f\xff\x86\x5d\x27\xf2\x4b\xe1\xf0\xc2\x39\x98\xc1\xf4\x96\x86\x0d\xb3\x91\x24\x15\xbc\x61\xeb\x25\x61\x7c\x44\xe2\x65\x79\x26\xfa\x39\xac\xf3\xa0\x5c\x40\x4f\x64\x7d\x53\x55\x47\x49\x7d\x68\x4b\x6e\x86\x2a\x8e\xe8\x8a\x47\x62\x55\xe4\x10\xab\x81\x79\x42\x57\x25\x1d\x5f\xb5\xaf\x8a\x3f\x5f\xae\x7d\x4e\x38\x8d\x74\x31\xd6\x54\x55\x76\xa3\xd4\xd2\xfa\x92\xf3\xfc\x5a\x6d\x0e\xab\xcd\x42\xc6\xb6\xd5\x48\x0b\xae\x98\xf7\xb2\x1b\x5b\x6c\x11\x09\xb2\x10\x88\x76\xc1\x8d\x7e\x35\x2a\xc4\x53\xa6\x16\x1a\x83\x4b\xff\x28\x0e\x6f\x5f\x5a\xd1\x15\x33\x50\x92\xa3\xf6\x78\xd3\xca\xc5\x78\xd8\x40\xd0\x0e\x3d\x39\xc9\x09\x45\x31\x03\xa9\x6d\xa0\x94\x9d\x09\x9b\xcf\x62\xcf\xce\xa0\x93\xce\x53\x46\x01\x9d\x2c\xfe\xb0\x4c\x16\xe9\xb8\x81\xe4\x7b\x91\xe7\xf5\xd5\xfc\x3a\x7b\x71\x65\x27\x81\x6e\x46\x5c\x04\xe4\x2b\x26\x2b\x96\xc5\xa5\x44\xe8\x52\x21\xd3\xd5\xd3\xd1\xb8\x1e\x84\x1c\x50\xc7\x75\xe3\x1b\xf8\xb2\x9e\xc3\x6a\xb5\x4f\x3f\x5f\x47\xf9\x80\x4c\x37\xf0\xa5\x5e\xad\xe7\x50\xd7\xfb\x39\xd4\xcb\xcd\xd7\xc2\x04\xb2\x81\x72\x78\xf1\xe6\x64\x7b\x74\x33\xcb\xe2\xdc\x49\x41\x75\xc8\x28\x38\x4c\x50\xf6\xb3\xb0\x32\x7e\xf2\xac\xf8\x49\x64\x23\x46\xb1\x36\x25\xec\x2a\xb0\x31\x5d\x3f\x0b\x6e\xbc\xd9\x3f\x07\xa7\x9a\x33\x73\xe2\x84\x2c\xc5\xa0\x78\x39\xa8\x6a\x78\xd7\x48\x67\xda\x9d\x99\x53\xf9\x93\xf6\x48\x25\xbd\xd3\xad\x19\xde\x23\x7f\xf1\x61\x68\x60\x23\x56\xeb\x4d\xbb\x3d\xac\xd7\x8c\xb3\xcd\xe6\x7e\x75\x58\xee\xb6\xac\x3e\x2c\x45\xbb\x5e\xd6\x3b\x56\x24\x93\x31\xe9\xe7\xe5\x7a\x6e\xc2\xa3\x63\xb6\x4f\xe3\xe4\x0d\xe3\xd0\xf6\x71\x0b\x99\xe0\x38\x46\x9f\x93\xf4\xd9\x32\xea\x9b\x4b\xdb\x38\xf6\x56\xe6\xd6\x09\x1e\x5d\xdc\xff\xa8\xe9\xfb\x2e\xfa\xad\x06\xaa\x04\x5a\x65\x4e\xa5\x75\x86\x4c\x8e\x6e\x74\xe2\xea\xca\x98\x22\x55\x26\x95\xb2\x45\xf7\x82\x0a\x4f\xaf\x32\x12\xa4\x34\xee\xf8\x2b\xd3\x59\x21\xf3\xb9\x00\x90\xfe\x99\x39\xde\xcb\xd7\x58\x7f\xa6\x3c\xc2\x0c\x64\x97\x07\x23\xf5\x98\x27\x66\xcb\x3c\xc6\xd2\xe4\x05\x1a\x1f\xc8\x00\xd3\x30\x6a\x5e\x7f\xbe\x5c\x7d\xc7\x44\xcd\x29\x4d\xd7\x99\xcc\x07\xc9\xbc\x40\x6d\x08\xe3\xf3\x2f\xac\x74\x52\x61\xfa\xdc\xf3\x67\x16\xfe\x58\x98\x38\x31\xc6\x9d\x3d\xb9\x94\xaf\x9e\x98\x70\xbf\xdd\x2e\xb7\xb5\xa8\x6b\xdc\x6c\x5a\xb1\xba\x17\xe2\xb0\x67\xab\xad\x10\xfb\xdd\x0e\xf9\x6a\x7d\x95\xe3\x49\x89\x75\xbb\xfd\xa1\x5b\xb6\x62\xcd\x85\x58\x6d\xd6\x7b\x5c\x6f\x77\xfb\x7b\x71\x38\xec\x76\xdb\x7a\xbf\x2c\x18\x91\x93\x6d\xa0\x3c\x81\xf1\x9d\x1c\x83\x71\x42\xc1\x24\x2b\x00\x5e\xa4\x16\x0d\x3c\x3c\x3e\x8e\x99\x89\xef\x31\xa2\x3c\xd5\x2e\x3a\x77\x0f\x8f\x8f\x73\xf8\x1c\x7f\xca\x32\xed\x85\xf3\x3a\x7d\x8e\xfd\xe0\x91\x9a\xe9\x7b\x67\x06\xe3\xd9\xe5\x0b\x33\xcd\xab\x51\xa1\x00\x18\x98\x96\x1d\x7a\x7a\x66\x81\x7a\xe3\x1a\x60\xad\x08\x4a\x14\xff\x0f\x00\x00\xff\xff\x91\xbc\xc4\xc6\x7f\x0b\x00\x00"
-
-func bvlcReferenceCaffenetYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_bvlcReferenceCaffenetYml,
-		"BVLC-Reference-CaffeNet.yml",
-	)
-}
-
-func bvlcReferenceCaffenetYml() (*asset, error) {
-	bytes, err := bvlcReferenceCaffenetYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "BVLC-Reference-CaffeNet.yml", size: 2943, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bvlcReferenceRcnnIlsvrc13Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x41\x6f\xe3\xb8\x0e\xbe\xfb\x57\x10\x93\xcb\x7b\x40\x63\xd7\x49\xda\x34\x3e\x3c\x3c\x4c\x81\xf7\x30\xc0\x6c\x0e\x9d\xc1\x5c\x16\x8b\x80\x96\xe9\x58\x13\x5b\x12\x28\x3a\x69\xfe\xfd\x42\xb2\x13\xa7\x98\xee\x6e\x73\x08\x6c\xe9\x23\x45\x7e\xfc\x48\xd9\x60\x47\x05\x7c\xfe\xf1\xf5\x79\xfe\x42\x35\x31\x19\x45\xf3\x97\xe7\xed\x76\xfe\xe5\xeb\xb7\x1f\x2f\xcf\xf9\x12\x66\x10\x40\x60\x6b\x38\xdb\x9e\xa1\xb3\x15\xb5\x49\xcd\xd8\xd1\xc9\xf2\xa1\x48\x00\x06\x27\xdf\xc9\x78\xcb\x2f\xdf\x61\x06\xd7\x5d\xa8\x2d\x83\x34\x34\x5a\x01\x1c\x89\xbd\xb6\xa6\x80\xc7\xf4\x3e\xcd\xdf\x40\xc7\x2d\x50\xd6\x08\xa3\x36\x92\x5c\xc1\x79\x7a\x0f\xb3\x2b\x40\x9b\xda\x72\x87\x32\x3c\x83\xa7\x0e\x8d\x68\x75\xdd\x1f\x76\x93\xe0\x07\xb5\x21\x2e\x60\x06\xd7\x17\x0f\xbd\xa7\x0a\xc4\x82\x23\x0e\xc8\x21\x34\x70\x4c\x95\x56\xc1\x67\x02\xd3\x6f\x06\x5d\xdf\x8a\x76\x2d\x81\x6b\x51\x02\xde\x83\x42\x03\x25\x81\x77\xa4\x74\xad\xa9\x4a\x00\xb0\xab\x1e\x57\x45\xb4\xdc\xbb\xbe\x00\x46\xed\xd8\xfe\x24\x25\x99\x42\xee\xda\xb9\x44\x72\x58\x8a\x88\x9c\x2b\xd7\x47\xb0\xfa\x08\x78\x1f\xc1\xce\xa9\xc7\x55\x4b\xc5\x47\xec\x46\xec\x68\xf9\x8f\x31\xdd\xc2\x2b\xf2\x8a\xb5\x93\xc8\xfb\x7f\x12\x80\xef\x0d\x81\xeb\x99\xa6\xfa\x6a\xe3\x25\x30\x3e\x54\xc0\xd6\xb1\xc0\x2f\xf3\xe7\xed\x76\xe4\x32\x14\xfd\x2a\x9f\x8a\x84\x22\xaf\x69\x74\xa6\xfd\x08\x3a\xa1\x87\x0e\x2b\x82\xf2\x0c\xc2\x68\xbc\x6b\x83\x53\xb3\xbf\x71\xf7\xed\xc7\x6f\xa0\x5a\xf4\x3e\xf0\xcc\x1e\xb4\x11\x0b\x08\xb5\x9a\xb3\x32\xe6\xba\xa5\x86\x48\x5a\x3c\x13\xdf\x81\x63\x7b\xd4\x15\x55\xd0\x10\x13\xa0\x07\x0c\x41\xd6\x73\x69\x68\xee\x1b\x6a\xeb\x29\x93\x21\x36\xcb\x31\x34\x3e\xc7\x93\xaf\xf1\x02\xbd\x62\x17\x2a\x2f\x16\x3c\x11\x68\x09\x6a\xc3\x6b\x2e\xdb\xf4\x73\x0a\xff\xb3\x0c\x4c\x9e\x90\x55\x13\x68\x72\xd6\x93\xbf\x83\x0e\x0f\x14\x84\x76\x61\xc7\xd6\xb5\x56\x1a\xdb\x31\x2f\x87\xea\x80\x7b\x02\x34\x15\x18\x2b\x20\x81\x96\xf1\xb8\x77\x68\x8a\xed\x40\x55\x60\xea\xc5\x7a\x0f\xff\xd7\xec\x1b\xad\x0e\xf0\x5f\x2e\xf7\xe3\x73\xc2\x97\xf6\xf5\x41\xef\xd3\x5b\x94\x3a\xba\x40\x5f\x06\x27\x2a\xbd\x16\x0a\x8f\x24\x2a\x4d\x61\x28\x77\x79\xa1\xfd\xd2\xa6\x73\x68\x44\x9c\x2f\xb2\x6c\xaf\xa5\xe9\xcb\x54\xd9\x2e\x0b\x53\x22\x53\x58\xd7\x94\x09\x13\x65\x1d\x7a\x21\xce\xa2\x8d\xcf\xca\x63\xab\x76\xd7\x63\x77\xa1\x40\x3b\xdd\xfa\x23\xab\x7c\xf9\xc6\x23\xf2\xab\x3e\xa6\x96\xf7\x19\x96\x3e\xcb\x97\x79\x9e\x2e\x1e\x16\xab\x2b\xa6\xc8\x32\x53\x1e\x35\x9d\x88\xd3\x9f\xbd\x3b\x0b\x71\x44\x0f\x91\xdc\x46\x51\xb6\xb6\xbc\x44\x31\xb2\xe7\xb3\x49\x6f\xda\x9d\x4d\x99\xcc\xa0\xd5\x8a\xcc\x54\x8b\x21\xc7\x71\xb1\x80\xde\x30\x79\x61\xad\x84\xaa\x64\x06\xda\xb8\x5e\x22\x69\x13\x76\x58\x0b\x9d\x37\x83\x5a\xb3\x97\x01\x05\x72\x76\xf4\xcb\x84\x9b\xc7\xe5\x02\x74\x87\x7b\x4a\x86\x21\x72\xd3\x54\x97\x28\x6e\xfc\x44\xd0\x9b\xbe\x0b\x80\xe1\x88\xc9\x8b\xc3\x30\x29\x85\x38\xd6\x37\x1e\x3d\x2d\x8d\x33\x2b\x76\xc0\x6e\x18\xc7\x9f\x2a\x14\xfc\x34\x6e\x54\xba\x23\x13\x86\xa3\x2f\xe0\xf7\xe5\x1d\x2c\x16\xeb\xf8\xf7\x47\x62\x7b\x71\xbd\x0c\xb9\x85\x63\xa3\xe3\x8b\x6a\xe3\x5e\x02\x63\x46\x35\xa1\xf4\x4c\x11\x8a\xef\xe5\x34\xe0\xa7\xb0\x92\x77\xd2\x1a\x31\x2d\x96\x91\xad\x9b\xac\x46\xae\xde\xcb\xec\x4d\x5e\x63\xf7\x0f\xa9\x8d\x31\xf9\x5d\xcf\x6d\x71\x95\x18\xe3\x29\x1d\xe4\xd2\x7b\xe2\x30\xfb\xc9\x48\xd4\x30\xa3\x9e\xbf\x9d\x82\xa3\x7c\x47\x19\x05\xd2\xb2\x51\xb6\x8b\xa0\xa5\x9d\x3f\x1b\x4f\xb2\x3b\x59\xae\x7c\x2a\xaf\xf2\xf6\x58\xd5\x90\x3a\xf8\xbe\x0b\xec\xdc\xd7\xb4\x5c\x96\xea\x49\x95\xf7\xeb\xbc\x7e\x52\xb8\x5e\xde\xaf\x97\xd5\x66\xbd\x59\x2f\xd5\x63\x12\x0f\x0a\xb5\xbb\x5c\x1c\x97\x16\xdf\x33\xba\x26\xce\x82\x13\xe9\x7d\x23\x3e\x4c\x14\xdb\xb3\xa2\x90\x7d\xdc\xdd\x39\x94\xe6\x23\xf9\xdd\x74\xc7\xc7\xdb\x33\xab\xc8\xb5\xf6\x9c\x3a\xb6\x62\x87\x14\xc7\x48\x6e\xce\x2d\xb2\xac\x6a\xd3\xe8\x3a\x2d\x89\x0f\xd4\xd2\xf9\xa8\x83\xa6\x62\x77\xfe\x9d\xff\xc1\xea\xd2\x20\xda\xef\xc2\xb4\xd4\xc7\x20\x29\x6c\x3d\xc1\x0c\x74\x0d\x9e\xe4\x2e\x28\xc4\x44\x99\x94\xe8\x29\xd4\x14\xb4\x07\x84\xf0\x10\x26\xbf\x81\xd1\xf2\xf6\x8e\xbe\xb9\xac\x83\xe5\xc4\xd7\x2d\xa5\xc3\x42\x74\x5f\x91\xb1\x42\xe1\xf9\x2f\xbc\xd4\xba\xa5\xf8\x4d\xe3\x2f\xc2\xfe\xb5\x42\x27\x2d\x8d\x1e\x42\x9d\x42\x1a\x8e\x9e\x24\xb1\x7a\x5a\x2f\x36\xab\xa7\x3c\xdf\xac\xd7\xe5\x26\xaf\x10\x17\xf8\x40\x9b\xf5\xe3\x43\x5e\x96\x4f\x0f\xea\x86\xe7\x1b\xa3\x85\xca\x1f\x1e\x1e\xab\x45\xb5\x5a\xe3\x26\x5f\x3c\xa9\x15\x6e\xee\xe9\x1e\x37\xea\x61\xb9\xca\x55\x82\x22\xac\xcb\x5e\x86\x41\x4f\xaf\xc2\x08\x86\x24\x7e\x43\x4d\x7b\x09\xc0\x41\x9b\xaa\x80\x70\xdf\x0c\xcc\x84\xf7\x90\x91\xa1\x9e\xb1\xbd\xda\xfc\xeb\x79\xbb\xbd\x83\x97\xf0\x97\xa6\xe9\xbf\x43\xbb\x87\xfb\x46\x9b\xfd\x2e\x74\x83\x27\x29\xe0\x4b\x18\x43\x5b\x92\x30\xcb\x86\xb5\xeb\x67\x54\x9c\x7f\xa3\x41\x02\xd0\xa1\xd1\x35\x79\xd9\x61\x2f\x8d\xe5\x02\xb0\xac\xfa\xb6\x4a\x1a\x5d\x55\x14\x66\x00\xf7\x94\xfc\x19\x00\x00\xff\xff\x7d\x95\xd7\x03\x76\x0a\x00\x00"
-
-func bvlcReferenceRcnnIlsvrc13YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_bvlcReferenceRcnnIlsvrc13Yml,
-		"BVLC-Reference-RCNN-ILSVRC13.yml",
-	)
-}
-
-func bvlcReferenceRcnnIlsvrc13Yml() (*asset, error) {
-	bytes, err := bvlcReferenceRcnnIlsvrc13YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "BVLC-Reference-RCNN-ILSVRC13.yml", size: 2678, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dpn68Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x06\xf1\xa5\x05\x12\xc9\x96\x6c\xaf\xac\x43\x81\x36\xb9\x14\x68\x8d\x60\xb1\x28\x0a\x2c\x0a\x63\x44\x8e\x24\x76\x25\x92\x20\x47\xf1\xba\x4f\x5f\x90\x92\x7f\x82\xcd\xa2\x39\x74\x0f\x5e\x71\xf8\xcd\xff\x37\xc3\x68\x1c\xa8\x82\xa7\xe7\xfd\xb6\x84\x05\x84\x13\x98\x06\x4e\x66\x74\x30\x18\x49\x7d\xd2\x38\x1c\xe8\x68\xdc\x97\x2a\x01\x98\xd0\x9f\x48\x7b\xe3\x3e\x7e\x82\x05\x5c\x6e\xa1\x31\x0e\xb8\xa3\x59\x0b\xe0\x85\x9c\x57\x46\x57\xb0\x4d\x97\xe9\xea\x15\x74\xbe\x02\x61\x34\x3b\x54\x9a\x93\x0b\x78\x95\x2e\x61\x71\x01\x28\xdd\x18\x37\x20\x4f\xdf\xe0\x69\x40\xcd\x4a\x5c\xee\xa7\xdb\x24\xd8\x41\xa5\xc9\x55\xb0\x80\xcb\xc1\xc3\xe8\x49\x02\x1b\xb0\xe4\x02\x72\x0a\x0d\xac\x23\xa9\x44\xb0\x99\xc0\xf5\xdf\x02\x86\xb1\x67\x65\x7b\x02\xdb\x23\x07\xbc\x07\x81\x1a\x6a\x02\x6f\x49\xa8\x46\x91\x4c\x00\x70\x90\xdb\x75\x15\x35\x5b\x3b\x56\xe0\x50\x59\x67\xfe\x26\xc1\x99\x40\x37\xf4\x0f\x1c\x8b\xe3\xb8\x8a\xc8\x07\x61\xc7\x08\x16\xef\x01\xb7\x11\x6c\xad\xd8\xae\x7b\xaa\xde\xa3\x37\x63\x67\xcd\xff\x8c\xe9\x16\x2e\xc9\x0b\xa7\x2c\xc7\xba\xff\x94\x00\x3c\x8d\xd8\xc3\x33\x72\x07\x7b\xe2\xd0\x28\x0f\xe8\x08\x3a\xd5\x76\xfd\x09\xa8\x69\x94\x50\xa4\x19\xf4\xf9\xf6\xd8\x29\xd1\x81\x30\x43\xad\x34\xc5\xe6\x7b\x76\xa4\x5b\xee\x02\x87\x6a\xc3\x1d\x7c\x24\xbf\xa7\x3f\x19\x7e\x6e\x5b\x47\x2d\x32\xc9\x20\x52\x32\xb8\xfa\xe4\x50\xfb\x4b\x83\x7d\x02\x91\x43\x4f\x44\x16\xf6\x34\x3a\xec\x6f\xe2\xd0\x12\x9e\x48\x7b\xda\x13\xfb\xe9\xab\x3f\xc1\xa3\xd1\x9a\x44\xb0\xf9\x68\xf4\x8b\xe9\xc7\x60\xe7\x46\x2d\x4d\x1c\x35\xe4\x48\x0b\xf2\x81\x1a\xd7\x53\x64\x05\xda\x40\x92\x0c\x8e\x54\x7b\xc5\x14\x3e\x89\x45\x9a\xc2\x54\x99\x5a\xe9\xf6\x15\xa3\x1f\xa0\x63\xb6\xbe\xca\xb2\x56\x71\x37\xd6\xa9\x30\x43\xe6\x0d\xe1\x0b\xb9\x4c\x60\xd3\xd0\x43\x84\x66\xec\x88\xb2\x01\x3d\x07\x79\xef\xbf\xa7\x2a\x4e\xf6\x98\x3d\x3d\xef\x7d\xb2\x80\x5e\x89\x90\x54\xa8\xdb\xd5\xe5\x2c\xac\x60\xd4\x8e\x3c\x3b\x15\x72\x4d\x16\xa0\xb4\x1d\x39\xe6\x70\xc5\x4e\xb2\xc0\x99\x05\x34\xca\x79\x9e\x50\xc0\x27\x4b\xdf\xcc\xe6\x43\x14\x57\xa0\x06\x6c\x29\x99\xe8\x7f\x43\x87\x73\x14\x37\x76\x22\xe8\x15\x63\x02\x60\x72\x71\xb5\x62\x31\xcc\x38\x93\x8b\xe5\x8e\xae\xaf\xa2\x79\xda\xa4\x1a\x48\x87\xe9\xf5\x15\x7c\x2e\xee\x21\xcf\xd7\xf1\xe7\xaf\xf9\x7e\x20\xd4\x15\x7c\x5e\xe5\xeb\x74\x79\x0f\xab\xd5\x87\xf8\xdf\x72\x9d\x2e\xcf\x08\x2f\xb0\xa7\x0a\x36\xbb\xb4\x2c\x97\x79\xb1\xdb\xe4\xab\xc4\x8c\x6c\x47\x9e\xd2\x0f\x91\x45\xdf\x73\x1a\xd3\x5d\x02\x73\xd2\x0d\x21\x8f\x8e\x22\x14\xdf\x4a\x7b\xc2\x5f\x23\x4f\xde\xc8\x7c\xc6\xf4\x58\xc7\x82\xde\x24\x3e\x97\xf3\xad\xe4\x67\xcf\xfe\x30\xba\xbe\x8a\x8c\xa8\xb2\xcc\x17\x29\x0e\xf8\x8f\xd1\x78\xf4\x13\xa3\xd8\x38\x4a\xe3\xe0\xa6\xc6\xb5\x99\x3f\x69\x4f\xec\xb3\x58\x66\x4d\x3c\x0b\x52\xfe\xca\xaf\xad\x8a\x8e\xc4\x17\x3f\x0e\x15\xac\x65\x5e\xac\xeb\x4d\x59\x14\x28\x70\xbd\xde\xe5\xe5\x72\xbb\xc1\x55\xb9\x94\x75\xb1\x5c\x6d\x31\x89\x44\x08\x3d\x3a\xaf\x36\x3f\x2f\xc7\xd6\xa1\xed\xe2\xb8\x1d\x49\xb5\x1d\x7b\x70\xe4\xcd\xe8\x04\x85\x14\xe2\xed\xc1\x22\x77\xef\x0f\x3f\xda\xf5\xd3\x7c\x64\xd2\xea\x6d\x99\x49\xb2\xbd\x39\x1d\xe2\xe1\x81\xbe\xb2\xc3\x83\x36\x07\x41\xaa\x3f\x04\x74\x6a\x9d\x61\x33\xa5\x37\x47\xf1\x3f\xf8\xbc\x3a\x4b\xa3\xfc\x3c\x0b\xca\x1f\xd0\x89\x4e\xbd\x04\x6a\x60\xef\x09\x16\xa0\x1a\xf0\xc4\xf7\xa1\xd3\x3a\xb6\xbb\x46\x4f\xa1\x6b\xa0\x3c\x20\x84\x0f\x36\x80\x1a\x66\xcd\xdb\x87\xe4\xe6\x45\x09\x9a\xd7\x92\xdd\x56\x75\x12\x44\xf3\x92\xb4\xe1\xb8\x3d\xbf\x63\xa5\x51\x3d\xc5\x87\xd7\x9f\x09\xfa\x6d\x93\x8e\x8a\x3b\x35\x85\x7a\x0d\x69\x72\x7d\x65\x85\xac\x73\xb9\x29\x6a\xb9\x15\xc5\x6a\x29\x56\x94\x37\x65\x81\x54\xac\x8b\xf5\x6a\xb5\x2d\x31\xbf\x29\xf7\x8d\x52\xb9\xdb\xe5\x42\x94\xbb\x75\x5e\x6e\x3e\x94\x72\xbb\x69\x76\xc5\x52\x48\xb1\x6d\x36\xb9\x28\x57\x09\x32\x3b\x55\x8f\x3c\xad\xd8\x58\xe0\xf3\x0b\x01\xd7\xbb\x04\xe0\x8b\xd2\xb2\x82\xc7\xfd\x7e\xae\x4c\x38\x87\x8c\xf4\xb4\xeb\xcf\x3a\x3f\x3c\xee\xf7\xf7\xf0\x31\xfc\xa4\x69\xfa\x63\x18\xdb\xf0\x37\x82\xd2\xed\x41\x22\xa3\x27\xae\xe0\xd7\x30\x0a\x7b\xe2\xb0\xb6\x26\xd9\xe5\xad\x8f\xab\x6e\x56\x48\x00\x06\xd4\xaa\x21\xcf\x07\x1c\xb9\x33\xae\x02\xac\xe5\xd8\xcb\xa4\x53\x52\x52\x98\x65\x37\x86\x86\xff\x4e\xde\x63\x3b\xaf\x8d\xbb\xc8\x8f\xf4\xd9\x98\x5e\xe9\xf6\xf9\x3c\xc3\x77\xd0\xa1\x07\x6d\xa0\x51\xd4\xcb\xd8\x11\x09\x77\x17\xd6\xde\x25\x8b\xb7\x96\xfc\x2f\x7f\xfc\xf6\x38\x13\xd1\x8e\x7d\x9f\x15\xcb\xcd\x87\x2c\xb4\xd4\x27\xff\x06\x00\x00\xff\xff\x35\x82\x07\x8d\x7b\x09\x00\x00"
-
-func dpn68YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_dpn68Yml,
-		"DPN68.yml",
-	)
-}
-
-func dpn68Yml() (*asset, error) {
-	bytes, err := dpn68YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "DPN68.yml", size: 2427, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dpn92Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4b\x6b\xe4\x46\x10\xbe\xeb\x57\x14\x9e\x4b\x02\xb6\x34\x92\xe6\xa9\x43\x20\xb1\x2f\x81\x64\x30\xcb\x12\x02\x4b\x18\x4a\xdd\x25\xa9\xb3\x52\x77\xd3\x5d\xb2\x77\xf2\xeb\x43\xb7\x34\x0f\xb3\x5e\xb2\x87\xf8\x20\x4b\xd5\x5f\xbd\xbf\xaa\x1e\x8d\x03\x55\xf0\xf4\x7c\xd8\x17\xb0\x80\xf0\x05\xa6\x81\x93\x19\x1d\x0c\x46\x52\x9f\x34\x0e\x07\x7a\x35\xee\x73\x95\x00\x4c\xe8\x8f\xa4\xbd\x71\x1f\x3e\xc2\x02\x2e\xa7\xd0\x18\x07\xdc\xd1\xac\x05\xf0\x42\xce\x2b\xa3\x2b\xd8\xa4\xcb\x34\x7f\x03\x9d\x8f\x40\x18\xcd\x0e\x95\xe6\xe4\x02\xce\xd3\x25\x2c\x2e\x00\xa5\x1b\xe3\x06\xe4\xe9\x1d\x3c\x0d\xa8\x59\x89\xcb\xf9\x74\x9a\x04\x3b\xa8\x34\xb9\x0a\x16\x70\xf9\xf0\x30\x7a\x92\xc0\x06\x2c\xb9\x80\x9c\x42\x03\xeb\x48\x2a\x11\x6c\x26\x70\xfd\x5b\xc0\x30\xf6\xac\x6c\x4f\x60\x7b\xe4\x80\xf7\x20\x50\x43\x4d\xe0\x2d\x09\xd5\x28\x92\x09\x00\x0e\x72\xb3\xaa\xa2\x66\x6b\xc7\x0a\x1c\x2a\xeb\xcc\xdf\x24\x38\x13\xe8\x86\xfe\x81\x63\x71\x1c\x57\x11\xf9\x20\xec\x18\xc1\xe2\x7b\xc0\x6d\x04\x5b\x2b\x36\xab\x9e\xaa\xef\xd1\x9b\xb1\xb3\xe6\x7f\xc6\x74\x0b\x97\xe4\x85\x53\x96\x63\xdd\x7f\x4a\x00\x9e\x46\xec\xe1\x19\xb9\x83\x03\x71\x68\x94\x07\x74\x04\x9d\x6a\xbb\xfe\x04\xd4\x34\x4a\x28\xd2\x0c\xfa\x7c\xfa\xda\x29\xd1\x81\x30\x43\xad\x34\xc5\xe6\x7b\x76\xa4\x5b\xee\x02\x87\x6a\xc3\x1d\x7c\x20\x7f\xa0\x3f\x19\x7e\x6e\x5b\x47\x2d\x32\xc9\x20\x52\x32\xb8\xfa\xe8\x50\xfb\x4b\x83\x7d\x02\x91\x43\x4f\x44\x16\x0e\x34\x3a\xec\x6f\xe2\xd0\x12\x9e\x48\x7b\x3a\x10\xfb\xe9\xad\x3f\xc1\xa3\xd1\x9a\x44\xb0\xf9\x68\xf4\x8b\xe9\xc7\x60\xe7\x46\x2d\x4d\x1c\x35\xe4\x48\x0b\xf2\x81\x1a\xd7\xaf\xc8\x0a\xb4\x81\x24\x19\xbc\x52\xed\x15\x53\x78\x25\x16\x69\x0a\x53\x65\x6a\xa5\xdb\x37\x8c\x7e\x80\x8e\xd9\xfa\x2a\xcb\x5a\xc5\xdd\x58\xa7\xc2\x0c\x99\x37\x84\x2f\xe4\x32\x81\x4d\x43\x0f\x11\x9a\xb1\x23\xca\x06\xf4\x1c\xe4\xbd\xff\x96\xaa\x38\xd9\xd7\xec\xe9\xf9\xe0\x93\x05\xf4\x4a\x84\xa4\x42\xdd\xae\x2e\x67\x61\x05\xa3\x76\xe4\xd9\xa9\x90\x6b\xb2\x00\xa5\xed\xc8\x31\x87\x2b\x76\x92\x05\xce\x2c\xa0\x51\xce\xf3\x84\x02\x3e\x59\xfa\x6a\x36\x1f\xa2\xb8\x02\x35\x60\x4b\xc9\x44\xff\x1b\x3a\x9c\xa3\xb8\xb1\x13\x41\x6f\x18\x13\x00\x93\x8b\xab\x15\x8b\x61\xc6\x99\x5c\x2c\x77\x74\x7d\x15\xcd\xd3\x26\xd5\x40\x3a\x4c\xaf\xaf\xe0\x53\x79\x0f\x45\xb1\x8a\x8f\xbf\xe6\xf3\x81\x50\x57\xf0\x29\x2f\x56\xe9\xf2\x1e\xf2\x7c\x1b\xff\x2d\x57\xe9\xf2\x8c\xf0\x02\x7b\xaa\x60\xbd\x4f\x77\xbb\x65\x51\xee\xd7\x45\x9e\x98\x91\xed\xc8\x53\xfa\x21\xb2\xe8\x7b\x4e\x63\x3a\x4b\x60\x4e\xba\x21\xe4\xd1\x51\x84\xe2\x7b\x69\x4f\xf8\x6b\xe4\xc9\x3b\x99\xcf\x98\x1e\xeb\x58\xd0\x9b\xc4\xe7\x72\xbe\x97\xfc\xec\xd9\x1f\x47\xd7\x57\x91\x11\x55\x96\xf9\x32\xc5\x01\xff\x31\x1a\x5f\xfd\xc4\x28\x36\x8e\xd2\x38\xb8\xa9\x71\x6d\xe6\x4f\xda\x13\xfb\x2c\x96\x59\x13\xcf\x82\x94\xbf\xf0\x5b\xab\xa2\x23\xf1\xd9\x8f\x43\x05\x2b\x59\x94\xab\x7a\xbd\x2b\x4b\x14\xb8\x5a\xed\x8b\xdd\x72\xb3\xc6\x7c\xb7\x94\x75\xb9\xcc\x37\x98\x44\x22\x84\x1e\x9d\x57\x9b\x9f\x97\x63\xeb\xd0\x76\x71\xdc\x5e\x49\xb5\x1d\x7b\x70\xe4\xcd\xe8\x04\x85\x14\xe2\xe9\xd1\x22\x77\xdf\x1f\x7e\xb4\xeb\xa7\xf9\xc8\xa4\xd5\xfb\x22\x93\x64\x7b\x73\x3a\xc6\x8f\xa3\x36\x47\x41\xaa\x3f\x06\x5c\x6a\x9d\x61\x33\x25\x36\xfb\xff\x1f\xbc\x85\x67\x1a\x25\x67\xfe\x2b\x7f\x44\x27\x3a\xf5\x12\xe8\x80\xbd\x27\x58\x80\x6a\xc0\x13\xdf\x87\xee\xea\xd8\xe2\x1a\x3d\x85\x4e\x81\xf2\x80\x10\x5e\xd8\x00\x6a\x98\x35\x6f\x2f\x8f\x9b\x5b\x24\x68\x5e\xcb\x74\x5b\xc9\x49\x10\xcd\x4b\xd2\x86\xe3\xc6\xfc\x86\x95\x46\xf5\x14\x2f\x5b\x7f\x26\xe5\xd7\x8d\x79\x55\xdc\xa9\x29\xd4\x6b\x48\x93\xeb\x2b\x13\x0a\xb1\xc9\xf3\x4d\xbe\xd9\x6d\xf6\x85\xd8\x51\xb9\x95\x9b\x72\xbd\xcf\x57\xb9\x2c\xf7\xd4\xe0\xf6\xa6\xd0\x57\xa5\x1d\xca\x6d\x81\xeb\x32\xdf\x95\x9b\x66\xdb\x6c\x45\xb1\xa5\x55\xb3\x91\x2b\xb1\x5e\xaf\xeb\xdd\x3a\x41\x66\xa7\xea\x91\xa7\xb5\x4a\x5f\xd8\xe1\xf9\x56\x80\xeb\x59\x02\xf0\x59\x69\x59\xc1\xe3\xe1\x30\x57\x26\x7c\x87\x8c\xf4\xb4\xdf\xcf\x3a\x3f\x3c\x1e\x0e\xf7\xf0\x21\x3c\xd2\x34\xfd\x31\x8c\x6a\xf8\x5d\xa0\x74\x7b\x94\xc8\xe8\x89\x2b\xf8\x35\xd0\xff\x40\x1c\x56\xd5\x24\xbb\xdc\xef\x71\xbd\xcd\x0a\x09\xc0\x80\x5a\x35\xe4\xf9\x88\x23\x77\xc6\x55\x80\xb5\x1c\x7b\x99\x74\x4a\x4a\x0a\xf3\xeb\xc6\xd0\xf0\xdf\xc9\x7b\x6c\xe7\x55\x71\x17\xf9\x91\x3e\x1b\xd3\x2b\xdd\x3e\x9f\xe7\xf6\x0e\x3a\xf4\xa0\x0d\x34\x8a\x7a\x19\x3b\x22\xe1\xee\xc2\xd7\xbb\x64\xf1\xde\x62\xff\xe5\x8f\xdf\x1e\x67\x0a\xda\xb1\xef\xb3\x72\xb9\xde\x66\xa1\xa5\x3e\xf9\x37\x00\x00\xff\xff\xab\x92\x23\x9e\x6f\x09\x00\x00"
-
-func dpn92YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_dpn92Yml,
-		"DPN92.yml",
-	)
-}
-
-func dpn92Yml() (*asset, error) {
-	bytes, err := dpn92YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "DPN92.yml", size: 2415, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _inceptionResnetV2Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xbd\x8e\xe3\x36\x10\xee\xf5\x14\x03\xb8\x49\x80\xb5\x64\xcb\xff\x2c\xd2\x5c\x9a\x6b\xf6\x80\xc3\x75\x87\xc0\x18\x51\x23\x9b\x39\x89\x24\x38\xa3\xf5\x6e\x9e\x3e\x20\x25\x5b\x36\x6e\x0f\xd9\x22\x2e\x04\x69\xe6\x9b\xff\xf9\x48\x5b\xec\x48\xc1\x67\xab\xc9\x8b\x71\x76\xfe\x95\xf8\x99\x04\x66\x10\x15\xe0\x1a\x78\x73\x7d\x80\xce\xd5\xd4\x66\x4d\xc0\x8e\x2e\x2e\xfc\x50\x19\xc0\x60\xf8\x8d\x2c\xbb\xf0\xf5\x1b\xcc\xe0\xa6\x85\xc6\x05\x90\x33\x8d\x56\x00\x2f\x14\xd8\x38\xab\x60\x9b\x2f\xf2\xe5\x03\x74\x54\x81\x76\x56\x02\x1a\x2b\xd9\x0d\x5c\xe6\x0b\x98\xdd\x00\xc6\x36\x2e\x74\x28\xc3\x3b\x30\x75\x68\xc5\xe8\x9b\x7e\xd0\x66\xd1\x0f\x1a\x4b\x41\xc1\x0c\x6e\x1f\x0c\x3d\x53\x0d\xe2\xc0\x53\x88\xc8\x21\x35\xf0\x81\x6a\xa3\xa3\xcf\x0c\xa6\xdf\x0c\xba\xbe\x15\xe3\x5b\x02\xdf\xa2\x44\x3c\x83\x46\x0b\x15\x01\x7b\xd2\xa6\x31\x54\x67\x00\xd8\xd5\xdb\xb5\x4a\x96\x27\xdf\x2b\x08\x68\x7c\x70\x7f\x93\x96\x42\x63\xe8\xda\xb9\xa4\xe6\x04\x51\x09\x39\xd7\xbe\x4f\x60\xfd\x11\xf0\x29\x81\xbd\xd7\xdb\x75\x4b\xea\x23\x76\x23\x76\xb4\xfc\xcf\x9c\xee\xe1\x35\xb1\x0e\x26\x2d\x80\x82\x3f\x32\x80\x6f\x5f\xfe\xfc\x92\x05\x6a\x28\x90\xd5\xc4\xb1\x99\xd3\x57\xea\x23\xfa\xd8\xd6\x02\x2e\x54\xb1\x11\x8a\xaf\x24\x3a\xcf\x61\xf0\x55\x19\x7b\x7a\xd8\x81\x39\x9c\x45\x3c\xab\xa2\x38\x19\x39\xf7\x55\xae\x5d\x57\xb0\x23\x7c\xa1\x50\x68\x6c\x1a\x9a\x27\x68\x21\x81\xa8\xe8\x90\x25\xca\x5b\x7e\x30\xc5\xf0\x6a\x5e\x72\x17\x4e\x05\x56\x5c\x2c\xb7\x8b\x32\x5f\xec\xca\xed\xf2\x57\xfe\x87\x62\x9b\xd6\x5d\x8a\xe4\x9c\x8b\xaa\x75\xd5\xd5\x7b\x20\x26\x0c\xfa\x5c\x70\x6b\xba\xc2\x07\xf2\xc1\x69\x62\x36\xf6\x54\x98\x2b\x21\x8e\x0f\xf2\xdc\xbf\x65\x33\x68\x8d\x26\xcb\x89\x1d\x53\x89\xa3\x50\x41\x6f\x03\xb1\x04\xa3\x85\xea\x6c\x06\xc6\xfa\x5e\x52\xcf\x26\xec\x20\x8b\x53\x9d\x41\x63\x02\xcb\x80\x02\x79\xf3\xf4\x13\x7b\xe6\x49\xac\xc0\x74\x78\xa2\x6c\x58\xd0\xbb\x81\x5d\xb3\xb8\xf3\x93\x40\x0f\x33\x8d\x80\x21\xc4\xe4\xc5\x63\x64\xa1\x50\x48\xe3\x4d\xa1\x27\xd1\xc8\x87\xda\x74\x64\x23\xbf\x58\xc1\xf7\xd5\x13\x94\x87\x43\x7a\xfc\x35\xea\x3b\x42\xab\xe0\xfb\xb2\xdc\x3f\xc1\xf5\x71\xd5\xb1\xc6\x96\x54\x94\x64\xae\x17\xdf\xcb\x50\x6f\x4c\x25\x05\x1b\xf3\x1e\x74\x19\x8c\x55\x36\x84\xd2\x07\x4a\x50\x7c\xaf\xce\x01\x3f\xa5\x9a\xbd\x53\xea\x88\x69\xb1\x4a\x1d\xbc\xab\x74\xec\xdf\x7b\xd5\x8e\x91\xf9\xd8\x87\x56\xa5\x6d\x52\x45\xc1\xab\x1c\x3b\xfc\xc7\x59\xbc\xf0\xb0\xb2\xe2\x02\xe5\x89\x4b\x69\x11\xf9\xcd\x32\x09\x17\xa9\xaf\x96\x64\x14\xe4\xf2\x2a\x8f\x5e\xf5\x99\xf4\x0f\xee\x3b\x05\xeb\xba\x5c\xad\xab\xcd\x7e\xb5\x42\x8d\xeb\xf5\xa1\xdc\x2f\xb6\x1b\x5c\xee\x17\x75\xb5\x5a\x2c\xb7\x98\xa5\xc9\xc7\xa1\x5c\x4f\x1b\x1e\xcf\xab\x53\x40\x7f\x06\xb4\x35\x5c\xc8\x9c\xce\xc2\x10\x88\x5d\x1f\x34\xc5\x12\x92\xf6\xe8\x51\xce\x1f\x4f\x7f\xa4\x45\x22\xe0\xb4\xf4\xf3\x40\x6c\x49\xe6\x2f\x65\x51\x93\x6f\xdd\xdb\xf1\x1d\x55\xee\x83\x13\x37\xd4\x39\xa6\xf3\x7f\x07\x7f\x2f\x6a\x42\x5f\xb9\x61\xf8\x18\x19\x6c\x5e\xe2\xe6\x60\xcb\x04\x33\x30\x0d\x30\xc9\x53\x5c\x04\x9b\xb6\xa1\x42\xa6\x38\x54\x30\x0c\x08\xf1\x45\x1c\xa0\x85\xd1\xf2\xfe\xe8\xbf\xbb\x03\xa2\xe5\xd4\xd1\xfb\xa6\x0f\x82\xe4\xbe\x26\xeb\x84\xe2\xfb\x2f\xbc\x34\xa6\xa5\x74\x55\xf2\x75\x7f\x7f\x9e\xe1\xc5\xc8\xd9\x0c\xa9\x4e\x29\x0d\xa1\xa7\xa5\x69\xd6\x55\xa3\x9b\xcd\xae\x5a\x1e\xd6\xbb\xc5\x66\x55\x6a\xbd\xaa\xca\xf5\x1a\x49\xef\x56\x1b\x5a\xdd\x0d\x61\x32\xda\xea\x1a\x77\x15\x69\x2c\x77\x55\xb5\xd0\x07\x3a\xec\x97\x9a\xca\x65\xbd\x69\xca\x5d\xb5\xdc\x67\x28\x12\x4c\xd5\xcb\x70\xc4\xd3\xab\x04\x04\x4b\x92\xae\xe6\x49\x97\x01\xfc\x30\xb6\x56\xf0\xe9\xf9\x79\xec\x4c\xfc\x8e\x15\x59\xea\x03\xb6\x37\x9b\xdf\x3e\x3d\x3f\x3f\xc1\xd7\xf8\xc8\xf3\xfc\xf7\xc8\xea\x78\xab\x1b\x7b\x3a\xd6\x28\xc8\x24\x0a\x3e\x47\xa6\x0c\xff\x31\x46\xd9\xed\x76\x4e\x47\xdf\x68\x90\x01\x74\x68\x4d\x43\x2c\x47\xec\xe5\xec\x82\x02\xac\xea\xbe\xad\xb3\x7f\x03\x00\x00\xff\xff\xe6\xfb\x88\x99\xb4\x08\x00\x00"
-
-func inceptionResnetV2YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_inceptionResnetV2Yml,
-		"Inception-ResNet-v2.yml",
-	)
-}
-
-func inceptionResnetV2Yml() (*asset, error) {
-	bytes, err := inceptionResnetV2YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "Inception-ResNet-v2.yml", size: 2228, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _inceptionV3Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcd\x8e\xe3\x36\x0c\xbe\xfb\x29\x88\xc9\xa5\x05\x12\x3b\x3f\x93\x4c\xc6\x87\x5e\x76\x2f\x0b\x14\x73\x58\x2c\x7a\xe8\xa2\x08\x68\x99\x8a\xd5\x91\x25\x43\xa4\x27\x9b\x3e\x7d\x21\xd9\x89\x13\xec\x2e\x3a\x87\xe6\x10\xd8\xe4\x47\x8a\x3f\x1f\x29\x3b\x6c\xa9\x84\x4f\x4e\x51\x27\xc6\x3b\x98\x41\x94\x80\xd7\x70\xf6\x7d\x80\xd6\xd7\x64\x33\x1d\xb0\xa5\x93\x0f\xaf\x65\x06\x30\x58\x7c\x21\xc7\x3e\x7c\xfe\x02\x33\xb8\x6a\x41\xfb\x00\xd2\xd0\x68\x05\xf0\x46\x81\x8d\x77\x25\xec\xf2\x65\xbe\xba\x83\x8e\x2a\x50\xde\x49\x40\xe3\x24\xbb\x82\x37\xf9\x12\x66\x57\x80\x71\xda\x87\x16\x65\x78\x06\xa6\x16\x9d\x18\x75\xd5\x0f\xda\x2c\xfa\x41\xe3\x28\x94\x30\x83\xeb\x0b\x43\xcf\x54\x83\x78\xe8\x28\x44\xe4\x10\x1a\x74\x81\x6a\xa3\xa2\xcf\x0c\xa6\xdf\x0c\xda\xde\x8a\xe9\x2c\x41\x67\x51\x22\x9e\x41\xa1\x83\x8a\x80\x3b\x52\x46\x1b\xaa\x33\x00\x6c\xeb\xdd\x63\x99\x2c\x8f\x5d\x5f\x42\x40\xd3\x05\xff\x37\x29\x29\x14\x86\xd6\x2e\x24\x15\x27\x48\x99\x90\x0b\xd5\xf5\x09\xac\xde\x03\x3e\x26\x70\xd7\xa9\xdd\xa3\xa5\xf2\x3d\x76\x23\x76\xb4\xfc\xcf\x98\x6e\xe1\x35\xb1\x0a\x26\x75\xbe\x84\xdf\x32\x98\x88\xb0\x78\xdb\x80\x61\x48\xbd\xa1\xfa\xda\xd9\x4f\x2d\x1e\xe9\x85\x04\x7e\xc7\x70\x24\xf8\xc3\x70\x8f\x16\x3e\x93\xf2\x47\x67\x52\x8f\x3e\x34\x68\x2d\xb9\x23\x41\xcf\xc6\x1d\x93\x55\x8d\x82\xa0\x83\x6f\x61\xbd\x5c\xad\xf3\x0c\xe0\x4b\x63\x38\xfa\x47\x60\x41\x57\x63\xa8\x41\x90\x5f\x63\x87\x95\x6f\xbb\x5e\x28\xc0\x9b\x89\x0d\x9e\xc3\xa9\xa1\x30\x72\x2a\x06\x74\x8e\xed\x54\x16\x99\x8d\x3e\x03\x39\x31\x81\xc0\xc4\xb8\x18\x8c\x13\x0f\xab\xe5\x72\x39\x00\x88\xe7\x60\xcd\x2b\xc1\xc3\x9f\x54\x05\x7c\x98\xc3\xc3\x47\xb4\x91\x4c\xe8\x1e\xe6\x80\xae\x86\x87\x8f\x86\x9b\x13\x72\x43\xe1\x21\xcf\x02\x69\x0a\xe4\x14\x71\xa4\xd1\xf4\x96\x18\x84\x5d\x24\x54\x01\x27\xaa\xd8\x08\xc5\x47\x12\x95\xe7\x30\x54\xb1\xba\x64\x7b\x61\xff\x02\x1a\x91\x8e\xcb\xa2\x38\x1a\x69\xfa\x2a\x57\xbe\x2d\xd8\x13\xbe\x51\x28\x14\x6a\x4d\x8b\x04\x2d\x24\x10\x15\x2d\xb2\x44\xb9\xe5\x9f\x99\xd6\xad\x55\x45\xfb\xcd\x91\x0c\x76\x8b\x63\xac\x74\x38\x17\x95\xf5\xd5\xc5\x3e\x15\x22\x42\x56\xaf\x0b\x73\xd3\xcc\xbc\xad\x7f\xe6\x77\x60\x86\xb6\xfe\x54\x0c\x45\xbe\x73\x18\x88\x09\x83\x6a\x0a\xb6\xa6\x2d\xba\x40\x5d\xf0\x8a\x38\xf6\xb6\xb8\x1e\x70\xb8\x93\xe7\xdd\x39\x9b\x81\x35\x8a\x1c\xa7\x55\x32\x55\x65\x14\x96\xd0\xbb\x40\x2c\xc1\x28\xa1\x3a\x9b\x81\x71\x5d\x2f\xa9\xcc\x13\x76\x90\xc5\x11\x98\x81\x36\x81\x65\x40\x81\x9c\x3b\xfa\x6e\xd5\x2c\x92\xb8\x1c\x88\x90\x0d\xd3\x7c\xc3\xee\x4b\x14\x37\x7e\x12\xe8\x6e\x00\x22\x60\x38\x62\xf2\xd2\x61\x5c\x59\x42\x21\x31\x22\x1d\x3d\x89\xc6\xe5\x51\x9b\x96\x5c\xe4\x2a\x97\xf0\x75\x33\x87\xf5\xf3\x73\xfa\xfb\x6b\xd4\xb7\x84\xae\x84\xaf\xab\xf5\x7e\x0e\x97\xbf\x8b\x8e\x15\x5a\x2a\xa3\x24\xf3\xbd\x74\xbd\x0c\xf9\xc6\x50\xd2\x61\x63\xdc\x83\x2e\x83\x31\x4b\x4d\x28\x7d\xa0\x04\xc5\x1f\xe5\x39\xe0\xa7\x50\xb3\x1f\xa4\x3a\x62\x2c\x56\xa9\x82\x37\x99\x8e\xf5\xfb\x51\xb6\xe3\xc9\x7c\xe8\x83\x2d\x13\x9b\xca\xa2\xe0\x4d\x8e\x2d\xfe\xe3\x1d\x9e\x78\x60\xb9\xf8\x40\x79\x5a\x3c\xb9\x0f\xc7\x82\xcf\x8e\x49\xf8\xca\xce\x51\x10\x27\x35\x97\x6f\x72\xef\x59\x35\xa4\x5e\xb9\x6f\x4b\x78\xda\xac\xb6\x6a\xad\xf6\x95\xd6\x75\xb5\xdb\x2f\x95\xde\xab\x6d\xbd\xc6\x27\xf5\xb8\x55\x9b\xdd\x26\x4b\xdd\x8f\x8d\xb9\xac\x67\x1e\x17\xfc\x31\x60\xd7\xa4\x01\x3f\x91\x39\x36\xc2\x10\x88\x7d\x1f\x14\xc5\x34\x92\xf6\xd0\xa1\x34\xef\x4f\x61\x1c\x8d\x34\xb7\xc5\xed\x64\x15\x35\x75\xd6\x9f\x0f\x77\xd3\xd6\x05\x2f\x7e\xc8\x6c\x0c\xe0\x7f\x3b\xee\xee\x9c\xa4\xbf\xcc\x80\xe1\x43\x9c\x54\xf3\x16\x19\x82\x96\x09\x66\x60\x34\x30\xc9\x3c\x36\xdc\xa5\xae\x57\xc8\x14\x9b\x37\x6c\xdf\xf8\x20\x1e\xd0\xc1\x68\x79\x7b\x1f\xde\x5c\x8c\xd1\x72\xaa\xda\x6d\x61\x07\x41\x72\x5f\x93\xf3\x42\xf1\xf9\x27\x5e\xb4\xb1\x94\xbe\x1f\xf8\xc2\xd3\xef\xfb\x74\x32\xd2\x98\x21\xd4\x29\xa4\xe1\xe8\x89\x18\x7a\xb7\xde\xac\xab\xed\x6e\x55\xad\xb5\xae\x56\x5a\xaf\xeb\x27\xf5\xa8\xf5\xee\x79\xfb\xa4\x77\xbb\xea\xa6\xec\x93\xd1\x72\xbb\xda\xa9\x2d\xd6\xcb\x6d\xb5\x5d\xea\x9d\x7a\x5a\xe1\x7e\xff\xbc\xc2\xa5\xaa\x76\xea\x91\xf6\x19\x8a\x04\x53\xf5\x32\x6c\x7f\xfa\x26\x01\xc1\x91\xa4\xef\x95\x49\x97\x01\xbc\x1a\x57\x97\xf0\xe1\xe5\x65\xac\x4c\x7c\x8f\x19\x39\xea\x03\xda\xab\xcd\x2f\x1f\x5e\x5e\xe6\xf0\x39\xfe\xe5\x79\xfe\x6b\x9c\xde\x78\x9d\x1a\x77\x3c\xc4\x1b\x91\x49\xca\xe9\x42\x9d\xc1\x28\xbb\x7e\xb2\xa4\x15\x37\x1a\x64\x00\x2d\x3a\xa3\x89\xe5\x80\xbd\x34\x3e\x94\x80\x55\xdd\xdb\x3a\xfb\x37\x00\x00\xff\xff\x9e\x98\x41\x37\xc2\x09\x00\x00"
-
-func inceptionV3YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_inceptionV3Yml,
-		"Inception-v3.yml",
-	)
-}
-
-func inceptionV3Yml() (*asset, error) {
-	bytes, err := inceptionV3YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "Inception-v3.yml", size: 2498, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _inceptionV4Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xdb\x38\x0c\xbd\xfb\x57\x10\xc8\xa1\xbb\xc0\xc4\xce\x77\x32\x3e\xec\xa5\xa7\xee\x61\x0e\x45\xb1\x97\x62\x11\xd0\x32\x1d\x6b\x47\x96\x04\x91\x4e\x3a\xfb\xeb\x17\x92\x9d\x38\x41\xdb\x6d\x0f\xcd\x21\xb0\xc9\x47\x4a\x7c\xa4\x9e\x6c\xb1\xa3\x12\x3e\x58\x45\x5e\xb4\xb3\x30\x83\x68\x01\xd7\xc0\x9b\xeb\x03\x74\xae\x26\x93\x35\x01\x3b\xba\xb8\xf0\x5a\x66\x00\x43\xc4\x27\xb2\xec\xc2\xc7\x4f\x30\x83\x9b\x17\x1a\x17\x40\x5a\x1a\xa3\x00\xce\x14\x58\x3b\x5b\xc2\x2e\x5f\xe4\xcb\x07\xe8\xe8\x02\xe5\xac\x04\xd4\x56\xb2\x1b\x78\x93\x2f\x60\x76\x03\x68\xdb\xb8\xd0\xa1\x0c\xcf\xc0\xd4\xa1\x15\xad\x6e\xfe\xc1\x9b\xc5\x3c\xa8\x2d\x85\x12\x66\x70\x7b\x61\xe8\x99\x6a\x10\x07\x9e\x42\x44\x0e\x5b\x03\x1f\xa8\xd6\x2a\xe6\xcc\x60\xfa\xcd\xa0\xeb\x8d\x68\x6f\x08\xbc\x41\x89\x78\x06\x85\x16\x2a\x02\xf6\xa4\x74\xa3\xa9\xce\x00\xb0\xab\x77\x9b\x32\x45\x9e\x7c\x5f\x42\x40\xed\x83\xfb\x87\x94\x14\x0a\x43\x67\xe6\x92\xc8\x09\x52\x26\xe4\x5c\xf9\x3e\x81\xd5\xcf\x80\x4f\x09\xec\xbd\xda\x6d\x0c\x95\x3f\x13\x37\x62\xc7\xc8\x1f\xee\xe9\x1e\x5e\x13\xab\xa0\x53\xe7\x4b\xf8\x23\x9b\xe6\x60\x7e\xde\xc0\xa5\xd5\xaa\x85\x16\x19\x10\x3a\x17\x08\x7a\xab\x13\x87\xc0\xba\xf3\x26\xb1\x01\x80\x41\xb5\x5a\x48\x49\x1f\x08\x00\x6d\x0d\x03\x18\xf4\x6d\xa6\x22\xe9\xbd\x21\x06\x69\xd1\xde\xaf\xb1\xce\xb3\x40\x0d\x05\xb2\x8a\x38\x36\x6e\x7a\x4b\x3d\x43\x1f\x5b\x58\xc0\x85\x2a\xd6\x42\xf1\x91\x44\xe5\x39\x0c\xfb\xae\xb4\x3d\x3d\xcc\xdb\x1c\x5a\x11\xcf\x65\x51\x9c\xb4\xb4\x7d\x95\x2b\xd7\x15\xec\x08\xcf\x14\x0a\x85\x4d\x43\xf3\x04\x2d\x24\x10\x15\x1d\xb2\x44\xbb\xe1\x87\x50\x0c\x5f\xf4\x39\x77\xe1\x54\x60\xc5\xc5\x72\xb7\x58\xe5\x8b\xfd\x6a\xb7\xfc\x5e\xfe\x81\xd8\xc6\xb8\x4b\x91\x92\x73\x51\x19\x57\x5d\xb3\x07\x62\x8a\x14\x15\x6c\x74\x57\x58\x12\x2e\x6e\xc4\x1c\xcf\x9b\xdc\xbf\xfd\x8a\xbc\x3e\x90\x0f\x4e\x11\xb3\xb6\xa7\xbb\x05\x1e\xec\xff\xb3\xd6\x2b\x59\x61\xd7\x75\x14\x8a\x57\x0a\xc8\xf3\x5b\x8a\xbf\x36\x85\x66\xee\x89\x8b\x6d\x36\x03\xa3\x15\x59\x4e\xf2\x30\xf1\x3e\x1a\x4b\xe8\x6d\x20\x96\xa0\x95\x50\x9d\xcd\x40\x5b\xdf\x4b\x6a\xe4\x84\x1d\x6c\x71\xac\x67\xd0\xe8\xc0\x32\xa0\x40\xde\x3c\x7d\x25\x1f\xf3\x64\x2e\x41\x77\x78\xa2\x6c\x38\xa1\x77\x13\x7b\xdd\xc5\x5d\x9e\x04\x7a\x18\xea\x08\x18\x96\x98\xb2\x78\x8c\x32\x24\x14\xd2\xcc\xa5\xa5\x27\xd3\x28\x08\x64\xa8\x23\x2b\xc7\x61\x07\x8d\x71\x28\xeb\xd5\xe8\x4b\xf9\x8e\x06\xdf\xa2\xda\xbc\xab\x51\xf0\xdd\xe8\xa9\x75\x47\x36\xca\x12\x97\xf0\x79\xfd\x04\xab\xe7\xe7\xf4\xf7\xf7\xe8\xef\x08\x6d\x09\x9f\x97\xab\xc3\x13\x5c\xff\xae\x3e\x56\x68\xa8\x8c\x96\xcc\xf5\xe2\x7b\x19\x58\x8a\x05\xa4\x2d\x8e\xd5\x0e\xbe\x0c\x46\x6e\x94\x41\x66\xdd\x68\x85\xa3\x9a\xcd\x00\xbf\x45\xd2\x10\x36\xd5\x99\x7d\x83\xa7\x11\x63\xb0\x4a\xf4\xff\x98\xa6\xef\x93\xe4\x83\xab\xb0\xd2\x46\x8b\x26\xbe\x51\x15\xad\x03\x55\x0d\x61\x14\x0c\x3e\xf6\xc1\x94\x69\x22\xcb\xa2\xe0\x75\x8e\x1d\xfe\xeb\x2c\x5e\x78\x38\xba\xe2\x02\xe5\x49\xbf\xd2\x81\xe4\x37\xcb\xe9\x04\xc5\x56\x5a\x92\xd1\x90\xcb\x17\x79\xcc\xaa\x5a\x52\xaf\xdc\x77\x25\x6c\xea\xd5\x7a\x53\x6d\x0f\xeb\x35\x2a\xdc\x6c\x9e\x57\x87\xc5\x6e\x8b\xcb\xc3\xa2\xae\xd6\x8b\xe5\x0e\xb3\x34\x6c\xb1\xc0\xab\xc2\xf3\x78\x47\x9c\x02\xfa\x36\x09\xda\x85\xf4\xa9\x15\x86\x40\xec\xfa\xa0\x28\x16\x9f\x30\x63\xe1\x49\x59\x32\x18\x22\x8e\x1e\xa5\xfd\xf9\x92\xc6\xa3\x9d\x52\x4c\x07\x77\x7e\xde\x14\x35\x79\xe3\xde\x8e\xf7\xb6\xdc\x07\x27\x6e\xa8\x76\xdc\xd4\x2f\x5b\xee\x61\x9d\xe4\xbf\x1e\x43\xcd\xc7\x24\xf2\xe7\xd8\x63\x34\x4c\x30\x03\xdd\x00\x93\x3c\xc5\xb1\xb1\x69\x76\x2a\x64\x8a\xcd\x04\x1d\xaf\x8b\xf8\x20\x0e\xd0\xc2\x18\x79\x7f\xcd\xde\xdd\xb7\x31\x72\x62\xed\x9e\xec\xc1\x90\xd2\xd7\x64\x9d\x50\x7c\xfe\x4e\x96\x46\x1b\x4a\x9f\x25\x7c\x9d\xf6\xaf\x7b\x77\xd1\xd2\xea\x61\xab\xd3\x96\x86\xa5\xa7\x61\xd9\xd7\xab\xc3\x16\x77\xcb\xed\xf3\x76\xbf\xdc\xaa\x7a\x5f\x2f\x70\xbb\x58\xab\x7d\xdd\x2c\x2b\x5a\xac\xee\x68\x9f\x82\xd4\xbe\xaa\x57\xb4\xdf\xd0\x02\xab\xf5\x7e\xb7\xdb\x2f\xb1\xd9\xa9\xf5\xe1\xd0\xec\x56\x2b\x3a\x2c\x32\x14\x09\xba\xea\x65\xb8\xe2\xe8\x8b\x04\x04\x4b\x92\x3e\x83\x26\x5f\x06\xf0\xaa\x6d\x5d\xc2\xfb\x97\x97\x91\x99\xf8\x1e\x2b\xb2\xd4\x07\x34\xb7\x98\xdf\xde\xbf\xbc\x3c\xc1\xc7\xf8\x97\xe7\xf9\xef\x51\x0a\xe2\x17\x94\xb6\xa7\x63\xd4\x22\x26\x29\xe1\x43\x3c\x21\x2f\x24\x51\x31\x07\xdb\xed\x4b\x28\xa9\xec\x18\x10\x07\x19\xad\x6e\x88\xe5\x88\xbd\xb4\x2e\x94\x80\x55\xdd\x9b\xfa\x09\xfe\xd4\xf6\x94\x30\xff\x05\x00\x00\xff\xff\xeb\x88\x15\xa2\x23\x0a\x00\x00"
-
-func inceptionV4YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_inceptionV4Yml,
-		"Inception-v4.yml",
-	)
-}
-
-func inceptionV4Yml() (*asset, error) {
-	bytes, err := inceptionV4YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "Inception-v4.yml", size: 2595, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _inceptionbn21kYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x4b\x6f\xe3\x36\x10\xbe\xeb\x57\x0c\xe0\x4b\x0b\x44\x92\x9f\xb2\xad\x43\x0f\x9b\x5e\x16\x6d\xbd\xc0\x22\xe8\xa1\x8b\x45\x30\xa2\x86\x16\x1b\x89\x24\xc8\xd1\x3a\xee\xaf\x2f\x48\xc9\x96\xd3\x4d\xd0\x1c\x56\x07\x81\x9c\xf9\xe6\xfd\xa0\xc6\x8e\x4a\xf8\xa8\x05\x59\x56\x46\x7f\x38\xa4\xcb\xc5\x6f\x30\x83\x40\x07\x23\xe1\x6c\x7a\x07\x9d\xa9\xa9\x4d\xa4\xc3\x8e\x4e\xc6\x3d\x95\x09\xc0\x20\xf7\x40\xda\x1b\xf7\xf9\x01\x66\x70\xe5\x82\x34\x0e\xb8\xa1\x51\x0a\xe0\x1b\x39\xaf\x8c\x2e\xa1\xc8\xe6\xd9\xe2\x05\x74\x64\x81\x30\x9a\x1d\x2a\xcd\xc9\x15\xbc\xcc\xe6\x30\xbb\x02\x94\x96\xc6\x75\xc8\xc3\x19\x3c\x75\xa8\x59\x89\x2b\x7f\xe0\x26\x41\x0f\x2a\x4d\xae\x84\x19\x5c\x2f\x1e\x7a\x4f\x35\xb0\x01\x4b\x2e\x20\x07\xd7\xc0\x3a\xaa\x95\x08\x3a\x13\x98\xbe\x19\x74\x7d\xcb\xca\xb6\x04\xb6\x45\x0e\x78\x0f\x02\x35\x54\x04\xde\x92\x50\x52\x51\x9d\x00\x60\x57\x17\xeb\x32\x4a\x1e\x6d\x5f\x82\x43\x65\x9d\xf9\x9b\x04\xe7\x02\x5d\xd7\xa6\x1c\x93\xe3\xb8\x8c\xc8\x54\xd8\x3e\x82\xc5\x7b\xc0\xc7\x08\xb6\x56\x14\xeb\x96\xca\xf7\xc8\x8d\xd8\x51\xf2\x7f\x7d\xba\x85\xd7\xe4\x85\x53\xb1\xfe\x25\xfc\x92\x00\x3c\x7c\xfa\xf5\x53\xe2\x48\x92\x23\x2d\xc8\x87\x64\x4e\xb7\x98\x47\xb4\x21\xad\x39\x9c\xa8\xf2\x8a\x29\x1c\x89\x45\x96\xc1\xa0\xab\x52\xfa\xf8\xa2\x07\x52\x68\x98\xad\x2f\xf3\xfc\xa8\xb8\xe9\xab\x4c\x98\x2e\xb7\xe4\xb8\xf7\x98\xff\xa7\xfb\x52\x69\x5c\x7a\x8f\x52\xd2\x5b\x82\x1f\xfe\xfc\xfd\x3e\x17\x01\x91\x9f\xd4\x93\xca\xff\x08\x56\xd2\xbf\x8c\x79\x4b\xa0\xee\x5a\x91\x77\xcf\x9a\x38\x8d\x1e\xa5\x47\x6c\x5b\x72\xe7\xbc\x6a\x4d\x95\x77\xe8\x99\x5c\xae\x3a\x3c\x52\x80\x2c\x17\x4f\xa9\xba\xf8\x94\x75\x75\x32\x83\x56\x09\xd2\x3e\x4e\xc4\x14\xd6\x48\x2c\xa1\xd7\x8e\x3c\x3b\x25\x98\x02\x58\x69\xdb\x73\xcc\xd3\x84\x1d\x68\xa1\x92\x33\x90\xca\x79\x1e\x50\xc0\x67\x4b\xdf\x4d\x4c\x1a\xc9\x25\x44\x8f\x92\xa1\x29\x6f\x8a\x74\xf1\xe2\x46\x4f\x04\xbd\xa8\x63\x00\x0c\x26\x26\x2d\x16\xc3\xe4\x31\xb9\x58\xd2\x68\x7a\x22\x8d\x33\x50\xab\x8e\x74\x98\x29\x5f\xc2\x97\xd5\x1d\x2c\x97\xeb\xf8\xfb\x3a\xf2\x3b\x42\x5d\xc2\x97\xc5\x62\x7b\x07\x97\xdf\xd7\xc4\xf4\x6c\x7b\x1e\xc2\x0b\x96\xa3\xee\xd1\xcd\x81\x97\xc0\x18\x94\x24\xe4\xde\x51\x84\xe2\x6b\x61\x0d\xf8\xc9\xb3\xe4\x95\xc8\x46\x4c\x8b\x55\x4c\xd8\x4d\x60\x63\xba\x5e\x0b\x6e\xb4\xec\x1f\x7b\xd7\x96\xb1\x4d\xca\x3c\xf7\xab\x0c\x3b\xfc\xc7\x68\x3c\xf9\xd8\x2b\x9e\x8d\xa3\x2c\x8e\x4b\x66\xdc\x31\xf7\x67\xed\x89\xfd\xb5\x3d\x46\x42\xe8\x92\x8c\x9f\xf9\xa5\x66\xd1\x90\x78\xf2\x7d\x57\xc2\x6e\xb3\xa8\x2a\x9a\x17\xeb\xfd\x5e\xc8\xcd\x4a\x62\x21\x77\xb2\xda\x2e\xb1\x2e\x16\xb4\x9f\x17\x49\x2c\x76\xa8\xc3\x65\xa9\xf8\x71\x2d\x1d\x1d\xda\x06\x50\xd7\x70\x22\x75\x6c\xd8\x83\x23\x6f\x7a\x27\x28\x84\x11\xb9\x8f\x16\xb9\x79\x7f\x08\x51\xaf\x1f\x27\xe6\xda\xd9\x95\x0e\x31\xe4\x35\xd9\xd6\x9c\x33\xeb\x0c\x9b\x21\x9e\xd1\xec\x8f\x34\x72\x1d\xf1\x90\xb5\x08\xb9\xf4\xba\xf2\x8f\xe8\x44\xa3\xbe\x85\xd6\xc0\xd6\x13\xcc\x40\x49\xf0\xc4\x77\xa1\xd2\x3a\x96\xbb\x42\x4f\xa1\x6a\xa0\x3c\x20\x84\x03\x1b\x40\x0d\xa3\xe4\xed\xfa\xbe\xd9\xe3\x41\x72\x4a\xd7\x6d\x46\x07\x42\x54\x5f\x93\x36\x4c\xe1\xfc\x86\x16\xa9\x5a\x8a\xcf\x9d\xbf\x34\xe8\xf7\x05\x3a\x29\x6e\xd4\xe0\xea\xe4\xd2\x60\x7a\xea\x88\xd5\x7e\x57\x6c\xe4\x76\x57\xcf\xe5\x7e\x8d\x58\xaf\x56\xa2\xa8\xc4\x0e\xb7\xdb\xbd\x94\xab\xf5\xea\x26\xf3\x93\x50\x51\xc9\x62\xb3\xd8\x15\x82\x68\xbe\xab\xd6\xdb\x0a\x0b\xdc\x16\x5b\xb9\xc1\x8d\xd8\x09\x5a\x24\xc8\xec\x54\xd5\xf3\xb0\xa6\xe9\x99\x1d\x82\x26\x8e\xcf\xeb\xc4\x4b\x00\x9e\x94\xae\x4b\xb8\x3f\x1c\xc6\xcc\x84\x7b\x88\x48\x53\xef\xb0\xbd\xca\xfc\x74\x7f\x38\xdc\xc1\xe7\xf0\xcb\xb2\xec\xe7\x30\xb6\xe1\x65\x56\xfa\xf8\x58\x23\xa3\x27\x2e\xe1\x63\x18\x85\x03\x71\x58\x4b\x03\xed\xfa\xc2\xc6\x55\x36\x0a\x24\x00\x1d\x6a\x25\xc9\xf3\x23\xf6\xdc\x18\x57\x02\x56\x75\xdf\xd6\x49\xa3\xea\x9a\xc2\x2c\xbb\x9e\x92\x7f\x03\x00\x00\xff\xff\xf1\x2c\x8f\xa9\x84\x08\x00\x00"
-
-func inceptionbn21kYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_inceptionbn21kYml,
-		"InceptionBN-21K.yml",
-	)
-}
-
-func inceptionbn21kYml() (*asset, error) {
-	bytes, err := inceptionbn21kYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "InceptionBN-21K.yml", size: 2180, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ninYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5b\xab\xe3\x36\x10\x7e\xf7\xaf\x18\x08\x85\x16\x4e\x7c\x4f\x8e\xe3\x87\x42\xd9\xa7\x85\x92\x87\xdd\xc3\xbe\x2c\x4b\x18\x4b\xe3\x58\x5d\x5b\x32\xd2\xf8\x5c\xfa\xeb\x8b\x64\x27\x4e\xd8\xd3\x2e\xcd\x43\x90\x67\xbe\xb9\x7e\x9a\x91\xc6\x81\x6a\x38\x12\xbf\x18\xfb\x1d\x94\xbe\x1e\x37\xe0\x55\x60\x5a\x78\x33\x93\x85\xc1\x48\xea\xa3\xd6\xe2\x40\x5e\x5d\x47\x00\xb3\xe9\x13\x69\x67\xec\xa7\x27\xd8\xc0\x55\x0b\xad\xb1\xc0\x1d\x2d\x56\x00\xcf\x64\x9d\x32\xba\x86\x7d\x9c\xc6\xd9\x1d\x74\x51\x81\x30\x9a\x2d\x2a\xcd\xd1\x15\x9c\xc5\x29\x6c\xae\x00\xa5\x5b\x63\x07\xe4\xf9\x0c\x8e\x06\xd4\xac\xc4\x55\x3f\x6b\x23\xef\x07\x95\x26\x5b\xc3\x06\xae\x1f\x0e\x26\x47\x12\xd8\xc0\x48\xd6\x23\xe7\xd4\x60\xb4\x24\x95\xf0\x3e\x23\x58\x7f\x1b\x18\xa6\x9e\xd5\xd8\x13\x8c\x3d\xb2\xc7\x3b\x10\xa8\xa1\x21\x70\x23\x09\xd5\x2a\x92\x11\x00\x0e\x72\x5f\xd6\xc1\xf2\x3c\x4e\x35\x58\x54\xa3\x35\x7f\x91\xe0\x44\xa0\x1d\xfa\xed\xf0\xaa\x89\xeb\x00\xdb\x8a\x71\x0a\x48\xf1\x53\xe4\x39\x20\xc7\x51\xec\xcb\x9e\xea\x9f\x1a\x2d\xc0\xc5\xec\xbf\x53\xb9\xc5\x4a\x72\xc2\xaa\x91\x43\xaf\x7f\x8f\x00\x9e\x3a\xe5\x96\xbe\x28\x07\xe8\x9b\x13\x28\x21\xb9\x48\x8d\x86\x8f\x7f\x7e\xfe\xf2\xe9\x43\x9e\x66\xf9\xd7\xec\x1b\x48\x64\x74\xc4\x71\x04\xf0\x91\x83\x51\xd3\x93\xef\x32\x8a\x4e\xd1\x33\xc1\xae\x8a\xab\x5f\xe0\xc9\x8c\xdb\x0c\xfe\x10\x62\xb2\x28\xde\x00\xb5\x84\x2a\x8b\x8b\x59\xb1\x03\xbc\x28\xee\xfc\x6f\xbf\x60\xaf\xe4\xcc\xf7\x67\x1f\xc3\x52\x4b\x96\xb4\x20\xe7\xa9\x5d\xbf\x02\xab\x38\x7a\x92\x13\x78\xa1\xc6\x29\x26\x7f\x24\x16\x71\x0c\x73\x95\x8d\xd2\xe7\xbb\x1b\xb9\x85\x8e\x79\x74\x75\x92\x9c\x95\xe3\xf8\xac\xb8\x9b\x9a\x58\x98\x21\x19\xf0\x99\x74\xaf\x74\x22\xab\x34\xc7\x5d\x55\x1e\x24\x15\x87\x3c\xdf\x35\x42\xec\xef\x2c\xd1\xbe\xaa\xe7\xd8\xd8\x73\x82\x8d\x4b\xb2\x22\xcb\xe3\xb2\x4c\xd3\x68\x03\xbd\x12\xa4\x5d\x98\x9e\x35\xe8\x22\xac\x41\x1b\xbd\x15\x66\x18\xc8\x0a\x85\x7d\xb4\x01\xa5\xc7\x89\x43\x1d\x2b\x7a\x96\x79\xf6\x37\xd0\x2a\xeb\x78\x46\x01\xbf\x8d\xf4\xc3\x7c\x6d\x83\xb8\x06\x35\xe0\x99\xa2\xf9\x0a\xdf\xd0\x7b\xc9\xe3\xc6\x4f\x00\xdd\xdd\x00\x0f\x98\x43\xac\x5e\x46\xf4\x73\xca\x64\x43\xcb\x43\xe8\x55\xb4\x4c\x8c\x54\x03\x69\x3f\x81\xae\x86\xaf\xc5\x03\xe4\x79\x19\xfe\xbe\x2d\xfa\x81\x50\xd7\xf0\x35\xcb\x8b\x07\xc8\xb2\xc7\x07\xc8\xd2\xf2\x5b\x64\x26\x1e\x27\x9e\xcb\xf3\x91\x83\xef\x25\xcd\x59\x17\xc1\x52\x54\x4b\xc8\x93\xa5\x00\xc5\xf7\xca\x9a\xf1\x6b\x66\xd1\x3b\x95\x2d\x98\x1e\x9b\xd0\xb0\x9b\xc2\x96\x76\xbd\x57\xdc\x12\xd9\x9d\x26\xdb\xd7\x81\xf6\x3a\x49\x5c\x11\xe3\x80\x7f\x1b\x8d\x2f\x2e\x5c\x18\xc7\xc6\x52\x1c\xa6\x2c\x5c\x06\xf7\xa6\x1d\xb1\x4b\x42\x1b\x35\xf1\x22\x88\xf9\x95\xef\xbd\x8a\x8e\xc4\x77\x37\x0d\x35\x94\x32\x2f\xca\x66\x57\x15\x05\x0a\x2c\xcb\x43\x5e\xa5\xfb\x1d\x66\x55\x2a\x9b\x22\xcd\xf6\x18\x05\xa2\x3d\x07\x97\xf5\x73\x19\xd4\xb3\xc5\xb1\x0b\x13\xf5\x42\xea\xdc\xb1\x03\x4b\xce\x4c\x56\x90\x2f\xa1\x41\x47\xff\x2f\xf9\xe0\xd5\x25\x02\xdb\x96\x12\xad\xfc\x5a\x0c\x21\x4e\x23\x72\x57\x83\xa4\xb1\x37\x6f\xf1\x68\x0d\x9b\xb9\x9e\x25\xec\xa2\xd7\x4a\x9f\x2e\x75\xc7\xc1\xc9\xe5\x8a\x2a\x77\x42\x2b\x3a\xf5\xec\x19\xc5\xde\x11\x6c\x40\xb5\xe0\x88\x1f\x3c\x41\x3a\xb0\x74\xc9\x77\xde\x3f\xfe\xe0\x77\x89\x86\xc5\xf2\x76\x47\xdf\x2c\x6b\x6f\xb9\x26\x79\xdb\x8c\x59\x10\xdc\x4b\xd2\x86\xc9\x9f\xff\xc5\x4b\xab\x7a\x0a\x6f\x9a\xbb\xdc\xab\x1f\x7b\xfb\xa2\xb8\x53\x73\xaa\x6b\x4a\x73\xe8\x95\xcc\x4a\x3c\x96\x87\x74\xb7\xa7\x4c\x14\x65\x95\x51\x96\x55\xf2\x31\x3b\x60\x51\x94\x25\xed\x0e\xe9\x4d\xd3\x56\xa3\x66\xb7\xaf\x0e\xbb\x4a\xa4\x52\xb4\x99\x3c\x3c\x8a\x46\xb4\x6d\x29\xf2\xbc\x49\x73\xcc\x1b\x8a\x90\xd9\xaa\x66\xe2\x79\xfb\xd1\x2b\x5b\x04\xbd\xbc\xd5\xab\x2e\x02\xf8\xae\xb4\xac\xe1\xc3\xf1\xb8\x74\xc6\x7f\xfb\x8a\x34\x4d\x16\xfb\xab\xcd\xaf\x1f\x8e\xc7\x07\xf8\xe4\xff\xe2\x38\xfe\xcd\x4f\x9b\xdf\xf5\x4a\x9f\x4f\xcb\x56\xaf\xe1\xa3\x67\xf2\x48\xec\xb7\xc9\x2c\xbb\x3e\xa3\x61\x03\x2d\x06\x11\xc0\x80\x5a\xb5\xe4\xf8\x84\x13\x77\xc6\xd6\x80\x8d\x9c\x7a\x19\x75\x4a\x4a\xf2\x23\x68\x27\x8a\xfe\x09\x00\x00\xff\xff\x43\xda\xbe\x18\x6c\x08\x00\x00"
-
-func ninYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_ninYml,
-		"NIN.yml",
-	)
-}
-
-func ninYml() (*asset, error) {
-	bytes, err := ninYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "NIN.yml", size: 2156, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnext10132x4dYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcd\x8e\xe4\xb8\x0d\xbe\xfb\x29\x88\xae\xcb\x2e\x50\x65\xd7\x7f\x77\xfb\x10\x20\x99\xec\x21\x40\xb6\xb3\x68\x0c\x82\x00\x8b\xa0\x40\x4b\xb4\xad\xb4\x2c\x19\x22\x3d\xd5\x35\xa7\x7d\x90\xe4\xe5\xf6\x49\x02\xc9\xae\x9f\xde\xe9\x41\x26\xc0\xd6\xa1\x60\x53\x1f\x29\xfe\x7c\x24\xed\xb0\xa3\x12\x9e\x89\x9f\xe8\x1f\xb2\x5a\xae\x16\x9b\xf5\xeb\x56\xc3\x0c\xe2\x01\xf8\x1a\x4e\x7e\x08\xd0\x79\x4d\x36\xab\x03\x76\x74\xf4\xe1\xa5\xcc\x00\x46\xc5\x8f\xe4\xd8\x87\xe7\x8f\x30\x83\xcb\x29\xd4\x3e\x80\xb4\x34\x69\x01\x7c\xa2\xc0\xc6\xbb\x12\xf6\xf9\x32\x5f\xbd\x81\x4e\x47\xa0\xbc\x93\x80\xc6\x49\x76\x01\xaf\xf2\x25\xcc\x2e\x00\xe3\x6a\x1f\x3a\x94\xf1\x19\x98\x3a\x74\x62\xd4\xe5\x7c\x3c\xcd\xa2\x1d\x34\x8e\x42\x09\x33\xb8\xbc\x30\x0c\x4c\x1a\xc4\x43\x4f\x21\x22\x47\xd7\xa0\x0f\xa4\x8d\x8a\x36\x33\xb8\xfe\x66\xd0\x0d\x56\x4c\x6f\x09\x7a\x8b\x12\xf1\x0c\x0a\x1d\x54\x04\xdc\x93\x32\xb5\x21\x9d\x01\x60\xa7\xf7\xdb\x32\x69\x36\xfd\x50\x42\x40\xd3\x07\xff\x2f\x52\x52\x28\x0c\x9d\x5d\x48\x4a\x4e\x90\x32\x21\x17\xaa\x1f\x12\x58\x7d\x0b\xb8\x49\xe0\xbe\x57\xfb\xad\xa5\xf2\x5b\xf4\x26\xec\xa4\xf9\x3f\x7d\xba\x85\x6b\x62\x15\x4c\x2f\x29\xef\x7f\xc8\xe0\xcc\x07\x30\x0c\x08\x6c\xba\xde\xd2\x1c\x5a\xd3\xb4\xf6\x14\x53\x37\x58\x0c\xe6\x33\x69\x70\x24\xa9\x8c\x18\x54\x6b\x84\x94\x0c\x81\x52\xf9\x4d\x87\x0d\x81\xb2\xc8\x6c\x6a\xa3\x52\xdd\x72\xf8\xdb\x10\x2e\x2a\x86\x63\x7d\x58\xc2\xa0\x84\x34\x54\x27\x08\xd4\x13\x8a\x71\x4d\xcc\x2d\x54\x83\xb1\xda\xb8\x06\x2a\xeb\xd5\x0b\x48\x8b\x02\xd8\x34\x81\x1a\x14\x4a\x6e\x91\x44\x7e\x4a\x40\xc7\x17\x6e\x30\x1c\x8d\xb4\x89\x7d\x1c\x09\x2c\xbe\xf7\xd6\x37\xa7\xf1\xee\x31\x12\xd0\xc4\xa6\x71\x10\x88\x07\x2b\x1c\xe9\x84\xd0\xfa\xce\x37\xe4\xc8\x0f\x3c\xcf\x60\x64\xc0\xa2\x0a\xe8\x54\xfb\x36\xba\xe4\x48\x8b\x0c\xde\xd9\x13\x20\xd4\x74\x84\xf6\xd4\x53\x58\xf4\x18\x69\x2d\x91\x6e\xe2\xa3\x7b\x39\x7c\x6c\x0d\x03\x4b\x40\xa1\xe6\x04\xf4\xda\x7b\x4e\xbe\x3b\x3a\x82\x36\x1d\xb9\xc8\xdd\x39\x1c\x5b\xa3\x5a\x38\x12\x28\xb4\xb1\x61\x7e\xfd\xe5\xdf\x0a\x83\x36\x0e\xad\x91\xd3\xaf\xbf\xfc\x07\xbe\x4b\x11\x99\xcf\xa9\x25\xd3\xf3\xbb\xd1\x7f\x3f\x07\x64\x40\x07\xc4\x4c\x4e\x0c\x5a\xa8\x51\x49\x2c\x88\x03\xd4\xda\xa4\xfe\x11\x9f\x4c\x5c\x1c\xe0\x68\x49\x53\x2f\x2d\xa0\xd3\x70\x34\x5a\xda\x3c\x0b\x54\x53\x20\xa7\x88\x63\x2f\x5d\xdf\x52\x1b\x61\x1f\xc3\x2c\xe0\x48\x15\x9b\x58\x8f\x02\x48\x54\x9e\xc3\x48\xa5\x2a\x16\xee\x76\x04\x2c\xa0\x15\xe9\xb9\x2c\x8a\xc6\x48\x3b\x54\xb9\xf2\x5d\xc1\x9e\xf0\x13\x85\x42\x61\x5d\xd3\x22\x41\x0b\x09\x44\x45\x87\x2c\x51\x6e\xf9\x6b\xaa\x35\x2a\xaa\xbc\x7f\x09\xc4\x14\xcb\x53\x4c\x8c\xfd\x7f\xf1\x45\x65\x7d\x75\xbe\x4f\xa3\x20\x93\x70\xf1\xfc\xc3\x1f\xff\xfc\xe3\x0f\x79\xa7\xb3\x19\x58\xa3\xc8\xf1\x25\xef\x63\x40\x93\xb0\x84\xc1\x05\x62\x09\x26\x72\x38\x9b\x81\x71\xfd\x20\x7c\x4e\xf0\x88\x1d\x65\xb1\x85\x67\x50\x9b\xc0\x32\xa2\x40\x4e\x3d\x7d\x31\x2a\x17\x49\x5c\x8e\xfd\x93\x8d\xd3\xe8\xa6\x3b\xcf\x5e\xdc\xd8\x49\xa0\x37\x0d\x1c\x01\xe3\x15\x57\x2b\x57\x6e\xc6\x62\xa6\xab\xaf\xa2\x69\xf8\x5d\xf9\x50\xc2\xcf\x9b\x39\xac\xd7\xdb\xf4\xf7\xcf\xe9\xbc\x23\x74\x25\xfc\xbc\x5a\x6f\xf2\xfd\xfd\x6e\x0e\xab\xd5\x3e\x5f\x3f\xcc\x61\xb5\xdc\xe4\xbb\xf5\x19\xc5\x0a\x2d\x95\xb0\xde\xed\x33\x3f\x48\x3f\xc8\x18\x79\x74\x2a\x5d\x3b\x45\x30\x9e\x65\x30\xc5\x5b\x13\xc6\xee\x4a\x50\x7c\x2f\xe2\x11\x7f\x75\x3a\x7b\x27\xe8\x09\x63\xb1\x4a\xb9\xbc\x89\x79\xca\xe4\x7b\x71\x4f\x37\xf3\x61\x08\xb6\x4c\xd4\x29\x8b\x82\x37\x39\x76\xf8\xd9\x3b\x3c\xf2\x48\x55\xf1\x81\xf2\x34\x42\x73\x1f\x9a\x82\x4f\x2e\x31\x25\x65\xd8\x91\x4c\x82\x5c\x5e\xe5\xad\x55\xd5\x92\x7a\xe1\xa1\x2b\x61\xab\xd7\x9b\x6d\xb5\x7b\xd8\x6c\x50\xe1\x76\xfb\xb8\x7e\x58\xee\x77\xb8\x7a\x58\xea\x6a\xb3\x5c\xed\x31\x4b\x1c\x88\xe5\x39\x2f\x19\x9e\xd6\x54\x13\xb0\x9f\x5a\x93\x4c\xd3\x0a\xc7\xd1\xe5\x87\xa0\x28\x86\x90\x4e\x0f\x3d\x4a\xfb\xed\xee\x27\xbb\x3c\x36\x5e\x11\x88\x1d\xbd\x5e\x97\x7f\xa1\xa9\xb7\xfe\x74\xf8\xad\xfc\xe0\xfc\x41\x91\xb1\x87\xa8\x9d\xf7\xc1\x8b\x1f\xc3\x9d\xbc\xfa\x5d\x7d\xf8\xad\x20\x4f\xb8\x73\x9b\x18\x3e\xa4\xa1\xfc\x29\x52\x07\x2d\x13\xcc\xc0\xd4\x71\x28\xce\x23\x13\x5c\xa2\x43\x85\x4c\xb1\xaa\xe3\x16\x8b\x0f\xe2\xe3\x6c\x9c\x34\x6f\x57\xfe\xcd\xee\x8f\x9a\xd7\x94\xde\x66\x7d\x14\x24\xf3\x9a\x9c\x97\xb8\x0b\xbe\x66\xa5\x36\x96\xd2\x27\x12\x9f\x09\xfc\x65\x11\xe3\xa6\x32\xa3\xab\x57\x97\xc6\xab\xaf\xac\x21\xb5\x54\x0f\xb4\xbb\x7f\x58\x6e\x97\xaa\xaa\xef\xf7\xcb\xdd\x5e\x53\xb5\x5d\x55\xb8\xc4\xfa\x5e\xdf\xa4\xff\xaa\xb4\xa3\x1d\xa9\xed\x63\xb5\x5c\xea\xc7\x7a\xb5\xd7\xab\x2d\xaa\xfd\x6e\xb7\xd3\xea\xf1\x71\x5d\xab\x3a\x43\x91\x60\xaa\x41\xc6\xd9\x4e\xaf\x12\xf0\xba\xcb\x2f\x67\x19\xc0\x8b\x71\xba\x84\x0f\x4f\x4f\x53\x66\xe2\x7b\x8c\xc8\xd1\x10\xd0\x5e\x74\xbe\xfb\xf0\xf4\x34\x87\xe7\xf8\x97\xe7\xf9\xf7\xb1\xad\xe3\xd7\x9c\x71\xcd\x61\x1a\xaa\x25\xfc\x25\xb6\xca\x13\x49\x9c\x68\xa3\xec\xf2\x55\x96\xa6\xe0\xa4\x10\x97\x2f\x3a\x53\x13\xcb\x01\x07\x69\x7d\x28\x01\x2b\x3d\xd8\x38\x5f\x5b\xa3\x35\xc5\x6e\x0f\x43\x2c\xf9\x8f\xc4\x1c\xbf\x33\x52\x5f\xdf\x9d\x3f\x6d\xf2\x9f\xbc\xb7\xc6\x35\x3f\x9d\x1b\xfd\x2e\xed\x6b\xe7\xa1\x36\x64\x75\x2a\x8b\x86\xbb\x0b\x95\xef\xa2\xe1\x2f\x57\xc6\x9f\xfe\xfe\xd7\x0f\x13\x3b\xfb\xc1\xda\x62\xb3\xdc\xdd\x17\xb1\xae\x9c\xfd\x37\x00\x00\xff\xff\x61\xd5\xdf\xe5\x35\x0b\x00\x00"
-
-func resnext10132x4dYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnext10132x4dYml,
-		"ResNeXt101-32x4d.yml",
-	)
-}
-
-func resnext10132x4dYml() (*asset, error) {
-	bytes, err := resnext10132x4dYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNeXt101-32x4d.yml", size: 2869, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnext2632x4dPrivYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcd\x6e\xdc\x48\x0e\xbe\xeb\x29\x08\xf7\x25\x01\xba\xa5\xfe\x73\xdb\xd6\x61\x0f\x9b\xd3\x02\xbb\xde\x20\x08\x16\x0b\x04\x8b\x06\x55\x45\x49\xb5\x2e\x55\x09\x45\xca\xed\xce\x29\x0f\x32\xf3\x72\x79\x92\x41\x95\xd4\x3f\x46\x3c\x48\x0e\xd3\x87\x86\xc4\xfa\xc8\xe2\xcf\x47\x52\x0e\x3b\x2a\xe1\x13\xf1\x23\xfd\x57\xd6\xbb\xc5\x66\xfd\xb2\xd5\x30\x83\x28\x07\x5f\xc3\xd1\x0f\x01\x3a\xaf\xc9\x66\x75\xc0\x8e\x0e\x3e\x3c\x95\x19\xc0\xa8\xf7\x99\x1c\xfb\xf0\xe9\x33\xcc\xe0\x7c\x0a\xb5\x0f\x20\x2d\x4d\x5a\x00\xcf\x14\xd8\x78\x57\xc2\x2e\x5f\xe6\xab\x57\xd0\xe9\x08\x94\x77\x12\xd0\x38\xc9\xce\xe0\x55\xbe\x84\xd9\x19\x60\x5c\xed\x43\x87\x32\x3e\x03\x53\x87\x4e\x8c\x3a\x9f\x8f\xa7\x59\xb4\x83\xc6\x51\x28\x61\x06\xe7\x17\x86\x81\x49\x83\x78\xe8\x29\x44\xe4\xe8\x1a\xf4\x81\xb4\x51\xd1\x66\x06\x97\xdf\x0c\xba\xc1\x8a\xe9\x2d\x41\x6f\x51\x22\x9e\x41\xa1\x83\x8a\x80\x7b\x52\xa6\x36\xa4\x33\x00\xec\xf4\x6e\x5b\x26\xcd\xa6\x1f\x4a\x08\x68\xfa\xe0\xff\x4f\x4a\x0a\x85\xa1\xb3\x0b\x49\xc9\x09\x52\x26\xe4\x42\xf5\x43\x02\xab\x5f\x01\x37\x09\xdc\xf7\x6a\xb7\xb5\x54\xfe\x8a\xde\x84\x9d\x34\x7f\xea\xd3\x35\x5c\x13\xab\x60\x7a\x49\x79\xff\x5b\x06\x27\x3a\x80\x61\x40\x60\xd3\xf5\x96\xe6\xd0\x9a\xa6\xb5\xc7\x98\xba\xc1\x62\x30\x5f\x49\x83\x23\x49\x65\xc4\xa0\x5a\x23\xa4\x64\x08\x94\xca\x6f\x3a\x6c\x08\x94\x45\x66\x53\x1b\x95\xea\x96\xc3\xbf\x87\x70\x56\x31\x1c\xeb\xc3\x12\x06\x25\xa4\xa1\x3a\x42\xa0\x9e\x50\x8c\x6b\x62\x6e\xa1\x1a\x8c\xd5\xc6\x35\x50\x59\xaf\x9e\x40\x5a\x14\xc0\xa6\x09\xd4\xa0\x50\x72\x8b\x24\xf2\x53\x02\x3a\x3e\x73\x83\xe1\x60\xa4\x4d\xec\xe3\x48\x60\xf1\xbd\xb7\xbe\x39\x8e\x77\x8f\x91\x80\x26\x36\x8d\x83\x40\x3c\x58\xe1\x48\x27\x84\xd6\x77\xbe\x21\x47\x7e\xe0\x79\x06\x23\x03\x16\x55\x40\xa7\xda\xd7\xd1\x25\x47\x5a\x64\xf0\xce\x1e\x01\xa1\xa6\x03\xb4\xc7\x9e\xc2\xa2\xc7\x48\x6b\x89\x74\x13\x1f\xdd\xcb\xe1\x73\x6b\x18\x58\x02\x0a\x35\x47\xa0\x97\xde\x73\xf2\xdd\xd1\x01\xb4\xe9\xc8\x45\xee\xce\xe1\xd0\x1a\xd5\xc2\x81\x40\xa1\x8d\x0d\xf3\xfd\xdb\x6f\x0a\x83\x36\x0e\xad\x91\xe3\xf7\x6f\xbf\xc3\xbb\x14\x91\xf9\x9a\x5a\x32\x3d\xbf\x19\xfd\xfb\x39\x20\x03\x3a\x20\x66\x72\x62\xd0\x42\x8d\x4a\x62\x41\x1c\xa0\xd6\x26\xf5\x8f\xf8\x64\xe2\xec\x00\x47\x4b\x9a\x7a\x69\x01\x9d\x86\x83\xd1\xd2\xe6\x59\xa0\x9a\x02\x39\x45\x1c\x7b\xe9\xf2\x96\xda\x08\xfb\x18\x66\x01\x07\xaa\xd8\xc4\x7a\x14\x40\xa2\xf2\x1c\x46\x2a\x55\xb1\x70\xd7\x23\x60\x01\xad\x48\xcf\x65\x51\x34\x46\xda\xa1\xca\x95\xef\x0a\xf6\x84\xcf\x14\x0a\x85\x75\x4d\x8b\x04\x2d\x24\x10\x15\x1d\xb2\x44\xb9\xe5\x9f\xa9\xf6\x47\xf1\x41\xb5\x8b\xd7\x4c\xcb\x66\x60\x8d\x22\xc7\xe7\x74\x8d\x7e\x4c\xc2\x12\x06\x17\x88\x25\x98\x48\xbd\x6c\x06\xc6\xf5\x83\xf0\x29\x2f\x23\x76\x94\xc5\xce\x9b\x41\x6d\x02\xcb\x88\x02\x39\xf6\xf4\xc3\x84\x5b\x24\x71\x39\xd2\x3e\x1b\x87\xc8\x55\x53\x9d\xbc\xb8\xb2\x93\x40\xaf\xfa\x2e\x02\xc6\x2b\x2e\x56\x2e\x94\x8a\x35\x48\x57\x5f\x44\xd3\xcc\xba\x94\xb1\x84\x2f\x9b\x39\xac\xd7\xdb\xf4\xf7\xbf\xe9\xbc\x23\x74\x25\x7c\x59\xad\x37\xf9\xee\xee\x76\x0e\xab\xd5\x2e\x5f\xdf\xcf\x61\xb5\xdc\xe4\xb7\xeb\x13\x8a\x15\x5a\x2a\x61\x7d\xbb\xcb\xfc\x20\xfd\x20\x63\xe4\xd1\xa9\x74\xed\x14\xc1\x78\x96\xc1\x14\x6f\x4d\x18\x9b\x22\x41\xf1\xad\x88\x47\xfc\xc5\xe9\xec\x8d\xa0\x27\x8c\xc5\x2a\xe5\xf2\x2a\xe6\x29\x93\x6f\xc5\x3d\xdd\xcc\xfb\x21\xd8\x32\x31\xa4\x2c\x0a\xde\xe4\xd8\xe1\x57\xef\xf0\xc0\x23\x4d\xc4\x07\xca\xd3\xe4\xcb\x7d\x68\x0a\x3e\x3a\x26\xe1\x22\x65\xd8\x91\x4c\x82\x5c\x5e\xe4\xb5\x55\xd5\x92\x7a\xe2\xa1\x2b\x61\xab\xd7\x9b\x6d\x75\x7b\xbf\xd9\xa0\xc2\xed\xf6\x61\x7d\xbf\xdc\xdd\xe2\xea\x7e\xa9\xab\xcd\x72\xb5\xc3\x2c\x71\x20\x96\xe7\xb4\x1b\x78\xda\x2e\x4d\xc0\x7e\xea\x28\x32\x4d\x2b\x1c\x27\x8e\x1f\x82\xa2\x18\x42\x3a\xdd\xf7\x28\xed\xaf\xbb\x9f\xec\xf2\xd8\x2f\x45\x20\x76\xf4\x72\x5e\xd9\x8b\x3e\x98\xe7\x42\x53\x6f\xfd\x71\xff\xd6\xd9\xde\xf9\xbd\x22\x63\xf7\xd1\x4a\xde\x07\x2f\x7e\x0c\x7b\xf2\xee\x2f\xf7\xe5\x2d\x61\x9e\xf0\xa7\xb6\x31\xbc\x4f\xb3\xf5\x39\x52\x09\x2d\x13\xcc\xc0\xd4\x71\xb6\xcd\x23\x33\x5c\xa2\x47\x85\x4c\xb1\xca\xe3\x32\x8a\x0f\xe2\xe3\x88\x9b\x34\xaf\x37\xf7\xd5\x0a\x8f\x9a\x97\x14\x5f\x57\x61\x14\x24\xf3\x9a\x9c\x97\x38\xd2\xff\xcc\x4a\x6d\x2c\xa5\x2f\x1d\x3e\x11\xfa\xc7\xa2\xc6\x85\x63\x46\x57\x2f\x2e\x8d\x57\x5f\x58\xa4\x35\x6d\xb1\xde\xd6\x0f\x0f\x9b\x6a\xa9\xab\x9d\x7a\xa8\x76\xba\x26\x75\x5f\xab\x0d\xed\x56\xb7\x57\x65\xb8\x28\xe1\x4a\x2f\xeb\xbb\xdd\x6a\x73\xa7\xb6\xab\x87\x2d\xe2\x76\xf5\x70\xaf\xeb\x0d\xee\xee\xea\xba\x5e\xef\x32\x14\x09\xa6\x1a\x64\x1c\xd1\xf4\x22\x01\x2f\x2b\xf9\x7c\x96\x01\x3c\x19\xa7\x4b\xf8\xf0\xf8\x38\x65\x26\xbe\xc7\x88\x1c\x0d\x01\xed\x59\xe7\xdd\x87\xc7\xc7\x39\x7c\x8a\x7f\x79\x9e\xbf\x8f\x6d\x1e\x3f\xca\x8c\x6b\xf6\x1a\x05\x99\xa4\x84\x7f\xc4\xd6\x79\x24\x89\x13\x6e\x94\x9d\x3f\xae\xd2\x54\x9c\x14\xe2\x0e\x45\x67\x6a\x62\xd9\xe3\x20\xad\x0f\x25\x60\xa5\x07\x1b\xe7\x6d\x6b\xb4\xa6\xd8\xfd\x61\x88\x25\xff\x17\x31\xc7\xcf\x85\xd4\xe7\x37\xa7\x2f\x94\xfc\xa3\xf7\xd6\xb8\xe6\xe3\xa9\xf1\x6f\xd2\xda\x75\x1e\x6a\x43\x56\xa7\xb2\x68\xb8\x39\x53\xfa\x26\x1a\xfe\x71\x53\xfc\xfd\x3f\xff\xfc\x30\xb1\xb4\x1f\xac\x2d\x36\xcb\xdb\xbb\x22\xd6\x95\xb3\x3f\x02\x00\x00\xff\xff\x8e\x44\x85\x0e\xfb\x0a\x00\x00"
-
-func resnext2632x4dPrivYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnext2632x4dPrivYml,
-		"ResNeXt26-32x4d-priv.yml",
-	)
-}
-
-func resnext2632x4dPrivYml() (*asset, error) {
-	bytes, err := resnext2632x4dPrivYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNeXt26-32x4d-priv.yml", size: 2811, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnext5032x4dYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcd\x8e\xe4\xb8\x0d\xbe\xfb\x29\x88\xae\xcb\x2e\x50\x65\xd7\x7f\x77\xfb\x10\x20\x99\xec\x21\x40\xb6\xb3\x68\x0c\x82\x00\x8b\xa0\x40\x4b\xb4\xad\xb4\x2c\x19\x22\x3d\xd5\x35\xa7\x7d\x90\xe4\xe5\xf6\x49\x02\xc9\xae\x9f\xde\xe9\x41\x26\xc0\xd6\xa1\x60\x53\x1f\x29\xfe\x7c\x24\xed\xb0\xa3\x12\x9e\x89\x9f\xe8\x1f\xb2\x5b\x2e\x36\xeb\xd7\xad\x86\x19\x44\x39\xf8\x1a\x4e\x7e\x08\xd0\x79\x4d\x36\xab\x03\x76\x74\xf4\xe1\xa5\xcc\x00\x46\xbd\x8f\xe4\xd8\x87\xe7\x8f\x30\x83\xcb\x29\xd4\x3e\x80\xb4\x34\x69\x01\x7c\xa2\xc0\xc6\xbb\x12\xf6\xf9\x32\x5f\xbd\x81\x4e\x47\xa0\xbc\x93\x80\xc6\x49\x76\x01\xaf\xf2\x25\xcc\x2e\x00\xe3\x6a\x1f\x3a\x94\xf1\x19\x98\x3a\x74\x62\xd4\xe5\x7c\x3c\xcd\xa2\x1d\x34\x8e\x42\x09\x33\xb8\xbc\x30\x0c\x4c\x1a\xc4\x43\x4f\x21\x22\x47\xd7\xa0\x0f\xa4\x8d\x8a\x36\x33\xb8\xfe\x66\xd0\x0d\x56\x4c\x6f\x09\x7a\x8b\x12\xf1\x0c\x0a\x1d\x54\x04\xdc\x93\x32\xb5\x21\x9d\x01\x60\xa7\xf7\xdb\x32\x69\x36\xfd\x50\x42\x40\xd3\x07\xff\x2f\x52\x52\x28\x0c\x9d\x5d\x48\x4a\x4e\x90\x32\x21\x17\xaa\x1f\x12\x58\x7d\x0b\xb8\x49\xe0\xbe\x57\xfb\xad\xa5\xf2\x5b\xf4\x26\xec\xa4\xf9\x3f\x7d\xba\x85\x6b\x62\x15\x4c\x2f\x29\xef\x7f\xc8\xe0\x4c\x07\x30\x0c\x08\x6c\xba\xde\xd2\x1c\x5a\xd3\xb4\xf6\x14\x53\x37\x58\x0c\xe6\x33\x69\x70\x24\xa9\x8c\x18\x54\x6b\x84\x94\x0c\x81\x52\xf9\x4d\x87\x0d\x81\xb2\xc8\x6c\x6a\xa3\x52\xdd\x72\xf8\xdb\x10\x2e\x2a\x86\x63\x7d\x58\xc2\xa0\x84\x34\x54\x27\x08\xd4\x13\x8a\x71\x4d\xcc\x2d\x54\x83\xb1\xda\xb8\x06\x2a\xeb\xd5\x0b\x48\x8b\x02\xd8\x34\x81\x1a\x14\x4a\x6e\x91\x44\x7e\x4a\x40\xc7\x17\x6e\x30\x1c\x8d\xb4\x89\x7d\x1c\x09\x2c\xbe\xf7\xd6\x37\xa7\xf1\xee\x31\x12\xd0\xc4\xa6\x71\x10\x88\x07\x2b\x1c\xe9\x84\xd0\xfa\xce\x37\xe4\xc8\x0f\x3c\xcf\x60\x64\xc0\xa2\x0a\xe8\x54\xfb\x36\xba\xe4\x48\x8b\x0c\xde\xd9\x13\x20\xd4\x74\x84\xf6\xd4\x53\x58\xf4\x18\x69\x2d\x91\x6e\xe2\xa3\x7b\x39\x7c\x6c\x0d\x03\x4b\x40\xa1\xe6\x04\xf4\xda\x7b\x4e\xbe\x3b\x3a\x82\x36\x1d\xb9\xc8\xdd\x39\x1c\x5b\xa3\x5a\x38\x12\x28\xb4\xb1\x61\x7e\xfd\xe5\xdf\x0a\x83\x36\x0e\xad\x91\xd3\xaf\xbf\xfc\x07\xbe\x4b\x11\x99\xcf\xa9\x25\xd3\xf3\xbb\xd1\x7f\x3f\x07\x64\x40\x07\xc4\x4c\x4e\x0c\x5a\xa8\x51\x49\x2c\x88\x03\xd4\xda\xa4\xfe\x11\x9f\x4c\x5c\x1c\xe0\x68\x49\x53\x2f\x2d\xa0\xd3\x70\x34\x5a\xda\x3c\x0b\x54\x53\x20\xa7\x88\x63\x2f\x5d\xdf\x52\x1b\x61\x1f\xc3\x2c\xe0\x48\x15\x9b\x58\x8f\x02\x48\x54\x9e\xc3\x48\xa5\x2a\x16\xee\x76\x04\x2c\xa0\x15\xe9\xb9\x2c\x8a\xc6\x48\x3b\x54\xb9\xf2\x5d\xc1\x9e\xf0\x13\x85\x42\x61\x5d\xd3\x22\x41\x0b\x09\x44\x45\x87\x2c\x51\x6e\xf9\x6b\xaa\x35\x2a\xaa\xbc\x7f\x09\xc4\x14\xcb\x53\x4c\x8c\xfd\x7f\xf1\x45\x65\x7d\x75\xbe\x4f\xa3\x20\x93\x70\xf1\xfc\xc3\x1f\xff\xfc\xe3\x0f\x79\xa7\xb3\x19\x58\xa3\xc8\xf1\x25\xef\x63\x40\x93\xb0\x84\xc1\x05\x62\x09\x26\x72\x38\x9b\x81\x71\xfd\x20\x7c\x4e\xf0\x88\x1d\x65\xb1\x85\x67\x50\x9b\xc0\x32\xa2\x40\x4e\x3d\x7d\x31\x2a\x17\x49\x5c\x8e\xfd\x93\x8d\xd3\xe8\xa6\x3b\xcf\x5e\xdc\xd8\x49\xa0\x37\x0d\x1c\x01\xe3\x15\x57\x2b\x57\x6e\xc6\x62\xa6\xab\xaf\xa2\x69\xf8\x5d\xf9\x50\xc2\xcf\x9b\x39\xac\xd7\xdb\xf4\xf7\xcf\xe9\xbc\x23\x74\x25\xfc\xbc\x5a\x6f\xf2\xfd\xfd\x6e\x0e\xab\xd5\x3e\x5f\x3f\xcc\x61\xb5\xdc\xe4\xbb\xf5\x19\xc5\x0a\x2d\x95\xb0\xde\xed\x33\x3f\x48\x3f\xc8\x18\x79\x74\x2a\x5d\x3b\x45\x30\x9e\x65\x30\xc5\x5b\x13\xc6\xee\x4a\x50\x7c\x2f\xe2\x11\x7f\x75\x3a\x7b\x27\xe8\x09\x63\xb1\x4a\xb9\xbc\x89\x79\xca\xe4\x7b\x71\x4f\x37\xf3\x61\x08\xb6\x4c\xd4\x29\x8b\x82\x37\x39\x76\xf8\xd9\x3b\x3c\xf2\x48\x55\xf1\x81\xf2\x34\x42\x73\x1f\x9a\x82\x4f\x2e\x31\x25\x65\xd8\x91\x4c\x82\x5c\x5e\xe5\xad\x55\xd5\x92\x7a\xe1\xa1\x2b\x61\xab\xd7\x9b\x6d\xb5\x7b\xd8\x6c\x50\xe1\x76\xfb\xb8\x7e\x58\xee\x77\xb8\x7a\x58\xea\x6a\xb3\x5c\xed\x31\x4b\x1c\x88\xe5\x39\x2f\x19\x9e\xd6\x54\x13\xb0\x9f\x5a\x93\x4c\xd3\x0a\xc7\xd1\xe5\x87\xa0\x28\x86\x90\x4e\x0f\x3d\x4a\xfb\xed\xee\x27\xbb\x3c\x36\x5e\x11\x88\x1d\xbd\x5e\x76\x7f\xa1\xa9\xb7\xfe\x74\xf8\x8d\xf8\xe0\xfc\x41\x91\xb1\x87\xa8\x9b\xf7\xc1\x8b\x1f\x83\x9d\x7c\xfa\x3d\x3d\xf8\xcd\x7b\x9e\x50\xe7\x16\x31\x7c\x48\x03\xf9\x53\xa4\x0d\x5a\x26\x98\x81\xa9\xe3\x40\x9c\x47\x16\xb8\x44\x85\x0a\x99\x62\x45\xc7\x0d\x16\x1f\xc4\xc7\xb9\x38\x69\xde\xae\xfb\x9b\xbd\x1f\x35\xaf\xe9\xbc\xcd\xf8\x28\x48\xe6\x35\x39\x2f\x71\x0f\x7c\xcd\x4a\x6d\x2c\xa5\xcf\x23\x3e\x93\xf7\xcb\x02\xc6\x2d\x65\x46\x57\xaf\x2e\x8d\x57\x5f\x19\x53\xa9\xfb\xd5\x76\xb3\xab\x97\xdb\x95\xbe\xaf\x1f\xa9\xda\x6d\xd7\x6b\x7a\xd0\x8f\xfb\x6d\xb5\xd2\x7a\x73\x93\xfc\xab\x92\xbe\xaf\xf0\xfe\x7e\xb3\xda\xed\xf5\xfe\x51\xeb\x6a\xf5\xf0\x88\x58\xef\xb7\x9b\xcd\x6e\x5f\x3d\xaa\x5d\x86\x22\xc1\x54\x83\x8c\x73\x9d\x5e\x25\xe0\x75\x8f\x5f\xce\x32\x80\x17\xe3\x74\x09\x1f\x9e\x9e\xa6\xcc\xc4\xf7\x18\x91\xa3\x21\xa0\xbd\xe8\x7c\xf7\xe1\xe9\x69\x0e\xcf\xf1\x2f\xcf\xf3\xef\x63\x4b\xc7\x2f\x39\xe3\x9a\xc3\x34\x50\x4b\xf8\x4b\x6c\x93\x27\x92\x38\xcd\x46\xd9\xe5\x8b\x2c\x4d\xc0\x49\x21\x2e\x5e\x74\xa6\x26\x96\x03\x0e\xd2\xfa\x50\x02\x56\x7a\xb0\x71\xb6\xb6\x46\x6b\x8a\x9d\x1e\x86\x58\xf2\x1f\x89\x39\x7e\x63\xa4\x9e\xbe\x3b\x7f\xd6\xe4\x3f\x79\x6f\x8d\x6b\x7e\x3a\x37\xf9\x5d\xda\xd5\xce\x43\x6d\xc8\xea\x54\x16\x0d\x77\x17\x22\xdf\x45\xc3\x5f\xae\x8b\x3f\xfd\xfd\xaf\x1f\x26\x6e\xf6\x83\xb5\xc5\x66\xb9\xbb\x2f\x62\x5d\x39\xfb\x6f\x00\x00\x00\xff\xff\xcf\x8a\x2a\xf3\x30\x0b\x00\x00"
-
-func resnext5032x4dYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnext5032x4dYml,
-		"ResNeXt50-32x4d.yml",
-	)
-}
-
-func resnext5032x4dYml() (*asset, error) {
-	bytes, err := resnext5032x4dYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNeXt50-32x4d.yml", size: 2864, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet101V2Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdf\x8b\xdc\x36\x10\x7e\xf7\x5f\x31\xb0\x14\x5a\xb8\xf5\xfe\xfe\x71\x7e\x28\xa4\x29\x84\x42\xb9\xc2\x35\xa4\x0f\x21\x1c\x63\x79\xbc\x56\x4f\x96\x84\x66\xbc\x7b\x9b\xbf\xbe\x48\xf6\xae\xf7\x9a\x84\xe6\xa1\xf7\x70\x78\x35\xdf\x8c\x66\xbe\x19\x7d\x92\xc5\x96\x0a\x78\x24\x7e\x20\x59\xcc\x17\x30\x81\xb8\x02\xae\x86\xb3\xeb\x02\xb4\xae\x22\x93\xd5\x01\x5b\x3a\xb9\xf0\x5c\x64\x00\xbd\xc7\x7b\xb2\xec\xc2\xe3\x7b\x98\xc0\xd5\x0a\xb5\x0b\x20\x0d\x0d\x5e\x00\x47\x0a\xac\x9d\x2d\x60\x9b\xcf\xf3\xc5\x2b\xe8\x60\x02\xe5\xac\x04\xd4\x56\xb2\x2b\x78\x99\xcf\x61\x72\x05\x68\x5b\xbb\xd0\xa2\xf4\xdf\xc0\xd4\xa2\x15\xad\xae\xf6\xde\x9a\xc5\x38\xa8\x2d\x85\x02\x26\x70\xfd\xc1\xd0\x31\x55\x20\x0e\x3c\x85\x88\xec\x53\x03\x1f\xa8\xd2\x2a\xc6\xcc\x60\xfc\x9b\x40\xdb\x19\xd1\xde\x10\x78\x83\x12\xf1\x0c\x0a\x2d\x94\x04\xec\x49\xe9\x5a\x53\x95\x01\x60\x5b\x6d\xd7\x45\xf2\x3c\xf8\xae\x80\x80\xda\x07\xf7\x37\x29\x99\x29\x0c\xad\x99\x4a\x22\x27\x48\x91\x90\x53\xe5\xbb\x04\x56\xdf\x03\x3e\x24\xb0\xf7\x6a\xbb\x36\x54\x7c\x8f\xdf\x80\x1d\x3c\xff\x33\xa7\x5b\x78\x45\xac\x82\xf6\x92\x78\xff\x39\x03\x78\x13\x47\x41\x57\x1d\x1a\x30\x84\xc1\x6a\x7b\xb8\x69\x9a\x38\x20\x64\x4a\x3d\x4e\x5d\x8b\x66\x57\x83\x25\x89\x76\x06\x69\x50\x00\x03\x01\x77\x25\x4b\x6c\x14\x1a\x73\x86\x8a\xc8\x53\x1c\x0d\xb4\x20\x8d\x63\xea\xdb\xe2\x03\x1d\xb5\xeb\xd8\x9c\xf3\x0c\xe0\x2f\x02\x7a\xf1\x46\x2b\x2d\xe6\x0c\x81\x22\xfd\x9d\x41\xe9\xb7\x33\x78\x8e\xed\x44\x1e\xf3\x0a\x97\x4c\xeb\xce\xa6\x5e\x32\x9c\xb4\x34\xd1\x95\x02\x59\x45\x31\xdf\xab\x2f\x68\xeb\x3b\xe1\x3b\xd0\x96\x85\xb0\x8a\x79\x5f\x43\x75\xf6\xea\x54\x8d\xe1\x86\xac\x7c\x70\x47\x5d\x11\x28\xd7\xfa\x40\x0d\x59\xd6\x47\x02\x6a\xbd\x0e\x5a\xa1\x01\x8a\xd6\xb8\x1d\x37\xee\x14\xa3\x25\x16\xa4\x21\xa6\x31\xc7\x2b\x45\x91\x1d\x42\xd6\x91\x0f\x07\xce\x8b\x6e\xf5\x67\xba\x03\xb4\x55\x9a\xb5\x03\x6a\x0b\xa8\x54\x17\x50\x9d\xa1\x0e\xae\x8d\xe3\xcc\xba\xa2\x80\xa5\x39\x83\xb6\x2a\xc4\x26\x54\x50\x91\x97\x26\xe6\xf8\x87\x4d\x65\xfe\xd6\xe2\x81\x1e\x48\xa0\x42\x41\x26\x81\x13\x01\x1d\xd1\x74\x91\xc3\xdb\x44\x06\x9e\xb0\x8f\x10\x89\xe8\x7c\x4c\x66\xb1\x59\x0e\x3c\x4f\xa7\xd3\xfd\xcb\xab\xb6\x7d\x78\xf7\xae\x77\x2d\x3b\x01\x16\x6d\x0c\x34\x78\x8c\xd5\x1a\x77\xa2\x90\xc8\x31\xf4\xa2\x25\xf5\xf2\x8d\x05\xb2\x4c\x6d\x69\x92\x94\x7c\xc9\x05\x03\xaa\x46\xd3\x91\x18\x56\xf9\x66\xf7\x03\x50\x08\x2e\x80\xfb\x57\x25\x42\x2c\xc0\x24\x39\xbc\x6f\x34\xc7\x08\x9d\x11\x38\x0d\xb0\x05\x4b\x3c\xa8\x8a\xae\x7e\xbf\xff\xf9\xe1\xf1\x2d\x2c\xe7\x8b\x0d\x28\x83\xcc\xba\xd6\xaa\x97\x0e\x41\x7e\xce\xb3\x6b\x97\x39\xca\xc4\xf8\x2b\x29\x04\xfa\x38\x61\x33\x38\x51\xc9\x5a\x28\x7e\x92\xa8\x3c\x87\xfe\x94\x94\x7d\x6b\x47\x75\x9b\x42\x23\xe2\xb9\x98\xcd\x0e\x5a\x9a\xae\xcc\x95\x6b\x67\xec\x08\x8f\x14\x66\x0a\xeb\x9a\xa6\x09\x3a\x93\x40\x34\x6b\x91\x25\xae\x1b\xfe\x96\xab\x0a\x58\xcb\xbb\x5f\x7e\xbd\x7e\xbc\x02\x62\x78\xd1\xc7\xdc\x85\xc3\x0c\x4b\x9e\x2d\x36\x8b\x65\x3e\x5f\xad\xf6\x9b\x6c\x02\x46\xab\xc8\xf6\xc0\xf4\x90\xdf\xb0\x58\xa4\xd9\x66\x09\x5a\x09\x55\xd9\x64\x38\x06\x97\xa3\xd1\x63\xfb\xb5\x28\x36\x13\xa8\x75\x60\xe9\x51\x20\x67\x4f\x5f\x88\xfa\x34\x2d\x17\xa0\x63\x93\xb2\x5e\x37\x6f\x74\xe4\x92\xc5\x4d\x9c\x04\x7a\x25\x35\x11\xd0\x6f\x31\x46\xf1\x18\x75\x46\x28\xa4\xde\xa4\xad\xc7\xa5\x41\xa6\x2b\xdd\xc6\xe3\xe7\x2c\x17\xf0\x71\x75\x07\xcb\xe5\x3a\xfd\xfb\x34\xd8\x5b\x42\x5b\xc0\xc7\xc5\x72\x99\xef\x76\xcb\x3b\x58\x2c\x36\xf9\xfd\x7a\x77\x07\x8b\xf9\x32\xbf\xdf\x7f\xca\x5c\x27\xbe\x93\xbe\xd2\xa4\x62\x71\x9b\x21\xe3\xde\x96\xc1\x50\x5f\x4d\x28\x5d\xa0\x04\xc5\xaf\x55\xd8\xe3\xc7\x24\xb3\xaf\x14\x39\x60\x0c\x96\x89\xbb\x9b\x1a\x07\xe6\xbe\x56\xe7\xb0\x33\x3f\x75\xc1\x14\x69\x00\x8a\xd9\x8c\x57\x39\xb6\xf8\xd9\x59\x3c\x71\x3f\x69\xe2\x02\xe5\x49\xdc\xd3\x58\xf0\xd9\x32\x09\xcf\x12\xa3\x96\x64\x58\xc8\xe5\x45\x5e\x47\x55\x0d\xa9\x67\xee\xda\x02\xd6\xd5\x72\xb5\x2e\x37\xfb\xd5\x0a\x15\xae\xd7\xf7\xcb\xfd\x7c\xbb\xc1\xc5\x7e\x5e\x95\xab\xf9\x62\x8b\x59\xea\x79\x6c\xc7\xe5\xfa\xe3\xe1\x02\x3d\x04\xf4\x4d\xd2\xac\x13\xe9\x43\x23\xe9\x6c\xba\x2e\x28\x8a\x25\x24\xeb\x93\x47\x69\xbe\x3f\xfd\x14\x97\xfb\x73\x33\x0b\xc4\x36\xbd\x47\xa6\xc7\xe5\xac\x22\x6f\xdc\xf9\xe9\x76\x2d\xf7\xc1\x89\xeb\x2b\x1b\x12\xf8\xdf\xb6\x7b\xb5\x4f\xb2\x5f\x26\x5f\xf3\x13\x06\xd5\xe8\x63\x9c\x0e\x34\x4c\x30\x01\x5d\x47\x7d\xba\x8b\xcd\xee\x35\xa8\x44\xa6\xd8\x38\xd0\x0c\x08\xf1\x43\x1c\xa0\x85\xc1\xf3\xf6\xbd\x71\xf3\xf0\x88\x9e\x23\x6b\xb7\xc4\xf6\x0b\x29\x7c\x45\xd6\xf5\xd7\xe1\x37\xa2\xd4\xda\x50\x7a\x9f\xf1\x65\x46\xbf\xec\x53\x94\x7e\xdd\xa7\x3a\xa6\xd4\x6f\x3d\x0e\xc6\xfd\x9c\x56\xb8\xdd\x57\x78\x5f\xaf\x48\xe1\x7c\xb5\xdc\xcd\xd7\xfb\x1d\x96\x6a\x41\x6a\x5d\x55\x37\xb4\x8f\x4e\xbb\xfd\xbc\xa6\xb2\xac\x37\x6a\xbd\xa3\xf5\x7c\xb3\xde\x56\xf3\x6a\xb7\xc1\xdd\x46\xa9\xfd\x76\x73\x9f\xa1\x48\xd0\x65\x27\xbd\xfa\xd2\x8b\x04\xbc\xdc\x8b\x30\xda\x32\x80\x67\x6d\xab\x02\xde\x3e\x3c\x0c\xcc\xc4\xdf\xfd\x3b\xa3\x0b\xe3\x5d\x0a\x3f\xbe\x7d\x78\xb8\x83\xc7\xf8\x2f\xcf\xf3\x9f\xe2\xc9\x1d\x1e\x25\x4f\xc3\x15\x58\x8c\x57\xc9\xe4\x7a\x2d\x5e\x9e\x84\x49\xd8\x06\x87\x0c\xa0\x45\xab\x6b\x62\x79\xc2\x4e\x1a\x17\x0a\xc0\xb2\xea\x4c\x95\xfd\x13\x00\x00\xff\xff\xcd\xe5\x6c\x4f\x22\x0b\x00\x00"
-
-func resnet101V2YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet101V2Yml,
-		"ResNet101-v2.yml",
-	)
-}
-
-func resnet101V2Yml() (*asset, error) {
-	bytes, err := resnet101V2YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet101-v2.yml", size: 2850, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet101Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xe3\x38\x0e\xbd\xfb\x57\x10\x08\x16\xd8\x05\x2a\x4e\x1c\x27\x55\x89\x0f\x0b\x34\xfa\xd0\xbb\x98\x41\x0d\x50\xd3\xe8\x39\x34\x1a\x05\x5a\xa6\x63\x4d\xc9\x92\x20\xd2\x49\xa5\x7f\xfd\x40\xb2\xf3\x51\xd3\x3d\x98\x3e\x4c\x0e\x81\x2d\x3e\x52\xe4\x23\xfd\x24\x8b\x3d\x55\xf0\x44\xfc\x48\x52\x2c\x0b\x98\x41\x5c\x01\xd7\xc2\xc9\x0d\x01\x7a\xd7\x90\xc9\xda\x80\x3d\x1d\x5d\x78\xa9\x32\x80\xd1\xe3\x23\x59\x76\xe1\xe9\x23\xcc\xe0\x62\x85\xd6\x05\x90\x8e\x26\x2f\x80\x03\x05\xd6\xce\x56\x70\x9f\x2f\xf3\xe2\x0d\x74\x32\x81\x72\x56\x02\x6a\x2b\xd9\x05\x5c\xe4\x4b\x98\x5d\x00\xda\xb6\x2e\xf4\x28\xe3\x33\x30\xf5\x68\x45\xab\x8b\x7d\xb4\x66\x31\x0e\x6a\x4b\xa1\x82\x19\x5c\x5e\x18\x06\xa6\x06\xc4\x81\xa7\x10\x91\x63\x6a\xe0\x03\x35\x5a\xc5\x98\x19\x5c\x7f\x33\xe8\x07\x23\xda\x1b\x02\x6f\x50\x22\x9e\x41\xa1\x85\x9a\x80\x3d\x29\xdd\x6a\x6a\x32\x00\xec\x9b\xfb\x75\x95\x3c\xf7\x7e\xa8\x20\xa0\xf6\xc1\xfd\x4e\x4a\x16\x0a\x43\x6f\xe6\x92\xc8\x09\x52\x25\xe4\x5c\xf9\x21\x81\xd5\x8f\x80\xf7\x09\xec\xbd\xba\x5f\x1b\xaa\x7e\xc4\x6f\xc2\x4e\x9e\x7f\x9b\xd3\x2d\xbc\x21\x56\x41\x7b\x49\xbc\xff\x37\x03\x78\x17\x47\x41\x37\x03\x1a\x30\x84\xc1\x6a\xbb\xbf\x69\x9a\x38\x20\x64\x4a\x3d\x4e\x5d\x8b\x66\xd7\x82\x25\x89\x76\x06\xe9\x50\x00\x03\x01\x0f\x35\x4b\x6c\x14\x1a\x73\x82\x86\xc8\x53\x1c\x0d\xb4\x20\x9d\x63\x1a\xdb\xe2\x03\x1d\xb4\x1b\xd8\x9c\xf2\x0c\xe0\x37\x02\x7a\xf5\x46\x2b\x2d\xe6\x04\x81\x22\xfd\x83\x41\x19\xb7\x33\x78\x8a\xed\x44\xbe\xe6\x15\xce\x99\xb6\x83\x4d\xbd\x64\x38\x6a\xe9\xa2\x2b\x05\xb2\x8a\x62\xbe\x17\x5f\xd0\xd6\x0f\xc2\x77\xa0\x2d\x0b\x61\x13\xf3\xbe\x84\x1a\xec\xc5\xa9\xb9\x86\x9b\xb2\xf2\xc1\x1d\x74\x43\xa0\x5c\xef\x03\x75\x64\x59\x1f\x08\xa8\xf7\x3a\x68\x85\x06\x28\x5a\xe3\x76\xdc\xb9\x63\x8c\x96\x58\x90\x8e\x98\xae\x39\x5e\x28\x8a\xec\x10\xb2\x8e\x7c\x38\x70\x5e\x74\xaf\xbf\xd2\x1d\xa0\x6d\xd2\xac\xed\x51\x5b\x40\xa5\x86\x80\xea\x04\x6d\x70\x7d\x1c\x67\xd6\x0d\x05\xac\xcd\x09\xb4\x55\x21\x36\xa1\x81\x86\xbc\x74\x31\xc7\x5f\x6c\x2a\xf3\xff\x3d\xee\xe9\x91\x04\x1a\x14\x64\x12\x38\x12\xd0\x01\xcd\x10\x39\xbc\x4d\x64\xe2\x09\xc7\x08\x91\x88\xc1\xc7\x64\x8a\xcd\x6a\xe2\x79\x3e\x9f\x6f\x5f\xdf\xb4\xed\xd3\x87\x0f\xa3\x6b\x3d\x08\xb0\x68\x63\xa0\xc3\x43\xac\xd6\xb8\x23\x85\x44\x8e\xa1\x57\x2d\xa9\x97\xef\x2c\x90\x65\xea\x6b\x93\xa4\xe4\x5b\x2e\x18\x50\x75\x9a\x0e\xc4\x50\xe6\x9b\x87\x7f\x01\x85\xe0\x02\xb8\x3f\x55\x22\xc4\x02\x4c\x92\xc3\xc7\x4e\x73\x8c\x30\x18\x81\xe3\x04\x2b\x58\xe2\x87\xaa\xe8\xe2\xf7\xf3\xaf\x9f\x9e\xde\xc3\x6a\x59\x6c\x40\x19\x64\xd6\xad\x56\xa3\x74\x08\xf2\x4b\x9e\x5d\xba\xcc\x51\x26\xae\x6f\x49\x21\xd0\xc7\x09\x5b\xc0\x91\x6a\xd6\x42\xf1\x91\x44\xe5\x39\x8c\x5f\x49\x3d\xb6\xf6\xaa\x6e\x73\xe8\x44\x3c\x57\x8b\xc5\x5e\x4b\x37\xd4\xb9\x72\xfd\xe2\x27\xd4\xbd\xb6\xfb\xff\xd1\x22\xb2\x37\x3f\xd7\x3c\x3f\xf7\xff\x8d\x1f\x86\x57\x7d\xc8\x5d\xd8\x2f\xb0\xe6\x45\xb1\x29\x56\xf9\xb2\x2c\xb7\x9b\x6c\x06\x46\xab\xc8\xe0\xc4\xde\xb4\xe7\xb4\x58\xa5\x79\x65\x09\x5a\x09\x35\xd9\x6c\x1a\xed\xf3\xb8\x8f\xd8\x71\x2d\x0a\xc8\x0c\x5a\x1d\x58\x46\x14\xc8\xc9\xd3\x37\x42\x3d\x4f\xcb\x15\xe8\x48\x7c\x36\x6a\xe1\x8d\x36\x9c\xb3\xb8\x89\x93\x40\x6f\xe4\x23\x02\xc6\x2d\xae\x51\x3c\x46\xed\x10\x0a\x89\xef\xb4\xf5\x75\x69\x92\xde\x46\xf7\xf1\x93\x72\x96\x2b\xf8\x5c\xde\xc1\x6a\xb5\x4e\x7f\x5f\x26\x7b\x4f\x68\x2b\xf8\x5c\xac\xca\xfc\x7e\x7b\x07\x45\x71\x9f\x3f\x3c\xec\xee\xa0\x58\x96\xf9\xae\xdc\x7d\xc9\xdc\x20\x7e\x90\xb1\xd2\xa4\x4c\x71\x9b\x29\xe3\xd1\x96\xc1\x54\x5f\x4b\x28\x43\xa0\x04\xc5\xef\x55\x38\xe2\xaf\x49\x66\xdf\x29\x72\xc2\x18\xac\x13\x77\x37\x35\x4e\xcc\x7d\xaf\xce\x69\x67\x7e\x1e\x82\xa9\xd2\x00\x54\x8b\x05\x97\x39\xf6\xf8\xd5\x59\x3c\x72\x9a\x1e\x16\x17\x28\x4f\x82\x9d\xc6\x82\x4f\x96\x49\x78\x91\x18\xb5\x24\xd3\x42\x2e\xaf\xf2\x36\xaa\xea\x48\xbd\xf0\xd0\x57\xb0\x6e\x56\xe5\xba\xde\x6c\xcb\x12\x15\xae\xd7\xbb\xd5\x76\x79\xbf\xc1\x62\xbb\x6c\xea\x72\x59\xdc\x63\x96\x7a\x1e\xdb\x71\x3e\xd2\x78\x3a\x14\xf7\x01\x7d\x97\x74\xe8\x48\x7a\xdf\x49\xfa\xde\xdc\x10\x14\xc5\x12\x92\xf5\xd9\xa3\x74\x3f\x9e\x7e\x8a\xcb\x0b\x85\x6d\x4b\x8b\x40\x6c\xd3\x1d\x63\x31\xde\x36\xe6\xc5\xb2\x98\x37\xe4\x8d\x3b\xe5\x3e\x38\x71\x63\x51\xd3\xde\xff\xf8\x4e\x09\x91\x27\xc0\x79\xea\x35\x3f\x63\x50\x9d\x3e\xc4\xc9\x40\xc3\x04\x33\xd0\x6d\xd4\x9b\xbb\xd8\xe8\x51\x53\x6a\x64\x8a\x4d\x03\xcd\x80\x10\x1f\xc4\x01\x5a\x98\x3c\x6f\xef\x0f\x37\x17\x89\xe8\x79\x65\xec\x96\xd4\x71\x21\x85\x6f\xc8\xba\xf1\x78\xfb\x8b\x28\xad\x36\x94\xee\x5b\x7c\x9e\xcf\x6f\x7b\x14\xa5\x5c\x8f\xa9\x5e\x53\x1a\xb7\xbe\x0e\x45\x49\xaa\x6c\x0a\xdc\x34\xeb\x72\x45\xed\x66\xad\xea\x55\x53\x60\xab\x76\xed\xb6\x5c\x2f\x4b\xbc\xe1\xfd\xc6\xa9\xdd\x2a\xa5\x76\x65\xb9\xda\x35\x8d\x5a\x6d\x97\xf5\xae\xa0\x16\x69\xb9\x6b\x1f\x8a\xdd\x43\x99\xa1\x48\xd0\xf5\x20\xa3\x9a\xd2\xab\x04\x3c\x9f\x73\x70\xb5\x65\x00\x2f\xda\x36\x15\xbc\x7f\x7c\x9c\x98\x89\xef\xe3\xbd\x61\x08\xd7\xb3\x11\xfe\xfd\xfe\xf1\xf1\x0e\x9e\xe2\x5f\x9e\xe7\xff\x89\x5f\xed\x74\xc9\x78\x9e\x8e\xb4\xea\x7a\x34\xcc\x2e\xc7\xdc\xf9\x8a\x97\x44\x6d\x72\xc8\x00\x7a\xb4\xba\x25\x96\x67\x1c\xa4\x73\xa1\x02\xac\x9b\xc1\x34\xd9\x1f\x01\x00\x00\xff\xff\x5a\xdd\x53\xa0\xf2\x0a\x00\x00"
-
-func resnet101YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet101Yml,
-		"ResNet101.yml",
-	)
-}
-
-func resnet101Yml() (*asset, error) {
-	bytes, err := resnet101YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet101.yml", size: 2802, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet152V2Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdf\x8b\xe3\x38\x12\x7e\xf7\x5f\x51\x10\x0e\xee\xa0\xe3\xd8\x4e\x9c\x1f\x7e\x38\xb8\x9b\x85\x61\x61\xe9\x85\xde\x61\xf6\x61\x18\x9a\xb2\x54\x8e\xb5\x2d\x4b\x46\x2a\x27\x9d\xf9\xeb\x17\xc9\x4e\x9c\xde\x99\x61\xe7\x61\xfb\xa1\x71\x54\x5f\x95\xaa\xea\x2b\x7d\x92\xc1\x8e\x2a\x78\x22\xff\x48\x9c\x97\x05\x2c\x20\xac\x80\x6d\xe0\x62\x07\x07\x9d\x95\xa4\x93\xc6\x61\x47\x67\xeb\x5e\xaa\x04\x60\xf4\xf8\x40\xc6\x5b\xf7\xf4\x01\x16\x70\xb3\x42\x63\x1d\x70\x4b\x93\x17\xc0\x89\x9c\x57\xd6\x54\xb0\x4d\xb3\x34\x7f\x03\x9d\x4c\x20\xac\x61\x87\xca\x70\x72\x03\x17\x69\x06\x8b\x1b\x40\x99\xc6\xba\x0e\x79\xfc\x06\x4f\x1d\x1a\x56\xe2\x66\x1f\xad\x49\x88\x83\xca\x90\xab\x60\x01\xb7\x1f\x1e\x06\x4f\x12\xd8\x42\x4f\x2e\x20\xc7\xd4\xa0\x77\x24\x95\x08\x31\x13\x98\xff\x16\xd0\x0d\x9a\x55\xaf\x09\x7a\x8d\x1c\xf0\x1e\x04\x1a\xa8\x09\x7c\x4f\x42\x35\x8a\x64\x02\x80\x9d\xdc\x6e\xaa\xe8\x79\xec\x87\x0a\x1c\xaa\xde\xd9\x3f\x48\xf0\x4a\xa0\xeb\xf4\x92\x63\x73\x1c\x57\x11\xb9\x14\xfd\x10\xc1\xe2\x47\xc0\xc7\x08\xee\x7b\xb1\xdd\x68\xaa\x7e\xc4\x6f\xc2\x4e\x9e\x7f\x9b\xd3\x3d\x5c\x92\x17\x4e\xf5\x1c\xfb\xfe\xdf\x04\xc2\x20\x28\x39\xa0\x06\x4d\xe8\x8c\x32\xc7\x3b\xca\xd8\x02\xa1\xa7\xc8\x70\xe4\x2c\x98\x6d\x03\x86\x38\xd8\x3d\x70\x8b\x0c\xe8\x08\xfc\x50\x7b\x0e\x34\xa1\xd6\x17\x90\x44\x3d\x85\xc1\x40\x03\xdc\x5a\x4f\x23\x29\xbd\xa3\x93\xb2\x83\xd7\x97\x34\x01\xf8\x9d\x80\x5e\x7b\xad\x84\x62\x7d\x01\x47\xa1\xf9\x83\x46\x1e\xb7\xd3\x78\x09\x64\xa2\x9f\xf3\x72\xd7\x4c\x9b\xc1\x44\x26\x3d\x9c\x15\xb7\xc1\x95\x1c\x19\x41\x21\xdf\x9b\x2f\x28\xd3\x0f\xec\x1f\x40\x19\xcf\x84\x32\xe4\x7d\x0b\x35\x98\x9b\x93\x9c\xc3\x4d\x59\xf5\xce\x9e\x94\x24\x10\xb6\xeb\x1d\xb5\x64\xbc\x3a\x11\x50\xd7\x2b\xa7\x04\x6a\xa0\x60\x0d\xdb\xf9\xd6\x9e\x43\xb4\xd8\x05\x6e\xc9\xd3\x9c\xe3\xad\x45\xa1\x3b\x84\x5e\x85\x7e\x58\xb0\x3d\xab\x4e\x7d\xa1\x07\x40\x23\xe3\xa4\x1d\x51\x19\x40\x21\x06\x87\xe2\x02\x8d\xb3\x5d\x18\x66\xaf\x24\x39\xac\xf5\x05\x94\x11\x2e\x90\x20\x41\x52\xcf\x6d\xc8\xf1\x57\x13\xcb\xfc\xb9\xc3\x23\x3d\x12\x83\x44\x46\x4f\x0c\x67\x02\x3a\xa1\x1e\x42\x0f\xef\x13\x99\xfa\x84\x63\x84\xd0\x88\xa1\x0f\xc9\x84\xd3\x3f\xf6\x79\xb9\x5c\xee\x5f\xdf\xd0\xf6\xf1\xfd\xfb\xd1\xb5\x1e\x18\x3c\x2b\xad\xa1\xc5\x53\xa8\x56\xdb\x33\xb9\xd8\x1c\x4d\xaf\x8a\x23\x97\xff\x33\x40\xc6\x53\x57\xeb\x28\x24\x5f\xf7\xc2\x03\x8a\x56\xd1\x89\x3c\xac\xd3\x72\xf7\x2f\x20\xe7\xac\x03\xfb\x97\x4a\x98\x3c\x83\x27\x4e\xe1\x43\xab\x7c\x88\x30\x68\x86\xf3\x04\xcb\x3d\x87\x63\x2a\xe8\xe6\xf7\xcb\x6f\x1f\x9f\xde\x41\x91\xe5\x25\x08\x8d\xde\xab\x46\x89\x51\x38\x18\xfd\x4b\x9a\xdc\x58\xf6\x41\x24\xe6\x5f\x51\x1f\xb0\x0f\x13\xb6\x82\x33\xd5\x5e\x31\x85\x4f\x62\x91\xa6\x30\x9e\x91\x7a\xa4\x76\xd6\xb6\x25\xb4\xcc\xbd\xaf\x56\xab\xa3\xe2\x76\xa8\x53\x61\xbb\x95\xb7\x84\x27\x72\x2b\x81\x4d\x43\xcb\x08\x5d\xb1\x23\x5a\x75\xe8\x39\xac\x6b\xff\x3d\x57\xe1\xb0\xe1\xf7\xff\xff\xe9\xf6\xf1\x06\x88\xee\x55\x9d\x52\xeb\x8e\x2b\xac\xfd\x2a\x2f\xf3\x22\xcd\xd6\xeb\x7d\x99\x2c\x40\x2b\x11\xba\x3d\x75\x7a\xca\x6f\x5a\xac\xe2\x6c\x7b\x76\x4a\x30\xc9\x64\x31\x1d\x83\xeb\xd1\x18\xb1\xe3\x5a\x90\x9a\x05\x34\xca\x79\x1e\x51\xc0\x97\x9e\xbe\x92\xf4\x65\x5c\xae\x40\x05\x92\x92\x51\x35\xef\x54\xe4\x9a\xc5\x5d\x9c\x08\x7a\x23\x34\x01\x30\x6e\x31\x47\xe9\x31\xe8\x0c\x93\x8b\xdc\xc4\xad\xe7\xa5\x49\xa4\xa5\xea\xc2\xf1\xb3\xc6\x57\xf0\x69\xfd\x00\x45\xb1\x89\xff\x3e\x4f\xf6\x8e\xd0\x54\xf0\x29\x2f\x8a\x74\xb7\x2b\x1e\x20\xcf\xcb\xf4\xb0\xd9\x3d\x40\x9e\x15\xe9\x61\xff\x39\xb1\x03\xf7\x03\x8f\x95\x46\x15\x0b\xdb\x4c\x19\x8f\xb6\x04\xa6\xfa\x1a\x42\x1e\x1c\x45\x28\x7e\xab\xc2\x11\x3f\x27\x99\x7c\xa3\xc8\x09\xa3\xb1\x8e\xbd\xbb\xab\x71\xea\xdc\xb7\xea\x9c\x76\xf6\xcf\x83\xd3\x55\x1c\x80\x6a\xb5\xf2\xeb\x14\x3b\xfc\x62\x0d\x9e\xfd\x38\x69\x6c\x1d\xa5\x51\xda\xe3\x58\xf8\x8b\xf1\xc4\x7e\x15\x3b\x6a\x88\xa7\x85\x94\x5f\xf9\x6d\x54\xd1\x92\x78\xf1\x43\x57\xc1\x46\x16\xeb\x4d\x5d\xee\xd7\x6b\x14\xb8\xd9\x1c\x8a\x7d\xb6\x2d\x31\xdf\x67\xb2\x5e\x67\xf9\x16\x93\xc8\x79\xa0\xe3\x7a\xf9\xf9\xe9\xfa\x3c\x3a\xec\xdb\xa8\x59\x67\x52\xc7\x96\xe3\xd9\xb4\x83\x13\x14\x4a\x88\xd6\xe7\x1e\xb9\xfd\xf1\xf4\x63\x5c\x3f\x9e\x9b\x95\x23\x6f\xe2\x6b\x64\x79\x2a\x56\x92\x7a\x6d\x2f\xcf\xf7\x6b\x69\xef\x2c\xdb\xb1\xb2\x29\x81\x7f\x6c\xbb\x37\xfb\x44\xfb\x75\xf2\x95\x7f\x46\x27\x5a\x75\x0a\xd3\x81\xda\x13\x2c\x40\x35\x41\x9f\x1e\x02\xd9\xa3\x06\xd5\xe8\x29\x10\x07\xca\x03\x42\xf8\x60\x0b\x68\x60\xf2\xbc\x7f\x6d\xdc\x3d\x3b\x82\xe7\xdc\xb5\xfb\xc6\x8e\x0b\x31\xbc\x24\x63\xc7\xeb\xf0\x3b\x51\x1a\xa5\x29\xbe\xce\xfc\x75\x46\xbf\xe6\x29\x48\xbf\x1a\x53\x9d\x53\x5a\x4c\x9b\xdf\x8d\x46\xb6\x95\x65\xb6\xdf\x66\x35\x66\xa2\xc8\xf2\xa6\xdc\xaf\xc5\xae\x94\x85\x2c\x8a\x43\xb3\xab\xa3\xd3\x35\xc5\xd9\xad\xc9\xf6\x87\x72\x87\xbb\x4d\xb9\x2b\xb2\x1a\xf3\xa6\x6e\xd6\x87\x4d\xb9\xdd\x4b\x12\xf5\x41\x36\xb7\xe1\x98\x5d\xa8\xa0\x3a\xdb\x6d\x1a\x2a\xb7\x62\xb3\x2f\x37\x28\xf3\xec\xb0\xde\xd3\x7a\x27\x77\xdb\x32\xcb\xee\x28\x9e\x9d\x0e\xfb\x62\xbd\xcd\xb0\xde\x6e\x44\x86\x39\x96\x24\x77\xd4\x60\x23\xf7\x1b\x51\x96\x32\xc3\x04\x99\x9d\xaa\x07\x1e\x95\x9e\x5e\xd9\xe1\xf5\x0e\x86\xd9\x96\x00\xbc\x28\x23\x2b\x78\xf7\xf8\x38\xb1\x10\x7e\x8f\x6f\x9a\xc1\xcd\xf7\x36\xfc\xfb\xdd\xe3\xe3\x03\x3c\x85\x7f\x69\x9a\xfe\x27\xa8\xc4\xf4\x00\x7a\x9e\xae\xdb\x6a\xbe\xb6\x16\xb7\x2b\xf8\xfa\xf8\x8c\x22\x3a\x39\x24\x00\x1d\x1a\xd5\x90\xe7\x67\x1c\xb8\xb5\xae\x02\xac\xe5\xa0\x65\xd2\x2a\x29\x29\x48\x87\x1b\x28\x49\xfe\x0c\x00\x00\xff\xff\x18\x58\xea\x73\x9a\x0b\x00\x00"
-
-func resnet152V2YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet152V2Yml,
-		"ResNet152-v2.yml",
-	)
-}
-
-func resnet152V2Yml() (*asset, error) {
-	bytes, err := resnet152V2YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet152-v2.yml", size: 2970, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet152Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x41\x8f\xe3\xb6\x0e\xbe\xfb\x57\x10\x08\x1e\xf0\x1e\x90\x38\x71\x9c\x64\x12\x1f\x1e\xb0\xd8\xc3\xbe\x87\x16\x53\x60\xba\xd8\x1e\x16\x8b\x01\x2d\xd3\xb1\x3a\xb2\x64\x88\x74\x32\xd9\x5f\x5f\x48\x76\xe2\x4c\x77\x8b\xee\xa1\x73\x18\xc4\xe2\x47\x8a\xfc\x48\x7d\x92\xc5\x96\x0a\x78\x22\x7e\x24\xc9\xb6\x6b\x98\x41\x58\x01\x57\xc3\xc5\xf5\x1e\x5a\x57\x91\x49\x6a\x8f\x2d\x9d\x9d\x7f\x29\x12\x80\xc1\xe3\x23\x59\x76\xfe\xe9\x23\xcc\xe0\x66\x85\xda\x79\x90\x86\x46\x2f\x80\x13\x79\xd6\xce\x16\xb0\x4b\x57\x69\xf6\x06\x3a\x9a\x40\x39\x2b\x1e\xb5\x95\xe4\x06\xce\xd2\x15\xcc\x6e\x00\x6d\x6b\xe7\x5b\x94\xe1\x37\x30\xb5\x68\x45\xab\x9b\x7d\xb0\x26\x21\x0e\x6a\x4b\xbe\x80\x19\xdc\x3e\x18\x7a\xa6\x0a\xc4\x41\x47\x3e\x20\x87\xd4\xa0\xf3\x54\x69\x15\x62\x26\x30\xfd\xcd\xa0\xed\x8d\xe8\xce\x10\x74\x06\x25\xe0\x19\x14\x5a\x28\x09\xb8\x23\xa5\x6b\x4d\x55\x02\x80\x6d\xb5\xdb\x14\xd1\xf3\xd8\xf5\x05\x78\xd4\x9d\x77\xbf\x93\x92\xa5\x42\xdf\x9a\x85\x44\x72\xbc\x14\x11\xb9\x50\x5d\x1f\xc1\xea\x47\xc0\xc7\x08\xee\x3a\xb5\xdb\x18\x2a\x7e\xc4\x6f\xc4\x8e\x9e\x7f\x9b\xd3\x3d\xbc\x22\x56\x5e\x77\x12\x79\xff\x6f\x02\xf0\x2e\x8c\x82\xae\x7a\x34\x60\x08\xbd\xd5\xf6\x78\xd7\x34\x71\x40\xc8\x14\x7b\x1c\xbb\x16\xcc\xae\x06\x4b\x12\xec\x0c\xd2\xa0\x00\x7a\x02\xee\x4b\x96\xd0\x28\x34\xe6\x02\x15\x51\x47\x61\x34\xd0\x82\x34\x8e\x69\x68\x4b\xe7\xe9\xa4\x5d\xcf\xe6\x92\x26\x00\xbf\x11\xd0\x6b\x67\xb4\xd2\x62\x2e\xe0\x29\xd0\xdf\x1b\x94\x61\x3b\x83\x97\xd0\x4e\xe4\x29\x2f\x7f\xcd\xb4\xee\x6d\xec\x25\xc3\x59\x4b\x13\x5c\xc9\x93\x55\x14\xf2\xbd\xf9\x82\xb6\x5d\x2f\x3c\x07\x6d\x59\x08\xab\x90\xf7\x2d\x54\x6f\x6f\x4e\xd5\x14\x6e\xcc\xaa\xf3\xee\xa4\x2b\x02\xe5\xda\xce\x53\x43\x96\xf5\x89\x80\xda\x4e\x7b\xad\xd0\x00\x05\x6b\xd8\x8e\x1b\x77\x0e\xd1\x22\x0b\xd2\x10\xd3\x94\xe3\x8d\xa2\xc0\x0e\x21\xeb\xc0\x87\x03\xd7\x89\x6e\xf5\x57\x9a\x03\xda\x2a\xce\xda\x11\xb5\x05\x54\xaa\xf7\xa8\x2e\x50\x7b\xd7\x86\x71\x66\x5d\x91\xc7\xd2\x5c\x40\x5b\xe5\x43\x13\x2a\xa8\xa8\x93\x26\xe4\xf8\x8b\x8d\x65\xfe\xbf\xc5\x23\x3d\x92\x40\x85\x82\x4c\x02\x67\x02\x3a\xa1\xe9\x03\x87\xf7\x89\x8c\x3c\xe1\x10\x21\x10\xd1\x77\x21\x99\x70\xfe\x07\x9e\x17\x8b\xc5\xfe\xf5\x4d\xdb\x3e\x7d\xf8\x30\xb8\x96\xbd\x00\x8b\x36\x06\x1a\x3c\x85\x6a\x8d\x3b\x93\x8f\xe4\x18\x7a\xd5\x12\x7b\xf9\xce\x02\x59\xa6\xb6\x34\x51\x4a\xbe\xe5\x82\x01\x55\xa3\xe9\x44\x0c\x79\xba\x7d\xf8\x17\x90\xf7\xce\x83\xfb\x53\x25\x42\x2c\xc0\x24\x29\x7c\x6c\x34\x87\x08\xbd\x11\x38\x8f\xb0\x8c\x25\x1c\x54\x45\x37\xbf\x9f\x7f\xfd\xf4\xf4\x1e\xd6\xab\x6c\x0b\xca\x20\xb3\xae\xb5\x1a\xa4\x43\x90\x5f\xd2\xe4\xd6\x65\x0e\x32\x31\x7d\x45\x85\xc0\x2e\x4c\xd8\x12\xce\x54\xb2\x16\x0a\x3f\x49\x54\x9a\xc2\x70\x4a\xca\xa1\xb5\x93\xba\x2d\xa0\x11\xe9\xb8\x58\x2e\x8f\x5a\x9a\xbe\x4c\x95\x6b\x97\x3f\xa1\x6e\xb5\x3d\xfe\x8f\x96\x81\xbd\xc5\xb5\xe6\xc5\xb5\xff\x6f\xfc\xd0\xbf\xea\x53\xea\xfc\x71\x89\x25\x2f\xb3\x6d\xb6\x4e\x57\x79\xbe\xdf\x26\x33\x30\x5a\x05\x06\x47\xf6\xc6\x3d\xc7\xc5\x22\xce\x2b\x8b\xd7\x4a\xa8\x4a\x66\xe3\x68\x5f\xc7\x7d\xc0\x0e\x6b\x41\x40\x66\x50\x6b\xcf\x32\xa0\x40\x2e\x1d\x7d\x23\xd4\x8b\xb8\x5c\x80\x0e\xc4\x27\x83\x16\xde\x69\xc3\x35\x8b\xbb\x38\x11\xf4\x46\x3e\x02\x60\xd8\x62\x8a\xd2\x61\xd0\x0e\x21\x1f\xf9\x8e\x5b\x4f\x4b\xa3\xf4\x56\xba\x0d\x47\xca\x59\x2e\xe0\x73\x3e\x87\xf5\x7a\x13\xff\x7d\x19\xed\x2d\xa1\x2d\xe0\x73\xb6\xce\xd3\xdd\x7e\x0e\x59\xb6\x4b\x1f\x1e\x0e\x73\xc8\x56\x79\x7a\xc8\x0f\x5f\x12\xd7\x4b\xd7\xcb\x50\x69\x54\xa6\xb0\xcd\x98\xf1\x60\x4b\x60\xac\xaf\x26\x94\xde\x53\x84\xe2\xf7\x2a\x1c\xf0\x53\x92\xc9\x77\x8a\x1c\x31\x06\xcb\xc8\xdd\x5d\x8d\x23\x73\xdf\xab\x73\xdc\x99\x9f\x7b\x6f\x8a\x38\x00\xc5\x72\xc9\x79\x8a\x2d\x7e\x75\x16\xcf\x1c\xa7\x87\xc5\x79\x4a\xa3\x60\xc7\xb1\xe0\x8b\x65\x12\x5e\x46\x46\x2d\xc9\xb8\x90\xca\xab\xbc\x8d\xaa\x1a\x52\x2f\xdc\xb7\x05\x6c\xaa\x75\xbe\x29\xb7\xfb\x3c\x47\x85\x9b\xcd\x61\xbd\x5f\xed\xb6\x98\xed\x57\x55\x99\xaf\xb2\x1d\x26\xb1\xe7\xa1\x1d\xd7\x2b\x8d\xc7\x4b\xf1\xe8\xb1\x6b\xa2\x0e\x9d\x49\x1f\x1b\x89\xe7\xcd\xf5\x5e\x51\x28\x21\x5a\x9f\x3b\x94\xe6\xc7\xd3\x8f\x71\x79\xa9\xb0\xae\x69\xe9\x89\x6d\x7c\x63\x2c\x87\xd7\xc6\x22\xdb\xae\x17\x15\x75\xc6\x5d\xd2\xce\x3b\x71\x43\x51\xe3\xde\xff\xf8\x4e\x11\x91\x46\xc0\x75\xea\x35\x3f\xa3\x57\x8d\x3e\x85\xc9\x40\xc3\x04\x33\xd0\x75\xd0\x9b\x79\x68\xf4\xa0\x29\x25\x32\x85\xa6\x81\x66\x40\x08\x3f\xc4\x01\x5a\x18\x3d\xef\xdf\x0f\x77\x0f\x89\xe0\x39\x31\x76\x4f\xea\xb0\x10\xc3\x57\x64\xdd\x70\xbd\xfd\x45\x94\x5a\x1b\x8a\xef\x2d\xbe\xce\xe7\xb7\x3d\x0a\x52\xae\x87\x54\xa7\x94\x86\xad\xa7\xa1\xc8\xab\x75\x79\xd8\x65\x87\xfd\x7a\x7d\xd8\x63\xb9\xdf\xac\x56\x79\x76\x50\x6a\x9f\x57\xf9\x86\x1e\xf2\x3b\xde\x27\xa7\xdd\x76\xb3\x3f\xac\x71\x9d\x57\x75\xbe\x5a\x29\xdc\x3c\x50\x59\xd3\x6e\x57\x6e\x54\x8d\x98\x95\x09\x8a\x78\x5d\xf6\x32\xa8\x29\xbd\x8a\xc7\xeb\x3d\x07\x93\x2d\x01\x78\xd1\xb6\x2a\xe0\xfd\xe3\xe3\xc8\x4c\xf8\x1e\xde\x0d\xbd\x9f\xee\x46\xf8\xf7\xfb\xc7\xc7\x39\x3c\x85\x7f\x69\x9a\xfe\x27\x9c\xda\xf1\x91\xf1\x3c\x5e\x69\xc5\x74\x35\xcc\x6e\xd7\xdc\xf5\x89\x17\x45\x6d\x74\x48\x00\x5a\xb4\xba\x26\x96\x67\xec\xa5\x71\xbe\x00\x2c\xab\xde\x54\xc9\x1f\x01\x00\x00\xff\xff\x65\x3c\xe6\x2b\xf2\x0a\x00\x00"
-
-func resnet152YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet152Yml,
-		"ResNet152.yml",
-	)
-}
-
-func resnet152Yml() (*asset, error) {
-	bytes, err := resnet152YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet152.yml", size: 2802, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet18PrivYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5d\x8f\xe3\xba\x0d\x7d\xf7\xaf\x20\x36\x28\xd0\x02\x13\x27\xce\xd7\x66\xfc\x50\xe0\x76\x1f\x2e\x0a\xdc\x4e\x2f\xb6\x8b\xed\xc3\x45\x11\xd0\x12\x1d\xab\x2b\x4b\x82\x48\x27\x93\xfb\xeb\x0b\xc9\xce\xc7\x74\xb7\xd8\x7d\xe8\x3c\x0c\x62\xe9\x90\x22\x0f\xc9\x23\x39\xec\xa9\x86\x8f\xc4\x2f\x24\xd5\x1e\x66\x90\x16\xc0\xb7\x70\xf1\x43\x84\xde\x6b\xb2\x45\x1b\xb1\xa7\xb3\x8f\x5f\xea\x02\x60\x34\xf8\x44\x8e\x7d\xfc\xf8\x09\x66\x70\xdb\x85\xd6\x47\x90\x8e\x26\x2b\x80\x13\x45\x36\xde\xd5\xb0\x2b\x97\x65\xf5\x06\x3a\x6d\x81\xf2\x4e\x22\x1a\x27\xc5\x0d\x5c\x95\x4b\x98\xdd\x00\xc6\xb5\x3e\xf6\x28\xe3\x6f\x60\xea\xd1\x89\x51\xb7\xfd\x71\xb7\x48\x7e\xd0\x38\x8a\x35\xcc\xe0\xf6\xc1\x30\x30\x69\x10\x0f\x81\x62\x42\x8e\xa1\x41\x88\xa4\x8d\x4a\x3e\x0b\xb8\xff\xcd\xa0\x1f\xac\x98\x60\x09\x82\x45\x49\x78\x06\x85\x0e\x1a\x02\x0e\xa4\x4c\x6b\x48\x17\x00\xd8\xeb\xdd\xa6\xce\x96\xc7\x30\xd4\x10\xd1\x84\xe8\xff\x4d\x4a\x16\x0a\x63\x6f\xe7\x92\xc9\x89\x52\x67\xe4\x5c\x85\x21\x83\xd5\x8f\x80\x8f\x19\x1c\x82\xda\x6d\x2c\xd5\x3f\x62\x37\x61\x27\xcb\xef\xc6\xf4\x08\xd7\xc4\x2a\x9a\x20\x99\xf7\x3f\x17\x00\x3f\xa5\x4e\x30\x7a\x40\x0b\x96\x30\x3a\xe3\x8e\x0f\x45\x13\x0f\x84\x4c\xb9\xc6\xb9\x6a\x69\xdb\xb7\xe0\x48\xd2\x3e\x83\x74\x28\x80\x91\x80\x87\x86\x25\x15\x0a\xad\xbd\x80\x26\x0a\x94\x5a\x03\x1d\x48\xe7\x99\xc6\xb2\x84\x48\x27\xe3\x07\xb6\x97\xb2\x00\xf8\x27\x01\xbd\x06\x6b\x94\x11\x7b\x81\x48\x89\xfe\xc1\xa2\x8c\xc7\x59\xbc\xa4\x72\x22\xdf\xe3\x8a\xd7\x48\xdb\xc1\xe5\x5a\x32\x9c\x8d\x74\xc9\x94\x22\x39\x45\x29\xde\x9b\x2d\x18\x17\x06\xe1\x27\x30\x8e\x85\x50\xa7\xb8\x6f\xae\x06\x77\x33\xd2\x77\x77\x53\x54\x21\xfa\x93\xd1\x04\xca\xf7\x21\x52\x47\x8e\xcd\x89\x80\xfa\x60\xa2\x51\x68\x81\xd2\x6e\x3a\x8e\x3b\x7f\x4e\xde\x32\x0b\xd2\x11\xd3\x3d\xc6\x1b\x45\x89\x1d\x42\x36\x89\x0f\x0f\x3e\x88\xe9\xcd\xef\xf4\x04\xe8\x74\xee\xb5\x23\x1a\x07\xa8\xd4\x10\x51\x5d\xa0\x8d\xbe\x4f\xed\xcc\x46\x53\xc4\xc6\x5e\xc0\x38\x15\x53\x11\x34\x68\x0a\xd2\xa5\x18\xff\xee\x72\x9a\x7f\xed\xf1\x48\x2f\x24\xa0\x51\x90\x49\xe0\x4c\x40\x27\xb4\x43\xe2\xf0\x31\x90\x89\x27\x1c\x3d\x24\x22\x86\x90\x82\xa9\xb6\xab\x89\xe7\xf9\x7c\xbe\x7f\x7d\x53\xb6\xcf\x3f\xff\x3c\x9a\x36\x83\x00\x8b\xb1\x16\x3a\x3c\xa5\x6c\xad\x3f\x53\xcc\xe4\x58\x7a\x35\x92\x6b\xf9\x93\x03\x72\x4c\x7d\x63\xb3\x94\x7c\xcd\x05\x03\xaa\xce\xd0\x89\x18\xd6\xe5\xf6\xfd\x1f\x80\x62\xf4\x11\xfc\x7f\x65\x22\xc4\x02\x4c\x52\xc2\xa7\xce\x70\xf2\x30\x58\x81\xf3\x04\xab\x58\xd2\xa0\x2a\xba\xd9\xfd\xf2\x8f\xcf\x1f\x3f\xc0\x6a\x59\x6d\x41\x59\x64\x36\xad\x51\xa3\x74\x08\xf2\x97\xb2\xb8\x55\x99\x93\x4c\xdc\xbf\xb2\x42\x60\x48\x1d\xb6\x80\x33\x35\x6c\x84\xd2\x4f\x12\x55\x96\x30\x4e\x49\x33\x96\xf6\xae\x6e\x73\xe8\x44\x02\xd7\x8b\xc5\xd1\x48\x37\x34\xa5\xf2\xfd\x82\x3d\xe1\x89\xe2\x42\x61\xdb\xd2\x3c\x43\x17\x12\x89\x16\x3d\xb2\xa4\x75\xcb\xdf\x33\x0d\x17\xf1\x51\x75\xf3\xb7\x19\xbc\xb1\xc2\xf8\x6a\x4e\xa5\x8f\xc7\x05\x36\xbc\xa8\xb6\xd5\xaa\x5c\xae\xd7\xfb\x6d\x31\x03\x6b\x54\xa2\x7e\xa2\x7d\x0a\x76\x5a\xac\x73\xa3\xb3\x44\xa3\x84\x74\x31\x9b\x66\xe2\x3a\x27\x23\x76\x5c\x4b\xca\x33\x83\xd6\x44\x96\x11\x05\x72\x09\xf4\x95\xc2\xcf\xf3\x72\x0d\x26\x55\xac\x18\x45\xf4\x41\x54\xae\x51\x3c\xf8\xc9\xa0\x37\xba\x93\x00\xe3\x11\x77\x2f\x01\x93\xe8\x08\xc5\x5c\xa8\x7c\xf4\x7d\x69\xd2\x6c\x6d\xfa\x34\x8b\xde\x71\x0d\xbf\xad\x9f\x60\xb5\xda\xe4\x7f\xff\x9a\xf6\x7b\x42\x57\xc3\x6f\xd5\x6a\x5d\xee\xde\x6f\x9f\xa0\xaa\x76\xe5\x6a\xff\x04\xd5\x72\x5d\x6e\x57\x57\x14\x2b\xb4\x54\x43\xb5\xda\x17\x7e\x90\x30\xc8\x98\x79\x96\xb8\x74\xec\x94\xc1\xb8\x57\xc0\x94\x6f\x4b\x28\x43\xa4\x0c\xc5\x6f\x65\x3c\xe2\xef\x41\x17\xdf\x48\x7a\xc2\x58\x6c\x32\x97\x0f\x39\x4f\x4c\x7e\x2b\xef\xe9\x64\x3e\x0c\xd1\xd6\xb9\x21\xea\xc5\x82\xd7\x25\xf6\xf8\xbb\x77\x78\xe6\xb1\x97\xc4\x47\x2a\xb3\xf2\xe7\x36\xe1\x8b\x63\x12\x5e\x64\x86\x1d\xc9\xb4\x50\xca\xab\xbc\xf5\xaa\x3a\x52\x5f\x78\xe8\x6b\xd8\xe8\xd5\x7a\xd3\x6c\xf7\xeb\x35\x2a\xdc\x6c\x9e\x57\xfb\xe5\x6e\x8b\xd5\x7e\xa9\x9b\xf5\xb2\xda\x61\x91\x7b\x20\x95\xe7\x7a\x37\xf2\x74\xbb\x1e\x23\x86\x2e\x0b\xda\x99\xcc\xb1\x93\x3c\xb8\x7e\x88\x8a\x52\x0a\x79\xf7\x10\x50\xba\x1f\x0f\x3f\xfb\xe5\x71\xa8\x16\x91\xd8\xa5\xb7\xca\x3c\x44\x73\x5a\x68\x0a\xd6\x5f\x0e\x6f\x16\x0f\xce\x1f\x14\x19\x7b\x48\x76\x65\x88\x5e\xfc\x98\xe8\x14\xcf\xff\xef\xf4\x37\x5f\x65\x46\x5c\x47\xc3\xf0\x01\xa3\xea\xcc\x29\xb5\x0b\x5a\x26\x98\x81\x69\x93\x9a\x3d\xa5\xea\x8f\x8a\xd5\x20\x53\xaa\x24\x18\x06\x84\xf4\x43\x3c\xa0\x83\xc9\xf2\xf1\x75\xf2\xf0\x4c\x49\x96\x77\x1a\x1f\x99\x1e\x17\xb2\x7b\x4d\xce\x8f\x97\xe7\xff\xf0\xd2\x1a\x4b\xf9\x35\xc7\xd7\xa6\xfd\xba\x70\xe9\xa2\x30\x63\xa8\xf7\x90\xc6\xa3\xef\x9d\xd2\x2c\x77\x6d\xd5\x56\x5a\x6f\xaa\xed\x56\xbd\xaf\xaa\xe5\x5e\xef\xab\xe7\x6a\xbb\x7d\xde\xee\xf0\x79\xff\x40\xfc\xdd\x68\xf9\xbc\xdc\xe8\xdd\xb2\x6a\xd5\xf3\x7a\xa9\x37\xed\x52\xed\x56\xb8\xc2\xe7\x6d\xb3\x56\xeb\xe6\x79\x5d\xa0\x48\x34\xcd\x20\xa3\x56\xd3\xab\x44\xbc\xde\xa2\x70\xdf\x2b\x00\xbe\x18\xa7\x6b\xf8\xf0\xf2\x32\x31\x93\xbe\xc7\x57\xc9\x10\xef\x37\x2f\xfc\xf1\xc3\xcb\xcb\x13\x7c\x4c\xff\xca\xb2\xfc\x53\x1a\xe5\xe9\x09\x73\x98\x2e\xcc\xfa\x7e\xf1\xcc\x6e\x97\xe8\xf5\x01\x99\x95\x6f\x32\x28\x00\x7a\x74\xa6\x25\x96\x03\x0e\xd2\xf9\x58\x03\x36\x7a\xb0\x49\x53\x3b\xa3\x35\xa5\x09\x8f\x43\x2a\xf9\xdf\x88\x19\x8f\x93\x98\xbc\xbb\xbe\xc2\xca\x5f\xbd\xb7\xc6\x1d\x7f\xbd\x0e\xf7\x3b\xe8\x90\xc1\x79\x68\x0d\x59\x9d\xcb\xa2\xe1\xdd\xad\x89\xdf\x25\xc7\x5f\x5f\x19\x7f\xf9\xfc\xcb\x87\xa9\x2f\xc3\x60\xed\x62\xbd\xdc\xbe\x5f\xa4\xba\x72\x51\xfc\x27\x00\x00\xff\xff\xb3\x39\xed\xef\xd9\x0b\x00\x00"
-
-func resnet18PrivYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet18PrivYml,
-		"ResNet18-priv.yml",
-	)
-}
-
-func resnet18PrivYml() (*asset, error) {
-	bytes, err := resnet18PrivYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet18-priv.yml", size: 3033, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet269V2Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xdf\x8b\xe3\x36\x10\x7e\xf7\x5f\x31\x10\x0a\x2d\x6c\x9c\xd8\xf9\xb9\x7e\x28\x5c\xaf\x70\x14\xca\x16\xb6\xc7\xf5\xe1\x38\x96\xb1\x3c\x8e\xd5\x95\x25\xa3\x19\x27\x9b\xfb\xeb\x8b\x64\x27\xce\xf6\xee\xe8\x3d\x74\x1f\x16\x47\xf3\xcd\x68\xe6\x9b\xd1\x27\x59\x6c\xa9\x80\x47\xe2\x07\x92\x7c\x7b\x0f\x33\x08\x2b\xe0\x6a\x38\xbb\xde\x43\xeb\x2a\x32\x49\xed\xb1\xa5\x93\xf3\xcf\x45\x02\x30\x78\xbc\x27\xcb\xce\x3f\xbe\x87\x19\x5c\xad\x50\x3b\x0f\xd2\xd0\xe8\x05\x70\x24\xcf\xda\xd9\x02\xb6\xe9\x32\xcd\x5e\x41\x47\x13\x28\x67\xc5\xa3\xb6\x92\x5c\xc1\x79\xba\x84\xd9\x15\xa0\x6d\xed\x7c\x8b\x32\x7c\x03\x53\x8b\x56\xb4\xba\xda\x07\x6b\x12\xe2\xa0\xb6\xe4\x0b\x98\xc1\xf5\x07\x43\xcf\x54\x81\x38\xe8\xc8\x07\xe4\x90\x1a\x74\x9e\x2a\xad\x42\xcc\x04\xa6\xbf\x19\xb4\xbd\x11\xdd\x19\x82\xce\xa0\x04\x3c\x83\x42\x0b\x25\x01\x77\xa4\x74\xad\xa9\x4a\x00\xb0\xad\xb6\xeb\x22\x7a\x1e\xba\xbe\x00\x8f\xba\xf3\xee\x6f\x52\xb2\x50\xe8\x5b\x33\x97\x48\x8e\x97\x22\x22\xe7\xaa\xeb\x23\x58\x7d\x0f\xf8\x10\xc1\x5d\xa7\xb6\x6b\x43\xc5\xf7\xf8\x8d\xd8\xd1\xf3\x3f\x73\xba\x85\x57\xc4\xca\xeb\x4e\x22\xef\x3f\x27\x00\x6f\xc2\x28\xe8\xaa\x47\x03\x86\xd0\x5b\x6d\x0f\x37\x4d\x13\x07\x84\x4c\xb1\xc7\xb1\x6b\xc1\xec\x6a\xb0\x24\xc1\xce\x20\x0d\x0a\xa0\x27\xe0\xbe\x64\x09\x8d\x42\x63\xce\x50\x11\x75\x14\x46\x03\x2d\x48\xe3\x98\x86\xb6\x74\x9e\x8e\xda\xf5\x6c\xce\x69\x02\xf0\x17\x01\xbd\x74\x46\x2b\x2d\xe6\x0c\x9e\x02\xfd\xbd\x41\x19\xb6\x33\x78\x0e\xed\x44\x9e\xf2\xf2\x97\x4c\xeb\xde\xc6\x5e\x32\x9c\xb4\x34\xc1\x95\x3c\x59\x45\x21\xdf\xab\x2f\x68\xdb\xf5\xc2\x77\xa0\x2d\x0b\x61\x15\xf2\xbe\x86\xea\xed\xd5\xa9\x9a\xc2\x8d\x59\x75\xde\x1d\x75\x45\xa0\x5c\xdb\x79\x6a\xc8\xb2\x3e\x12\x50\xdb\x69\xaf\x15\x1a\xa0\x60\x0d\xdb\x71\xe3\x4e\x21\x5a\x64\x41\x1a\x62\x9a\x72\xbc\x52\x14\xd8\x21\x64\x1d\xf8\x70\xe0\x3a\xd1\xad\xfe\x4c\x77\x80\xb6\x8a\xb3\x76\x40\x6d\x01\x95\xea\x3d\xaa\x33\xd4\xde\xb5\x61\x9c\x59\x57\xe4\xb1\x34\x67\xd0\x56\xf9\xd0\x84\x0a\x2a\xea\xa4\x09\x39\xfe\x61\x63\x99\xbf\xb5\x78\xa0\x07\x12\xa8\x50\x90\x49\xe0\x44\x40\x47\x34\x7d\xe0\xf0\x36\x91\x91\x27\x1c\x22\x04\x22\xfa\x2e\x24\x93\x6d\xf2\x91\xe7\xf9\x7c\xbe\x7f\x79\xd5\xb6\x0f\xef\xde\x0d\xae\x65\x2f\xc0\xa2\x8d\x81\x06\x8f\xa1\x5a\xe3\x4e\xe4\x23\x39\x86\x5e\xb4\xc4\x5e\xbe\xb1\x40\x96\xa9\x2d\x4d\x94\x92\x2f\xb9\x60\x40\xd5\x68\x3a\x12\xc3\x2a\xdd\xec\x7e\x00\xf2\xde\x79\x70\xff\xaa\x44\x88\x05\x98\x24\x85\xf7\x8d\xe6\x10\xa1\x37\x02\xa7\x11\x96\xb1\x84\x83\xaa\xe8\xea\xf7\xfb\x9f\x1f\x1e\xdf\x42\xbe\xcc\x36\xa0\x0c\x32\xeb\x5a\xab\x41\x3a\x04\xf9\x39\x4d\xae\x5d\xe6\x20\x13\xd3\xaf\xa8\x10\xd8\x85\x09\x5b\xc0\x89\x4a\xd6\x42\xe1\x93\x44\xa5\x29\x0c\xa7\xa4\x1c\x5a\x3b\xa9\xdb\x1c\x1a\x91\x8e\x8b\xc5\xe2\xa0\xa5\xe9\xcb\x54\xb9\x76\xc1\x8e\xf0\x48\x7e\xa1\xb0\xae\x69\x1e\xa1\x0b\xf1\x44\x8b\x16\x59\xc2\xba\xe1\x6f\xb9\x2a\x8f\xb5\xbc\xfb\xe5\xd7\xeb\xc7\x2b\x20\xfa\x17\x7d\x4c\x9d\x3f\x2c\xb0\xe4\x45\xb6\xc9\xf2\x74\xb9\x5a\xed\x37\xc9\x0c\x8c\x56\x81\xed\x91\xe9\x31\xbf\x71\xb1\x88\xb3\xcd\xe2\xb5\x12\xaa\x92\xd9\x78\x0c\x2e\x47\x63\xc0\x0e\x6b\x41\x6c\x66\x50\x6b\xcf\x32\xa0\x40\xce\x1d\x7d\x21\xea\xf3\xb8\x5c\x80\x0e\x4d\x4a\x06\xdd\xbc\xd1\x91\x4b\x16\x37\x71\x22\xe8\x95\xd4\x04\xc0\xb0\xc5\x14\xa5\xc3\xa0\x33\x42\x3e\xf6\x26\x6e\x3d\x2d\x8d\x32\x5d\xe9\x36\x1c\x3f\x67\xb9\x80\x8f\xab\x3b\xc8\xf3\x75\xfc\xf7\x69\xb4\xb7\x84\xb6\x80\x8f\x59\x9e\xa7\xbb\x5d\x7e\x07\x59\xb6\x49\xef\xd7\xbb\x3b\xc8\x96\x79\x7a\xbf\xff\x94\xb8\x5e\xba\x5e\x86\x4a\xa3\x8a\x85\x6d\xc6\x8c\x07\x5b\x02\x63\x7d\x35\xa1\xf4\x9e\x22\x14\xbf\x56\xe1\x80\x9f\x92\x4c\xbe\x52\xe4\x88\x31\x58\x46\xee\x6e\x6a\x1c\x99\xfb\x5a\x9d\xe3\xce\xfc\xd4\x7b\x53\xc4\x01\x28\x16\x0b\x5e\xa5\xd8\xe2\x67\x67\xf1\xc4\xc3\xa4\x89\xf3\x94\x46\x71\x8f\x63\xc1\x67\xcb\x24\xbc\x88\x8c\x5a\x92\x71\x21\x95\x17\x79\x1d\x55\x35\xa4\x9e\xb9\x6f\x0b\x58\x57\xf9\x6a\x5d\x6e\xf6\xab\x15\x2a\x5c\xaf\xef\xf3\xfd\x72\xbb\xc1\x6c\xbf\xac\xca\xd5\x32\xdb\x62\x12\x7b\x1e\xda\x71\xb9\xfe\x78\xbc\x40\x0f\x1e\xbb\x26\x6a\xd6\x89\xf4\xa1\x91\x78\x36\x5d\xef\x15\x85\x12\xa2\xf5\xa9\x43\x69\xbe\x3f\xfd\x18\x97\x87\x73\xb3\xf0\xc4\x36\xbe\x47\xe6\xc7\x7c\x51\x51\x67\xdc\xf9\xe9\x76\x2d\xed\xbc\x13\x37\x54\x36\x26\xf0\xbf\x6d\xf7\x6a\x9f\x68\xbf\x4c\xbe\xe6\x27\xf4\xaa\xd1\xc7\x30\x1d\x68\x98\x60\x06\xba\x0e\xfa\x74\x17\x9a\x3d\x68\x50\x89\x4c\xa1\x71\xa0\x19\x10\xc2\x87\x38\x40\x0b\xa3\xe7\xed\x7b\xe3\xe6\xe1\x11\x3c\x27\xd6\x6e\x89\x1d\x16\x62\xf8\x8a\xac\x1b\xae\xc3\x6f\x44\xa9\xb5\xa1\xf8\x3e\xe3\xcb\x8c\x7e\xd9\xa7\x20\xfd\x7a\x48\x75\x4a\x69\xd8\x7a\x1a\x8c\xe5\xa6\x5e\xee\x90\xaa\xfd\x92\xf6\xbb\x6a\xbb\xaa\xca\xdd\x6a\x5d\xaf\xea\x7c\x8f\x79\x99\xd5\xd9\x0d\xed\x93\x53\x75\xbf\x5f\xd7\xeb\xdd\x6e\xad\xea\xfd\x76\xb3\xcb\xf7\x79\x76\x8f\x15\xee\xcb\x9a\xd4\x72\x79\xbf\x4e\x50\xc4\xeb\xb2\x97\x41\x7d\xe9\x45\x3c\x5e\xee\x45\x98\x6c\x09\xc0\xb3\xb6\x55\x01\x6f\x1f\x1e\x46\x66\xc2\xef\xe1\x9d\xd1\xfb\xe9\x2e\x85\x1f\xdf\x3e\x3c\xdc\xc1\x63\xf8\x97\xa6\xe9\x4f\xe1\xe4\x8e\x8f\x92\xa7\xf1\x0a\x2c\xa6\xab\x64\x76\xbd\x16\x2f\x4f\xc2\x28\x6c\xa3\x43\x02\xd0\xa2\xd5\x35\xb1\x3c\x61\x2f\x8d\xf3\x05\x60\x59\xf5\xa6\x4a\xfe\x09\x00\x00\xff\xff\xbf\x48\x8a\x9a\x22\x0b\x00\x00"
-
-func resnet269V2YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet269V2Yml,
-		"ResNet269-v2.yml",
-	)
-}
-
-func resnet269V2Yml() (*asset, error) {
-	bytes, err := resnet269V2YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet269-v2.yml", size: 2850, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _resnet50_v1Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xdb\x38\x12\xbd\xeb\x57\x14\x60\x2c\x7a\x17\x68\xcb\x96\x65\xbb\x6d\x1d\x16\xc8\xf6\x21\x59\xec\xa2\x07\xe8\x09\x92\x43\x10\x18\x25\xaa\x64\x71\x9a\x22\x09\xb2\x64\xb7\xf3\xeb\x07\x24\xe5\x8f\x9e\x04\x33\x39\x4c\x1f\x1a\x16\xab\x5e\xb1\xf8\xea\xb1\x8a\x1a\x7b\xaa\xe0\x99\xfc\x13\xf1\x6a\xbe\x3b\x14\x30\x81\xb0\x06\xa6\x85\x93\x19\x1c\xf4\xa6\x21\x95\xb5\x0e\x7b\x3a\x1a\xf7\x52\x65\x00\x09\xf3\x91\xb4\x37\xee\xf9\x23\x4c\xe0\x62\x85\xd6\x38\xe0\x8e\x46\x14\xc0\x81\x9c\x97\x46\x57\xf0\x90\xcf\xf3\xf9\x1b\xd7\xd1\x04\xc2\x68\x76\x28\x35\x67\x17\xe7\x22\xba\x9e\x1d\xa4\x6e\x8d\xeb\x91\xd3\x6f\xf0\xd4\xa3\x66\x29\x2e\xf6\x64\xcd\x42\x1c\x94\x9a\x5c\x05\x13\xb8\x7c\x78\x18\x3c\x35\xc0\x06\x2c\xb9\xe0\x99\x52\x03\xeb\xa8\x91\x22\xc4\xcc\xe0\xfa\x37\x81\x7e\x50\x2c\xad\x22\xb0\x0a\x39\xf8\x7b\x10\xa8\xa1\x26\xf0\x96\x84\x6c\x25\x35\x19\x00\xf6\xcd\x7a\x59\x45\xe4\xde\x0e\x15\x38\x94\xd6\x99\xdf\x48\xf0\x4c\xa0\xeb\xd5\x94\x23\x39\x8e\xab\xe8\x39\x15\x76\x88\xce\xe2\x67\x9c\xf7\xd1\xd9\x5a\xb1\x5e\x2a\xaa\x7e\x06\x37\xfa\x8e\xc8\xbf\xcc\xe9\xd6\xbd\x21\x2f\x9c\xb4\x1c\x79\xff\x77\x06\xf0\x2e\x88\x41\x36\x03\x2a\x50\x84\x4e\x4b\xbd\xbf\x29\x1a\x1b\x20\xf4\x14\x6b\x1c\xab\x16\xcc\xa6\x05\x4d\x1c\xec\x1e\xb8\x43\x06\x74\x04\x7e\xa8\x3d\x87\x42\xa1\x52\x27\x68\x88\x2c\x05\x69\xa0\x06\xee\x8c\xa7\x54\x16\xeb\xe8\x20\xcd\xe0\xd5\x29\xcf\x00\x3e\x13\xd0\xab\x55\x52\x48\x56\x27\x70\x14\xe8\x1f\x14\x72\xda\x4e\xe1\x29\x94\x13\xfd\x35\x2f\x77\xce\xb4\x1d\x74\xac\xa5\x87\xa3\xe4\x2e\x40\xc9\x91\x16\x14\xf2\xbd\x60\x41\x6a\x3b\xb0\xbf\x07\xa9\x3d\x13\x36\x21\xef\x4b\xa8\x41\x5f\x40\xcd\x35\xdc\x98\x95\x75\xe6\x20\x1b\x02\x61\x7a\xeb\xa8\x23\xed\xe5\x81\x80\x7a\x2b\x9d\x14\xa8\x80\x82\x35\x6c\xe7\x3b\x73\x0c\xd1\x22\x0b\xdc\x91\xa7\x6b\x8e\x17\x8a\x02\x3b\x84\x5e\x06\x3e\x0c\x18\xcb\xb2\x97\xdf\xe8\x1e\x50\x37\x51\x6b\x7b\x94\x1a\x50\x88\xc1\xa1\x38\x41\xeb\x4c\x1f\xe4\xec\x65\x43\x0e\x6b\x75\x02\xa9\x85\x0b\x45\x68\xa0\x21\xcb\x5d\xc8\xf1\x17\x1d\x8f\xf9\xdf\x1e\xf7\xf4\x44\x0c\x0d\x32\x7a\x62\x38\x12\xd0\x01\xd5\x10\x38\xbc\x4d\x64\xe4\x09\x53\x84\x40\xc4\x60\x43\x32\xc5\x6a\x31\xf2\x3c\x9d\x4e\x37\xaf\x6f\xca\xf6\xe9\xfd\xfb\x04\xad\x07\x06\xcf\x52\x29\xe8\xf0\x10\x4e\xab\xcc\x91\x5c\x24\x47\xd1\xab\xe4\x58\xcb\x77\x1a\x48\x7b\xea\x6b\x15\x5b\xc9\xf7\x5c\x78\x40\xd1\x49\x3a\x90\x87\x32\x5f\x3d\xfc\x03\xc8\x39\xe3\xc0\xfc\xe1\x24\x4c\x9e\xc1\x13\xe7\xf0\xb1\x93\x3e\x44\x18\x14\xc3\x71\x74\x2b\x3c\x87\x8b\x2a\xe8\x82\xfb\xff\xaf\x9f\x9e\x1f\x61\x31\x2f\x56\x20\x14\x7a\x2f\x5b\x29\x52\xeb\x60\xf4\x2f\x79\x76\xa9\xb2\x0f\x6d\xe2\xfa\x15\x3b\x04\xda\xa0\xb0\x19\x1c\xa9\xf6\x92\x29\xfc\x24\x16\x79\x0e\xe9\x96\xd4\xa9\xb4\xd7\xee\x36\x85\x8e\xd9\xfa\x6a\x36\xdb\x4b\xee\x86\x3a\x17\xa6\x9f\xfd\x0f\x65\x2f\xf5\xfe\x03\xcd\x02\x7b\xd3\xf3\x99\xa7\xe7\xfa\xbf\xc1\xa1\x7b\x95\x87\xdc\xb8\xfd\x0c\x6b\x3f\x2b\x56\xc5\x22\x9f\x97\xe5\x66\x95\x4d\x40\x49\x11\x18\x1c\xd9\x1b\xf7\x1c\x17\xab\xa8\x57\xcf\x4e\x0a\xa6\x26\x9b\x8c\xd2\x3e\xcb\x3d\xf9\xa6\xb5\x70\xcc\xd4\xf2\xd2\x77\xdc\x9e\x4f\x96\x2a\x90\x81\xe4\xd0\x93\xa5\xf3\x9c\xcc\xc1\x15\x95\xe4\x53\xec\x23\x6f\x9a\x43\x08\x9c\x7c\xce\xb8\x1b\xf3\x39\xcb\x9b\x50\x31\x82\xc5\xd0\x3a\x98\x9c\xaf\xc6\x3e\x4b\x8a\x7a\xd2\xbc\x4b\x29\xb4\xca\x20\x97\x8b\xd1\x16\x71\xbb\x28\xc0\x0a\xee\x82\x88\xef\x46\x8b\xc2\x93\x19\xb8\x82\xbb\xc7\x0f\x9f\xcf\x6b\xc2\x28\xe3\x76\xe1\x6c\x15\xdc\xfd\xe7\xfd\xf3\x79\xbd\x91\x7d\xb8\xa1\x46\xfb\x0a\xbe\x94\xf7\xb0\x58\x2c\xe3\xbf\xaf\xa3\xbd\x27\xd4\x15\x7c\x29\x16\x65\xbe\xde\xdc\x43\x51\xac\xf3\x87\x87\xed\x3d\x14\xf3\x32\xdf\x96\xdb\xaf\x99\x19\xd8\x0e\x1c\x12\x9e\xa4\x46\x77\xb2\x97\x32\x24\x5b\x06\x23\x85\x6f\x35\x16\x11\xf8\x23\x5e\x12\xec\x4a\x47\xf6\x03\x72\x47\x1f\x85\x75\x14\xd7\x0d\x75\x21\x8f\x90\xc3\x75\x29\xfb\x73\x2a\xad\x33\x35\xd6\x52\x49\x96\xe4\x2f\x84\x86\xd5\x44\x52\x4b\xc8\x83\x23\xbf\x1b\x9c\xaa\xa2\x18\xab\xd9\xcc\x97\x39\xf6\xf8\xcd\x68\x3c\xfa\xa8\x64\xcf\xc6\x51\x1e\x87\x47\x94\xa8\x3f\x69\x4f\xec\x67\xb1\xfe\x9a\x78\x5c\xc8\xf9\x95\xdf\x46\x15\x1d\x89\x17\x3f\xf4\x15\x2c\x9b\x45\xb9\xac\x57\x9b\xb2\x44\x81\xcb\xe5\x76\xb1\x99\xaf\x57\x58\x6c\xe6\x4d\x5d\xce\x8b\x35\x66\x51\x9a\xe1\x80\xe7\xf1\xea\x47\xb5\xee\x1d\xda\x2e\xf6\xc4\x23\xc9\x7d\xc7\xf1\xee\x9b\xc1\x09\x0a\x87\x8f\xd6\x9d\x45\xee\x7e\x3e\xfd\x18\xd7\xcf\x04\xb6\x2d\xcd\x1c\x79\x1d\x5e\x3c\xb3\xf4\xf4\x99\xae\xe6\xd3\x86\xac\x32\xa7\xdc\x3a\xc3\x26\x1d\x69\xdc\xf9\x6f\xde\x27\xda\xf3\x68\x3e\x37\x12\xe9\x77\xe8\x44\x27\x0f\xe3\xbc\x6f\x51\xf9\x70\xc3\x64\x1b\x7a\xdf\x7d\x90\x47\xea\x6f\x35\x7a\x0a\x45\x03\xe9\x01\x21\xfc\x60\x03\xa8\x61\x44\x67\xe9\x11\x13\x3c\xaf\x0c\xdd\x92\x98\x16\x62\xb8\x86\xb4\x49\xa3\x75\x44\xb5\x52\x51\x7c\xdb\xf9\xb3\x6a\xbf\xaf\x41\x18\x1b\x32\xa5\x72\xdd\x32\x6d\x75\x53\xf4\x1a\xcb\x76\xbb\x5c\xd1\x43\xbd\x59\xd7\xf3\x87\xf5\x72\x43\xcb\x76\x59\xae\x8a\x86\xe6\xeb\xed\xf6\x86\xd9\x1b\xd0\xb2\x5e\xcc\xd7\xeb\xb9\x58\x6d\x97\x9b\x72\x5b\x3c\x94\xcb\x79\xb9\xde\xae\x4b\xda\xac\x56\x4d\xb3\xc8\x90\xd9\xc9\x7a\xe0\xd4\xb9\xe9\x95\x1d\x9e\x67\x2a\x5c\x6d\x19\xc0\x8b\xd4\x4d\x05\x8f\x4f\x4f\x23\x13\xe1\x3b\xbd\x51\x06\x77\x9d\xc3\xf0\xcf\xc7\xa7\xa7\x7b\x78\x0e\xff\xf2\x3c\xff\x57\xb8\xd2\xe3\x83\x66\x37\x8e\xcf\xea\x3a\x86\x26\x97\x91\x7a\x7e\x4e\xc6\x97\xee\x08\xc8\x00\x7a\xd4\xb2\x25\xcf\x3b\x1c\xb8\x33\xae\x02\xac\x9b\x41\x35\xd9\xef\x01\x00\x00\xff\xff\xcf\x74\x99\xed\x60\x0b\x00\x00"
-
-func resnet50_v1YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_resnet50_v1Yml,
-		"ResNet50_v1.yml",
-	)
-}
-
-func resnet50_v1Yml() (*asset, error) {
-	bytes, err := resnet50_v1YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ResNet50_v1.yml", size: 2912, mode: os.FileMode(436), modTime: time.Unix(1588607403, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _squeezenetV10Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xc9\x8e\xdb\x38\x13\xbe\xeb\x29\x0a\x30\x02\xfc\x3f\xd0\xad\xc5\xb2\xdc\x8e\x0e\x03\x64\x32\x97\x5c\x7c\x48\x72\x0b\x82\x46\x89\x2c\x59\x9c\x70\x51\xc8\x52\xbb\x3b\x4f\x3f\x20\x25\x5b\x6e\x24\x83\x89\x0f\x86\xc8\xfa\x6a\xfb\x6a\xa1\x45\x43\x2d\x7c\xfa\x3e\x11\xfd\xa0\x23\x31\x6c\x20\x5e\x81\xeb\xe1\xc5\x4d\x1e\x8c\x93\xa4\xb3\xde\xa3\xa1\xb3\xf3\xdf\xda\x0c\x60\x56\xf9\x4c\x36\x38\xff\xf1\x33\x6c\xe0\x2a\x85\xde\x79\xe0\x81\x16\x2d\x80\x27\xf2\x41\x39\xdb\xc2\x3e\x2f\xf3\xea\x15\x74\x11\x81\x70\x96\x3d\x2a\xcb\xd9\x15\x5c\xe5\x25\x6c\xae\x00\x65\x7b\xe7\x0d\xf2\xfc\x0d\x81\x0c\x5a\x56\xe2\x2a\x9f\xa5\x59\xb4\x83\xca\x92\x6f\x61\x03\xd7\x43\x80\x29\x90\x04\x76\x30\x92\x8f\xc8\x39\x34\x18\x3d\x49\x25\xa2\xcd\x0c\xd6\xdf\x06\xcc\xa4\x59\x8d\x9a\x60\xd4\xc8\x11\x1f\x40\xa0\x85\x8e\x20\x8c\x24\x54\xaf\x48\x66\x00\x68\xe4\x7e\xd7\x26\xcd\xd3\x38\xb5\xe0\x51\x8d\xde\xfd\x4d\x82\x0b\x81\xde\xe8\x7b\x4e\xe4\x78\x6e\x13\xf2\x5e\x8c\x53\x02\x8b\xdf\x01\x9f\x12\x78\x1c\xc5\x7e\xa7\xa9\xfd\x1d\xbd\x05\xbb\x68\xfe\x67\x4c\xb7\x70\x49\x41\x78\x35\x72\xe2\xfd\x8f\x0c\x2e\x9d\x60\x89\x01\xc5\xa0\xe8\x89\x02\x04\x65\x94\x46\x0f\x9e\xc2\xa4\x39\x44\x36\xdf\x69\x7a\x3e\x12\xdf\x01\x32\x34\xe5\x33\xf4\x74\x26\x0f\x23\xc6\xf2\x72\xa4\x1d\xad\x84\xaa\x68\xca\x92\x87\xd4\x11\x41\xfd\xa0\x3c\xda\x37\xa8\xf5\x5c\x84\x00\xe8\x63\xab\x78\x82\x9e\x30\xa8\x4e\x53\x34\x2d\x69\xd4\xee\x05\x9c\x85\x01\xbd\x3c\x47\xcc\x59\xf1\x00\x5a\x19\xc5\x24\xc1\x90\x71\xfe\xe5\x0e\x3c\x7d\x9f\x94\x27\xd0\x14\x42\x06\x20\x9c\x31\x93\x55\x62\x6e\x14\x39\x79\x65\x4f\x20\x55\x60\xaf\xba\x29\xea\xa5\x2e\x53\xf6\x74\x97\x62\x8b\x66\xa3\x53\xf2\xc9\xe7\x15\x17\x4f\x42\x2b\xb2\x1c\xf2\x95\x8e\xe3\x2d\x1d\xcd\x43\xde\xbc\x49\x46\x0e\x65\x5e\xbf\x01\x76\xe3\x7d\x95\xce\xf1\xab\x01\x14\x93\x47\xc1\x29\x85\x0f\x06\x4f\x51\x3b\xcf\x3c\xf5\xe4\xc9\x0a\x0a\xb1\x41\xd7\x53\xea\x4d\x1c\x23\x67\x05\x9c\xa9\x0b\x8a\x29\x7e\x12\x8b\x3c\x87\xb9\x3e\x5d\xcc\xe5\x76\xae\xee\x61\x60\x1e\x43\x5b\x14\x27\xc5\xc3\xd4\xe5\xc2\x99\xe2\x2f\xa2\xf1\x93\x40\x4d\xc5\x1a\xf5\x2b\x2c\xfa\x67\xf5\x94\x3b\x7f\x2a\xb0\x0b\x45\xb5\x2f\xb7\x79\xf9\x50\xef\xcb\x6c\x03\x5a\x09\xb2\x21\x8d\xfd\xea\x67\xb9\x6c\xe1\xcf\x77\x1f\x3e\x66\x1b\x50\x76\x9c\xe6\xf2\xaf\x98\xf9\x2e\xb6\xe9\x06\x7a\xe5\x03\xcf\x28\xe0\x97\x91\x7e\x5a\x07\xf7\xe9\xba\x05\x15\x59\xc9\xe6\x89\xbb\xe9\xc0\x8b\xf7\x1b\x3b\x09\xf4\xaa\x49\x23\x60\x76\xb1\x5a\x59\xfb\x2e\x72\x9b\x5c\xaf\x57\xcb\x80\x4b\x65\xc8\xc6\x85\x11\x5a\xf8\x52\xdf\xc1\x76\xfb\x90\xfe\xbe\x2e\x72\x43\x68\x5b\xf8\x52\x6d\x0f\x77\x70\xf9\xfb\x9a\xb9\x89\xc7\x89\xe7\xf4\xa2\xe7\x64\x7b\x09\x73\x96\x65\xb0\x24\xd5\x13\xf2\xe4\x29\x41\xf1\x57\x69\xcd\xf8\x35\xb2\xec\x17\x99\x2d\x18\x8d\x5d\x22\xec\x26\xb1\x85\xae\x5f\x25\xb7\x78\x0e\x8f\x93\xd7\x6d\xaa\x76\x5b\x14\x12\x19\x73\x69\xb4\xc8\x8d\x2e\xcc\xb3\x25\x2e\xe6\xa9\x2b\x12\x6f\xf1\x1c\x5e\x6c\x20\xce\xf9\x99\x5f\x9b\x11\x03\x89\x6f\x61\x32\x2d\xec\xe4\xb6\xde\x75\xcd\xa1\xae\x51\xe0\x6e\xf7\x76\x7b\x28\xf7\x0d\x56\x87\x52\x76\x75\x59\xed\x31\x4b\x26\x23\xe9\x97\xf5\x18\x96\x05\x7b\xf2\x38\x0e\x69\x28\xce\xa4\x4e\x03\x87\xb8\x3d\xdc\xe4\x05\xc5\x98\x3b\x0c\xb4\x46\xfb\x1b\x7d\x5c\x78\x3c\x17\x06\x03\x93\xbf\xb9\x7d\x7c\xaa\xf2\xb2\xc8\x60\xf6\xf6\x38\x22\x0f\xed\xb2\x3f\xf2\xd1\x3b\x76\x73\x6a\x4b\x04\x8b\x3c\x5c\x37\x5c\x52\xcf\x05\xf6\x3d\x5d\x3a\x54\x85\x47\xf4\x62\x50\x4f\xb1\xa0\xa8\x03\xc1\x06\x54\x0f\x21\xae\x3a\x1e\xc8\xa6\x22\x5d\xa2\x07\x15\x00\x21\x7e\xb0\x03\xb4\xb0\x68\xde\xbe\x28\x37\x4f\x4b\xd4\x5c\xe3\xbc\xa5\x66\xbe\x48\xe6\x25\x59\x17\x97\xd0\xf0\x6f\x56\x7a\xa5\x29\xbd\xc0\xe1\xd2\x56\x3f\x33\x1d\xd7\xa5\x9a\x43\x5d\x43\x9a\x5d\xaf\xa5\x25\x51\xd7\x24\xbb\xbe\x7a\xa0\xf2\xb0\xed\xea\xbe\x79\xa8\x9b\x9e\x84\x68\xb0\x96\x6f\x1f\x76\x37\xbc\xad\x4a\x5d\xf7\x16\xb7\xbd\xdc\x75\x54\x35\x07\x6a\xba\x8a\x9a\x03\x36\x42\x8a\x6d\xb7\x43\xc4\x43\x86\xbc\xec\xd1\x34\x89\xf4\xcc\x1e\xc1\x12\xa7\x17\x7f\x95\x65\x00\xdf\x94\x95\x2d\xbc\x3f\x1e\x17\x66\xe2\x39\x66\x64\x69\xf2\xa8\xaf\x3a\xff\x7b\x7f\x3c\xde\xc1\xc7\xf8\x97\xe7\xf9\xff\xe3\xb0\x2d\x6b\xfc\x31\x36\x78\x20\x6e\xaf\x3b\x36\x2e\x93\xf9\xee\xfa\xe8\xa7\x05\xb4\x28\x64\x00\x06\xad\xea\x29\xf0\x23\x4e\x3c\x38\xdf\x02\x76\x72\xd2\x32\xfb\x27\x00\x00\xff\xff\x16\x7d\x85\xf5\x05\x09\x00\x00"
-
-func squeezenetV10YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_squeezenetV10Yml,
-		"SqueezeNet-v1.0.yml",
-	)
-}
-
-func squeezenetV10Yml() (*asset, error) {
-	bytes, err := squeezenetV10YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "SqueezeNet-v1.0.yml", size: 2309, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _squeezenetV11Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x8f\xdb\x36\x13\xbe\xeb\x57\x0c\x60\x20\x78\x5f\x60\xad\x0f\x5b\x2b\x3b\x02\xd2\xa2\x4d\x2f\xb9\xec\x21\xc9\x2d\x08\x8c\x11\x39\xb2\x58\x4b\x24\x4b\x8e\xd6\xbb\xfd\xf5\x05\x29\xd9\xf2\x26\x1b\x24\x3e\x08\x12\xe7\x99\xef\x79\x86\x4e\x34\x0e\x54\xc3\xa7\x7f\x46\xa2\x7f\xe9\x81\x18\x56\x10\x8e\xc0\xb4\xf0\x6c\x46\x07\x83\x91\xd4\x27\xad\xc3\x81\xce\xc6\x9d\xea\x04\x60\x52\xf9\x4c\xda\x1b\xf7\xf1\x33\xac\xe0\x2a\x85\xd6\x38\xe0\x8e\x66\x2d\x80\x47\x72\x5e\x19\x5d\x43\x95\xe6\x69\xf1\x02\x3a\x8b\x40\x18\xcd\x0e\x95\xe6\xe4\x0a\x2e\x22\xf4\x02\x50\xba\x35\x6e\x40\x9e\xde\xc1\xd3\x80\x9a\x95\xb8\xca\x27\x69\x12\xec\xa0\xd2\xe4\x6a\x58\xc1\xf5\xc3\xc3\xe8\x49\x02\x1b\xb0\xe4\x02\x72\x0a\x0d\xac\x23\xa9\x44\xb0\x99\xc0\xf2\x5b\xc1\x30\xf6\xac\x6c\x4f\x60\x7b\xe4\x80\xf7\x20\x50\x43\x43\xe0\x2d\x09\xd5\x2a\x92\x09\x00\x0e\xb2\x2a\xeb\xa8\x79\xb4\x63\x0d\x0e\x95\x75\xe6\x6f\x12\x9c\x09\x74\x43\xbf\xe6\x58\x1c\xc7\x75\x44\xae\x85\x1d\x23\x58\xfc\x0a\xf8\x18\xc1\xd6\x8a\xaa\xec\xa9\xfe\x15\xbd\x19\x3b\x6b\xfe\x34\xa6\x5b\xb8\x24\x2f\x9c\xb2\x1c\xeb\xfe\x5b\x02\xb7\x93\xf0\x18\xfa\xd0\xa1\x87\x4d\x5a\x3e\x41\x4f\xde\x83\x30\x83\x1d\x79\xea\x05\x77\xa8\x03\x26\xbf\x83\xb3\xe2\xce\x8c\x0c\x1e\x85\x53\xad\x12\x4a\x1f\x01\x85\x18\x1d\x8a\xe7\x34\x71\xd4\x92\x23\x2d\xc8\x87\xd6\x2c\x5f\xb1\x2b\x68\x43\x93\x32\x38\x53\xe3\x15\x53\x78\x25\x16\x69\x0a\x53\x64\x4d\x30\x75\x3b\x51\x6b\xe8\x98\xad\xaf\xb3\xec\xa8\xb8\x1b\x9b\x54\x98\x21\xfb\x8b\xc8\x7e\x12\xd8\x53\xb6\x84\xff\x02\x8b\xee\x49\x3d\xa6\xc6\x1d\x33\x6c\x7c\x56\x54\xf9\x26\xcd\x77\xdb\x2a\xff\x91\x41\xa9\x1e\x95\x54\xac\x32\x71\xba\xd6\x2d\x63\x47\x94\x0d\xe8\x99\x5c\x66\x51\x9c\xf0\x48\x99\xc0\xb6\xa5\x18\xdb\x5a\x12\x59\x1f\x82\x58\xfb\x29\x08\x4d\xbc\x2e\xd2\xe2\xea\xa3\xce\x32\x71\xd2\xe6\xdc\x93\x3c\x52\x0c\xc6\x91\x35\xd9\x99\x9a\xd4\x76\xf6\x77\xa6\x21\x0c\x1d\xbd\x5b\x40\x6f\xde\x9c\x85\x92\xef\x66\x67\xf5\xfd\xdb\xb2\x28\xb7\xe5\xbe\x29\x1a\x49\xb2\xb9\x4f\x56\xd0\x2b\x41\xda\x47\xba\x2e\x55\x9a\x0f\x6b\xf8\xf3\x8f\x0f\x1f\x93\x15\x28\x6d\x47\x8e\xe5\x5e\x30\xd3\x59\x18\xaf\x15\xb4\xca\x79\x9e\x50\xc0\xcf\x96\xbe\xa3\xf1\x3a\x1e\xd7\xa0\x06\x3c\x52\x32\x31\xe5\x66\x72\x2e\xde\x6f\xec\x44\xd0\x8b\xe1\x0a\x80\xc9\xc5\x62\xc5\x62\x58\x07\x4c\x2e\x4e\x46\x74\xbd\x1c\xcd\xc4\x94\x6a\x20\x1d\x88\xee\x6b\xf8\xb2\xbd\x83\xcd\x66\x17\x1f\x5f\x67\xf9\x40\xa8\x6b\xf8\x52\x6c\xf6\x77\x70\x79\x7c\x4d\xcc\xc8\x76\xe4\x29\xbd\xe0\x39\xda\x9e\xc3\x9c\x64\x09\xcc\x49\xb5\x84\x3c\x3a\x8a\x50\x7c\x2d\xad\x09\xbf\x44\x96\xbc\x92\xd9\x8c\xe9\xb1\x89\x05\xbb\x49\x6c\x2e\xd7\x6b\xc9\xcd\x9e\xfd\x61\x74\x7d\x7d\x19\x11\x89\x8c\xa9\x1c\x7a\x91\x0e\x7d\x36\x3c\x69\xe2\x2c\xb6\xc1\x67\xb1\x6e\xe1\xdb\x3f\x6b\x4f\x9c\xf2\x13\xbf\x34\x23\x3a\x12\x27\x3f\x0e\x35\x94\x72\xb3\x2d\x9b\xfb\xfd\x76\x8b\x02\xcb\xf2\xed\x66\x9f\x57\xf7\x58\xec\x73\xd9\x6c\xf3\xa2\xc2\x24\x9a\x0c\x45\xbf\xac\x35\x3f\x2f\xc6\xa3\x43\xdb\x01\x6a\x09\x67\x52\xc7\x8e\x3d\x38\xf2\x66\x74\x82\x42\xcc\x51\x7a\xb0\xc8\x5d\x7d\xa5\x8d\xc3\x73\x3a\x51\x67\xf4\xe4\xc2\xe6\x25\xcd\x91\x45\x0e\xd5\xfa\xe5\x0e\x9a\x33\x99\x49\x14\xc9\x93\x2d\x6c\x39\x84\x8d\x93\x49\xb2\xbd\x79\x4e\xad\x33\x6c\xa6\x14\xe7\x48\xbe\xf1\xfb\x13\xfe\x87\xb8\x2e\x8e\x96\xd3\xc9\xc5\x37\x2e\xd3\x85\xc5\x09\x80\xf2\x07\x74\xa2\x53\x8f\x61\x36\xb0\xf7\x04\x2b\x50\x2d\x78\xe2\xbb\xd0\x6a\x1d\xfb\xdd\xa0\xa7\xd0\x36\x50\x1e\x10\xc2\x0b\x1b\x40\x0d\xb3\xe6\xed\xa5\x72\x73\xbb\x04\xcd\xa5\x84\xb7\x55\x9e\x0e\xa2\x79\x49\xda\x30\x85\xf7\x1f\x58\x69\x55\x4f\xf1\x12\xf6\x97\x09\xfd\xbe\x69\x61\x23\xab\x29\xd4\x25\xa4\xc9\xf5\x32\x25\x05\x55\x3b\xd1\x14\xbb\x82\x8a\x0a\x4b\x59\x55\xad\xdc\x6e\x8b\x32\x7f\x2b\x37\xed\xae\xc9\xe5\x4d\xe9\x17\xa5\x7c\x7b\xbf\xa3\x92\x8a\x42\x16\xbb\xad\xd8\x6d\x30\x2f\xaa\xe2\x7e\xbf\xdf\xef\x37\x55\x23\xf6\x94\x20\xb3\x53\xcd\xc8\xd3\xba\xa7\x27\x76\x08\x9a\x38\x5e\xfa\x8b\x2c\x01\x38\x29\x2d\x6b\x78\xff\xf0\x30\x57\x26\x7c\x87\x8c\x34\x8d\x0e\xfb\xab\xce\xff\xde\x3f\x3c\xdc\xc1\xc7\xf0\x48\xd3\xf4\xff\x81\xb7\xe1\xff\x82\xd2\xc7\x43\xe0\x8a\x27\xae\xe1\x43\xa0\xc6\xf4\xef\x65\x3e\xbb\xde\xfb\x71\x97\xcd\x0a\x09\xc0\x80\x5a\xb5\xe4\xf9\x80\x23\x77\xc6\xd5\x80\x8d\x1c\x7b\x99\xfc\x17\x00\x00\xff\xff\xf3\xa6\x09\xb3\x09\x09\x00\x00"
-
-func squeezenetV11YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_squeezenetV11Yml,
-		"SqueezeNet-v1.1.yml",
-	)
-}
-
-func squeezenetV11Yml() (*asset, error) {
-	bytes, err := squeezenetV11YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "SqueezeNet-v1.1.yml", size: 2313, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vgg16Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x8f\xa3\x38\x13\xbe\xf3\x2b\x4a\xca\x65\x46\x6a\x0c\x04\x02\x09\x87\xf7\x30\x7d\x68\x8d\x34\x6f\x1f\x66\x47\x2d\xed\x8e\x46\x91\xb1\x0b\xe2\x6d\xb0\x91\x5d\x24\x9d\xcb\xfe\xf6\x95\x0d\x9d\xa4\x77\x7a\xb5\x93\x43\x84\x5d\x4f\x7d\x3d\xf5\x01\x9a\x0f\x58\xc3\xd3\xc3\x43\x56\xc2\x0a\xfc\x09\x4c\x0b\x67\x33\x59\x18\x8c\xc4\x3e\x6a\x2d\x1f\xf0\x64\xec\x73\x1d\x01\xcc\xe8\x6f\xa8\x9d\xb1\x5f\xbf\xc1\x0a\x2e\x52\x68\x8d\x05\x3a\xe0\xa2\x05\x70\x44\xeb\x94\xd1\x35\x94\x2c\x65\xd9\x1b\xe8\x22\x02\x61\x34\x59\xae\x34\x45\x17\x70\xc6\x52\x58\x5d\x00\x4a\xb7\xc6\x0e\x9c\xe6\x67\x70\x38\x70\x4d\x4a\x5c\xe4\xb3\x34\xf2\x76\xb8\xd2\x68\x6b\x58\xc1\xe5\xe0\x60\x72\x28\x81\x0c\x8c\x68\x3d\x72\x0e\x0d\x46\x8b\x52\x09\x6f\x33\x82\xeb\x6f\x05\xc3\xd4\x93\x1a\x7b\x84\xb1\xe7\xe4\xf1\x0e\x04\xd7\xd0\x20\xb8\x11\x85\x6a\x15\xca\x08\x80\x0f\xb2\x2c\xea\xa0\xd9\x8d\x53\x0d\x96\xab\xd1\x9a\x3f\x51\x50\x22\xb8\x1d\xfa\x98\x02\x39\x96\xea\x80\x8c\xc5\x38\x05\xb0\xf8\x15\x70\x17\xc0\xe3\x28\xca\xa2\xc7\xfa\x57\xf4\x16\xec\xa2\xf9\x9f\x31\xdd\xc2\x25\x3a\x61\xd5\x48\x81\xf7\xff\x45\x00\xdf\x5e\xab\x07\xca\x01\xd7\xa0\x86\xd1\x9a\x23\xca\x0b\xdd\xa6\x0d\x15\xce\xca\xb8\xe7\x67\x5c\x1a\x64\x66\xb9\x39\x07\xd1\xd3\xc3\x03\x10\xf2\xc1\x57\xcb\x9f\x3f\x7f\xf9\xed\xe9\xeb\x7d\xbc\x4e\xb3\x02\x84\x19\x46\x24\xe5\xfd\xb1\xc8\x62\x8b\x16\xb5\x40\xe7\x6b\x76\x3d\x85\x72\xf1\xd1\x57\x2f\x81\x13\x36\x4e\x11\xfa\x47\x24\xc1\x18\xcc\x21\x37\x4a\x77\x6f\x5a\x2d\x86\x03\xd1\xe8\xea\x24\xe9\x94\x23\xd6\x29\x3a\x4c\x0d\x13\x66\x48\x9e\x9d\x1a\x8c\x3e\x73\x9d\xac\xb3\x6c\x9b\xef\xb0\xaa\xd2\xb6\x6a\x36\xf9\x16\xd7\x72\xfb\x0f\xd5\x8b\xd6\xa7\xa7\x2f\xf7\x89\xe0\x6d\x8b\xc9\x49\x3d\xab\xe4\xff\xde\x4f\xfc\x87\x31\xab\xe0\xd1\xc5\x3e\xe5\xb8\x39\xc7\x74\xc0\xf8\xd8\x75\xb1\x4f\x39\x56\x3a\x56\xbd\x3b\x5a\x11\xd2\x7d\x63\x9b\xdb\x17\x75\x64\xc6\x76\x09\x6f\x5c\x92\x15\xe9\x8e\x65\x9b\x4d\x19\xad\xa0\x57\x02\xb5\xc3\x57\x6a\xe7\x8c\x96\xcb\x1a\xee\xef\xe1\xd3\xef\x50\xb0\x14\x3e\x08\x33\x0c\x68\x85\xe2\x81\xf0\x50\xa1\xbe\x37\x27\x94\x1f\xa3\x15\x28\x3d\x4e\x14\xb8\xbb\x1a\x99\xef\x7c\x13\xad\xa0\x55\xd6\xd1\x8c\x02\x3a\x8f\xf8\xd3\xb0\xc6\xe1\xba\x06\x35\xf0\x0e\xa3\x79\x1e\x6e\xfa\xe3\x35\xbc\x1b\x3b\x01\xf4\xa6\x85\x3c\x60\x76\x71\xb5\x32\x72\x3f\xf4\x84\x36\x94\x39\xb8\xbe\x5e\x2d\xe3\x27\xd5\x80\xda\xf7\x97\xab\xe1\x7b\x7e\x07\xeb\x75\x11\xfe\x7e\x2c\xf2\x01\xb9\xae\xe1\x7b\xb6\xce\x59\xb9\xbd\x83\x2c\x2b\x59\x55\xed\xee\x20\x4b\x73\xb6\xcb\x77\x3f\x22\x33\xd1\x38\xd1\x9c\xa9\x0f\x22\xb8\x59\x22\x9e\x65\x11\x2c\xf9\xb5\xc8\x69\xb2\x18\xa0\xfc\xbd\x0c\x67\xfc\x35\xc8\xe8\x9d\x24\x17\x4c\xcf\x9b\xc0\xdd\x4d\x8e\x0b\x73\xef\xe5\xb9\x78\x76\xfb\xc9\xf6\x75\x68\x8c\x3a\x49\x24\x27\xce\xe4\xd0\x0b\x36\xf4\xc9\xf0\xa2\x91\x92\xb9\xc3\x92\x40\xa1\x3f\xbb\xb3\x76\x48\x8c\x5e\xe8\xad\x19\x71\x40\xf1\xec\xa6\xa1\x86\x42\xae\xf3\xa2\xd9\x6c\xf3\x9c\x0b\x5e\x14\xbb\xf5\x36\x2d\x37\x3c\xdb\xa6\xb2\xc9\xd3\xac\xe4\x51\x30\xe9\xf9\x7f\xdd\x63\x6e\x99\xdc\xce\xf2\xf1\x00\x5c\x4b\x38\xa1\xea\x0e\xe4\xc0\xa2\x33\x93\x15\xe8\x63\x0e\xd2\xfd\xc8\xe9\x50\xbf\x37\x5f\x93\x43\xeb\x77\x2d\x6a\xfa\x85\x51\x4b\x2c\x3f\x25\x12\xe5\x2e\x2f\xf3\x46\xee\x72\x14\xa9\xa8\xaa\x34\xcb\x8b\xb6\xc0\x7c\x5b\xc9\x2d\xf7\xbf\x75\x91\x56\x02\x93\xa7\x87\x87\xfd\xbc\x3a\xf6\x59\xb9\x0f\xcb\xc6\xed\x25\x8e\xbd\x39\xb3\xd1\x1a\x32\x33\x1d\x4b\xd4\x37\x31\xd6\x49\x72\x3a\x9d\x98\x35\x8d\x21\xc7\xcc\x0b\xe3\x82\x4d\xcf\xc9\x5f\xc7\xae\x4b\x9c\x69\xe9\xc4\x2d\x26\x47\xb4\xe7\xbd\x44\x1c\x97\x19\x7f\xcf\x1b\x0b\xa2\xd7\xf1\x50\x6e\xcf\xad\x38\xa8\xa3\x6f\x21\xde\x3b\x84\x15\xa8\x16\x1c\xd2\x9d\xef\x88\x79\xd7\x35\xdc\xa1\xaf\x6e\x98\x4d\xf0\x0f\x64\xfc\x1a\x5d\x34\x6f\x5f\x36\x37\x6f\x1d\xaf\x79\x65\xfa\xb6\x18\xf3\x45\x30\x2f\x51\x1b\x42\xff\xfc\x2f\x56\x5a\xd5\x63\x78\x39\xbb\xd7\x46\xfe\xb9\xb6\x27\x45\x87\x65\x2d\x5f\x43\x9a\x5d\x5f\x9b\x49\x54\xe9\x66\x93\xb6\xdb\x75\x9a\xf3\x02\x39\xc7\x4d\x2e\x2b\x91\xef\xb0\xcd\x0b\xb1\x5b\xcb\x1b\xd6\x6f\x3a\xb0\xc8\xf2\x6c\xd3\xa4\x6d\x5b\xee\xf2\xb5\x6c\x5a\x89\xbb\xaa\xca\xb3\x06\x2b\xc1\xb7\x9b\x75\xc4\x89\xac\x6a\x26\x9a\xb7\x3d\xbe\x90\xe5\xa0\x91\xc2\xc7\xc0\x55\x16\x01\x3c\x2b\x2d\x6b\xb8\x7f\x7c\x5c\x98\xf1\x67\x9f\x91\xc6\xc9\xf2\xfe\xa2\xf3\xe1\xfe\xf1\xf1\x0e\xbe\xfa\x3f\xc6\xd8\x47\x3f\xde\xfe\x3b\x42\xe9\x6e\xef\x47\xca\x21\xd5\xf0\xd9\x4f\xd0\x23\x92\xdf\x64\xf3\xdd\xe5\x7b\x20\x6c\xbf\x45\x21\x02\x18\xb8\x56\x2d\x3a\xda\xf3\x89\x0e\xc6\xd6\xc0\x1b\x39\xf5\x32\x3a\x28\x29\xd1\xcf\xbc\x9d\x30\xfa\x3b\x00\x00\xff\xff\x10\xe6\xdb\x78\x28\x09\x00\x00"
-
-func vgg16YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vgg16Yml,
-		"VGG16.yml",
-	)
-}
-
-func vgg16Yml() (*asset, error) {
-	bytes, err := vgg16YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "VGG16.yml", size: 2344, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vgg16_sodYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4b\x6f\xdb\xb8\x13\xbf\xeb\x53\x0c\xe0\xc3\xbf\x05\x6c\x4a\xb2\x63\xd9\xd2\xe1\x7f\x68\x0a\x14\xbb\x68\x92\x22\xce\x16\x8b\x16\x85\x31\x22\x47\x16\x1b\x89\x14\x48\x2a\x4e\x73\xd8\xcf\xbe\x20\x25\x3f\xd2\xa6\xd8\x02\xbb\x3e\x18\x14\xe7\x37\xef\x17\x15\xb6\x54\xc0\xc7\x77\xef\xd2\x6c\xbb\xb9\x79\x0b\x13\xf0\x37\xa0\x2b\xf8\xa6\x7b\x03\xad\x16\xd4\x44\x95\xc1\x96\xf6\xda\xdc\x17\x11\xc0\xc0\x71\x47\xca\x6a\x73\x7b\x07\x13\x38\x52\xa1\xd2\x06\x5c\x4d\x23\x17\xc0\x03\x19\x2b\xb5\x2a\x20\x63\x09\x4b\x9f\x41\x47\x12\x70\xad\x9c\x41\xa9\x5c\x74\x04\xa7\x2c\x81\xc9\x11\x20\x55\xa5\x4d\x8b\x6e\x38\x83\xa5\x16\x95\x93\xfc\x48\x1f\xa8\x91\x97\x83\x52\x91\x29\x60\x02\xc7\x0f\x0b\xbd\x25\x01\x4e\x43\x47\xc6\x23\x07\xd3\xa0\x33\x24\x24\xf7\x32\x23\x38\xfd\x26\xd0\xf6\x8d\x93\x5d\x43\xd0\x35\xe8\x3c\xde\x02\x47\x05\x25\x81\xed\x88\xcb\x4a\x92\x88\x00\xb0\x15\xd9\x45\x11\x38\x77\x5d\x5f\x80\x41\xd9\x19\xfd\x95\xb8\x8b\x39\x9a\xb6\x99\xb9\x10\x1c\xe3\x8a\x80\x9c\xf1\xae\x0f\x60\xfe\x2b\xe0\x5d\x00\x77\x1d\xcf\x2e\x1a\x2a\x7e\x85\x6f\xc4\x8e\x9c\xff\x68\xd3\x39\x5c\x90\xe5\x46\x76\x2e\xc4\xfd\xff\x11\xc0\x5d\x4d\x50\xe9\xa6\xd1\x7b\xa9\x76\x63\xb0\xd0\x10\x54\x52\x91\xeb\x15\x09\xd0\x2a\xa4\x78\x83\x8d\x24\xe5\xe0\xa6\xf4\x3a\x60\xd3\x97\xd2\xc9\x27\xcf\x24\xd0\xa1\x25\x07\xaf\xfe\x5a\x26\x49\x02\xb2\xc5\x1d\xd9\xd7\xb0\x97\xae\x86\x52\xf7\x4a\x78\x50\xa9\x1f\x01\x95\xd2\x2e\x24\xd6\xb2\x08\xe0\xf2\xfa\x7a\x50\x68\x8f\x75\x74\xb2\xe4\xf2\xe3\x87\xdb\xff\xa5\x19\x74\xd8\x91\x99\xc1\x1f\x8a\x6b\x65\x43\xe1\x90\xf8\xde\x96\xb7\xe4\x28\xe4\x16\x1e\x24\xc2\x07\xa3\x3b\x6d\xb1\xf1\x26\x7a\xb3\x6e\x3a\x27\x5b\xf9\x84\x63\xf2\x7f\x67\xf0\xa9\x46\xb5\x9b\xc2\x86\xc1\x86\x37\x68\x74\x55\x4d\xe1\x13\x83\xf7\x52\x4d\xe1\x4f\x06\x9b\x9a\xd4\x14\xde\x30\xf8\x60\x24\x27\x40\x25\xe0\x96\xc1\x15\xf1\x9a\x05\xb3\xa6\x30\x4f\xd2\x8c\x45\x86\x2a\x32\xa4\x38\x59\x5f\x82\xa7\xaf\x50\x7d\xde\x6c\x0b\x31\xec\xa9\xb4\xd2\x91\x3f\x92\xe3\x8c\xc1\x90\x81\xd2\xfb\x78\xde\x39\x33\xa8\x9d\xeb\x6c\x11\xc7\x3b\x69\x1d\xdb\x49\x57\xf7\x25\xe3\xba\x8d\xbf\xca\xb6\x95\xb4\x58\xc4\xcb\x24\x4f\xd3\xb4\x5a\x63\x92\x60\x4e\x9c\xe6\x7c\x21\x96\x42\x50\x86\xab\x65\x92\xce\xf3\xef\xa4\xfc\x28\x60\x73\xf3\xf6\x67\x98\x37\x1f\xdf\x5f\xc6\x1c\xab\x8a\xe2\xbd\xbc\x97\xf1\x95\x37\x6b\xf6\x49\xeb\x23\x43\x11\xc7\xdc\xce\x3a\xd2\x5d\x43\xac\xec\x19\x89\x3e\xfe\xda\x3e\xf9\x50\xc6\x56\x0b\x56\xbb\xf6\xb9\x1f\x68\x1e\xe5\x03\xd3\x66\x17\x63\x69\xe3\xf4\x22\xc9\x59\xba\x5c\x66\xd1\x04\x1a\xc9\x49\xd9\x30\x73\x4e\x21\x18\x2f\x0b\xb8\xfa\xed\x2e\x9a\x80\x54\x5d\xef\x42\x28\x4f\x90\xe1\xce\xb7\xc8\x04\x2a\x69\xac\x1b\x50\xe0\xbe\x75\xf4\xc3\x28\x9a\x85\xeb\x62\x28\xc7\x68\xe8\xf6\xb3\xea\x3f\x28\x3f\x93\x13\x40\xcf\x1a\xc4\x03\x06\x15\x27\x29\x1d\xfa\x91\xe6\xc8\x84\xac\x07\xd5\xa7\xab\x71\xb8\x08\xd9\x92\xf2\xc3\xca\x16\xf0\x79\x31\x85\xf9\xfc\x22\xfc\x7d\x19\xe9\x2d\xa1\x2a\xe0\x73\x3a\x5f\xb0\x6c\x3d\x85\x34\xcd\xd8\x6a\x95\x4f\x21\x4d\x16\x2c\x5f\xe4\x5f\x22\xdd\xbb\xae\x77\x83\xa7\xde\x88\xa0\x66\xb4\x78\xa0\x45\x30\xfa\x57\x11\xba\xde\x50\x80\xe2\x4b\x1e\x0e\xf8\x93\x91\xd1\x0b\x4e\x8e\x98\x06\xcb\x10\xbb\x33\x1f\xc7\xc8\xbd\xe4\xe7\xa8\xd9\x6e\x7b\xd3\x14\x87\x1a\xb1\x0b\x86\x2d\x3e\x69\x85\x7b\x1b\x2a\xcb\x3a\x6d\x88\x85\x91\x14\x8a\x61\xe8\xf8\xb1\xd6\x1e\x76\xbb\x34\xdb\x5a\x2d\x62\x4e\xca\x91\x49\x93\x84\xb9\x47\xf7\x5c\x3c\xaf\x89\xdf\xdb\xbe\x2d\x20\x23\x5c\x89\xb4\x14\x39\xce\x2f\x4a\x5c\x94\x5c\x50\xc6\x93\x6c\x25\x4a\x91\xa5\xeb\x65\x15\x05\xe1\x3e\x2f\x87\xe9\x6d\xc7\x91\xb6\x33\xd8\xd5\xa1\x95\xf7\x24\x77\xb5\xb3\x60\xc8\xea\xde\x70\xf2\xbe\x04\xea\xb6\x43\x57\xff\x6b\x3f\x04\x75\x8d\xfe\xc6\x3a\xa3\x9d\x1e\x3c\x19\x15\xfe\x37\xe2\x8f\x7b\x7b\x7b\x18\xd0\x2c\x40\x0e\x55\x2f\xed\x16\x0d\xaf\xe5\x83\xaf\x0c\x6c\x2c\xc1\x04\x64\x05\x96\xdc\xd4\x27\x7a\x18\xe5\x25\x5a\xf2\x49\x03\x69\x01\xc1\x1f\x9c\x06\x54\x30\x72\x9e\x6f\xc8\xb3\x55\xe9\x39\x4f\x81\x3a\x8f\xe5\x70\x11\xc4\x0b\x52\xda\x91\x3f\xff\x44\x4a\x25\x1b\x0a\x2f\x0a\x7b\xa8\xcf\x1f\x53\xe3\x37\x87\x1c\x4c\x3d\x99\x34\xa8\x3e\xd5\x02\x21\xcf\xc4\x3c\x5f\xad\x79\x55\x65\xcb\x45\x9a\xf3\x15\x55\x8b\x0b\xc4\x75\x2e\x56\x59\xc2\xcf\x22\x7f\x62\xca\x71\x25\x38\x65\x15\xcf\xd7\x09\x9f\xe7\x22\xcf\xe6\xd5\x8a\xcf\x57\xcb\x75\x39\x5f\x65\xa9\x88\xd0\x39\x23\xcb\xde\x0d\x33\x9d\x1e\x9d\x41\x50\xe4\xc2\x0b\xe6\x44\x8b\x00\xee\xa5\x12\x45\x58\x61\x43\x64\xfc\xb7\xf7\x48\x51\x6f\xb0\x39\xf2\xbc\xba\xbc\xbe\x9e\xc2\xad\xff\x63\x8c\xbd\xf6\x5d\xeb\x77\x98\x54\xbb\xed\xb8\x36\x8b\xb0\x82\xaf\x36\x37\xc7\x3d\x3a\x39\x9e\x0e\x6f\x99\x30\xdb\x46\xbe\x08\xa0\x45\x25\x2b\xb2\x6e\x8b\xbd\xab\xb5\x29\x00\x4b\xd1\x37\x22\xaa\xa5\x10\xe4\x3b\xda\xf4\x14\xfd\x1d\x00\x00\xff\xff\x90\xe3\x0f\xa8\xe8\x09\x00\x00"
-
-func vgg16_sodYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vgg16_sodYml,
-		"VGG16_SOD.yml",
-	)
-}
-
-func vgg16_sodYml() (*asset, error) {
-	bytes, err := vgg16_sodYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "VGG16_SOD.yml", size: 2536, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vgg16_sosYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4b\x8f\xdb\x36\x10\xbe\xeb\x57\x0c\x62\x14\x4d\x80\xb5\x5e\xb6\xe5\xb5\x0e\x3d\xc4\x05\x72\x69\x77\x8b\x38\x08\xd0\x06\x81\x31\xa2\x86\x12\x77\x25\x92\x20\xa9\x75\x92\x5f\x5f\x90\x92\x2d\x6f\x93\xa0\x01\xda\x3d\x18\xd4\xcc\x37\xef\xd7\x4a\xec\xa9\x84\xf7\x6f\xde\x64\xc5\xf1\x70\x7f\x80\x05\x78\x0a\x28\x0e\x9f\xd5\x60\xa0\x57\x35\x75\x11\x37\xd8\xd3\x49\x99\xc7\x32\x02\x18\x25\xde\x91\xb4\xca\xbc\x7d\x07\x0b\xb8\x70\x81\x2b\x03\xae\xa5\x49\x0a\xe0\x89\x8c\x15\x4a\x96\x50\xc4\x69\x9c\x3d\x83\x4e\x2c\x60\x4a\x3a\x83\x42\xba\xe8\x02\xce\xe2\x14\x16\x17\x80\x90\x5c\x99\x1e\xdd\xf8\x06\x4b\x3d\x4a\x27\xd8\x85\x3f\x72\x23\xaf\x07\x85\x24\x53\xc2\x02\x2e\x1f\x16\x06\x4b\x35\x38\x05\x9a\x8c\x47\x8e\xae\x81\x36\x54\x0b\xe6\x75\x46\x30\xff\x2d\xa0\x1f\x3a\x27\x74\x47\xa0\x3b\x74\x1e\x6f\x81\xa1\x84\x8a\xc0\x6a\x62\x82\x0b\xaa\x23\x00\xec\xeb\x62\x5d\x06\xc9\x46\x0f\x25\x18\x14\xda\xa8\x07\x62\x2e\x61\x68\xfa\x6e\xe9\x42\x72\x8c\x2b\x03\x72\xc9\xf4\x10\xc0\xec\x47\xc0\x4d\x00\x6b\xcd\x8a\x75\x47\xe5\x8f\xc8\x4d\xd8\x49\xf2\x5f\x7d\xba\x86\xd7\x64\x99\x11\xda\x85\xbc\xff\x12\xc1\xd8\x08\xc0\x85\x24\x37\x48\xaa\x41\xc9\x50\xd0\x03\x76\x82\xa4\x83\xfb\xca\x6b\x84\xc3\x50\x09\x27\xbe\x08\xd9\xc0\xcb\xc3\xfd\xe1\x15\xd4\xe8\xd0\x92\xbb\x81\x53\x2b\x58\x0b\xc2\xc2\xa8\xb9\xa2\xda\x17\xcd\xab\xd8\xbf\xff\xe3\xed\xcf\xd9\x06\x34\x6a\x5f\xa4\x17\xdf\x55\xf9\x22\x32\xc4\xc9\x90\x64\x64\x7d\x31\xe7\xaf\x50\x47\x2f\x6e\x21\x81\x13\x55\x56\x38\xf2\x4f\x72\x2c\x8e\xcf\x16\xbd\x53\xd7\x3d\xb8\x84\xd6\x39\x6d\xcb\x24\x69\x84\x75\x71\x23\x5c\x3b\x54\x31\x53\x7d\xf2\x20\xfa\x5e\xd0\x6a\x95\xe4\x5b\x96\xb1\x14\xb7\xdb\x55\x51\x61\x51\xb0\x7c\xb5\xdb\x5c\x24\xcb\x24\x61\x76\xa9\x49\xe9\x8e\xe2\x6a\x88\xa9\x1e\x92\x87\xfe\x4b\x8b\xb2\x49\xac\xb2\x71\xeb\xfa\x7f\x9a\xb9\x58\x78\xfd\xfe\xb7\x7d\xc2\x90\x73\x4a\x4e\xe2\x51\x24\xbf\x7b\x9f\x96\x7f\x29\xf5\x4c\x00\xcd\x27\xf1\x14\x2b\xd3\x24\x58\xd9\x24\x5b\xa7\xbb\x38\xdb\x6c\x8a\x68\x01\x9d\x60\x24\x6d\x98\xc6\x39\xa4\x89\x58\xc2\x7e\x0f\xaf\xff\x5c\xde\xed\x61\x1d\xa7\xd1\x02\x84\xd4\x83\x0b\x39\x9a\xb1\x23\xcd\x77\xd1\x02\xb8\x30\xd6\x8d\x28\x70\x9f\x35\x7d\x35\xad\xcb\x40\x2e\x41\xf4\xd8\x50\x34\x0e\xc4\x55\x83\x9c\xbd\xb8\xd2\x13\x40\xcf\x7a\xc8\x03\x46\x13\xb3\x16\x8d\x7e\xea\x1d\x99\x50\xce\x60\x7a\x26\x4d\xf3\x57\x8b\x9e\xa4\x9f\x67\x5b\xc2\x87\xd5\x0d\xe4\xf9\x3a\xfc\x7c\x9c\xf8\x3d\xa1\x2c\xe1\x43\x96\xaf\xe2\xe2\xf6\x06\xb2\xac\x88\xb7\xdb\xdd\x0d\x64\xe9\x2a\xde\xad\x76\x1f\x23\x35\x38\x3d\xb8\x31\x52\xef\x44\x30\x33\x79\x3c\xf2\x22\x98\xe2\xe3\x84\x6e\x30\x14\xa0\xf8\xad\x08\x47\xfc\xec\x64\xf4\x8d\x20\x27\x4c\x87\x55\xc8\xdd\x55\x8c\x53\xe6\xbe\x15\xe7\x64\xd9\x1e\x07\xd3\x95\xe7\xee\xb2\xab\x18\x7b\xfc\xa2\x24\x9e\x6c\xe8\x1a\xeb\x94\xa1\x38\x4c\x6d\xe8\x8a\x50\x1f\x3b\xf5\xd1\x53\xd3\x64\xc5\xd1\x2a\x9b\xfc\x94\xa7\x76\x9c\xa1\xa3\x0a\x33\x74\xb4\x97\x19\x8a\xdd\x27\xf7\xdc\x22\x6b\x89\x3d\xda\xa1\x2f\xa1\xc6\x5b\x2a\xb0\xba\xcd\x37\xb8\x5e\xe3\x2e\x4d\x79\x56\x6c\xd6\x3c\xdd\xf1\x4d\x56\x6f\x33\x1e\x05\x7b\xbe\x54\xe7\x9d\x67\xa7\xad\xd9\x18\xd4\x2d\xa0\xac\xe1\x44\xa2\x69\x9d\x05\x43\x56\x0d\x86\x91\x0f\x2f\x70\x8f\x1a\x5d\xfb\x9f\x43\xab\x49\x77\xea\x73\xac\x8d\x72\x6a\x8c\x64\x32\xf8\xff\xa8\x9f\xae\x1d\x76\xf7\xd5\xc3\xc1\x8f\xaa\xe7\x9f\xa7\x40\xd8\x23\x1a\xd6\x8a\x27\xdf\x29\xd8\x59\x82\x05\x08\x0e\x61\xbb\xb9\x96\xc6\x65\x56\xa1\x25\x5f\x44\xbf\xe9\x10\xfc\xc3\x29\x40\x09\x93\xe4\xf5\x51\xb9\xba\x2e\x5e\x72\xce\xd2\x75\x22\x47\x42\x50\x5f\x93\x54\x8e\xfc\xfb\x3b\x5a\xb8\xe8\x28\x1c\x61\x7b\xee\xd7\xaf\xeb\x72\x12\xae\x9d\xf6\xee\xec\xd2\x68\x7a\x6e\x04\xaa\x6e\xf3\x94\x76\xb4\xae\x0b\xbe\xda\xe0\xae\xc8\x8a\xa2\x40\xc6\x53\x9e\xf3\x6c\x83\xf9\x55\xda\x67\x21\x8e\xdb\xdb\xdb\x02\x73\xce\x78\x9e\x55\x29\x5b\xef\x38\xe5\x3c\xaf\xf2\x94\xd3\xa6\x58\xaf\x22\x74\xce\x88\x6a\x70\xe3\xf2\xa6\x4f\xce\x20\x48\x72\xe1\xe8\xcf\xbc\x08\xe0\x51\xc8\xba\x84\xfd\xdd\xdd\x94\x19\xff\xed\x23\x92\x34\x18\xec\x2e\x32\x2f\xf7\x77\x77\x37\xf0\xd6\xff\xc4\x71\xfc\xca\x4f\xb1\xff\x7f\x41\xc8\xe6\x38\x5d\x9d\x12\xde\xf9\x13\x75\x7f\x80\x5f\x47\x82\xdf\x5b\xd3\xeb\x7c\xfe\xc3\xae\x9b\xe4\x22\x80\x1e\xa5\xe0\x64\xdd\x11\x07\xd7\x2a\x53\x02\x56\xf5\xd0\xd5\x51\x2b\xea\x9a\xfc\x84\x9b\x81\xa2\xbf\x03\x00\x00\xff\xff\xba\x1f\x9d\xad\x1b\x09\x00\x00"
-
-func vgg16_sosYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vgg16_sosYml,
-		"VGG16_SOS.yml",
-	)
-}
-
-func vgg16_sosYml() (*asset, error) {
-	bytes, err := vgg16_sosYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "VGG16_SOS.yml", size: 2331, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vgg19Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x8f\xa3\x38\x13\xbe\xf3\x2b\x4a\xca\x65\x46\x6a\x0c\x24\x40\x02\x87\xf7\x30\x7d\x68\x8d\x34\x6f\x1f\x66\x47\x2d\xed\x8e\x46\x91\xb1\x0b\xe2\x6d\xb0\x91\x5d\x24\x9d\xcb\xfe\xf6\x95\x0d\x9d\xa4\x77\x7a\xb5\x93\x43\x84\x5d\x4f\x7d\x3d\xf5\x01\x9a\x0f\x58\xc3\xd3\xc3\x43\x56\xc1\x0a\xfc\x09\x4c\x0b\x67\x33\x59\x18\x8c\xc4\x3e\x6a\x2d\x1f\xf0\x64\xec\x73\x1d\x01\xcc\xe8\x6f\xa8\x9d\xb1\x5f\xbf\xc1\x0a\x2e\x52\x68\x8d\x05\x3a\xe0\xa2\x05\x70\x44\xeb\x94\xd1\x35\x94\x2c\x65\xd9\x1b\xe8\x22\x02\x61\x34\x59\xae\x34\x45\x17\x70\xc6\x52\x58\x5d\x00\x4a\xb7\xc6\x0e\x9c\xe6\x67\x70\x38\x70\x4d\x4a\x5c\xe4\xb3\x34\xf2\x76\xb8\xd2\x68\x6b\x58\xc1\xe5\xe0\x60\x72\x28\x81\x0c\x8c\x68\x3d\x72\x0e\x0d\x46\x8b\x52\x09\x6f\x33\x82\xeb\x6f\x05\xc3\xd4\x93\x1a\x7b\x84\xb1\xe7\xe4\xf1\x0e\x04\xd7\xd0\x20\xb8\x11\x85\x6a\x15\xca\x08\x80\x0f\xb2\xcc\xeb\xa0\xd9\x8d\x53\x0d\x96\xab\xd1\x9a\x3f\x51\x50\x22\xb8\x1d\xfa\x98\x02\x39\x96\xea\x80\x8c\xc5\x38\x05\xb0\xf8\x15\x70\x17\xc0\xe3\x28\xca\xbc\xc7\xfa\x57\xf4\x16\xec\xa2\xf9\x9f\x31\xdd\xc2\x25\x3a\x61\xd5\x48\x81\xf7\xff\x45\x00\xdf\x5e\xab\x07\xca\x01\xd7\xa0\x86\xd1\x9a\x23\xca\x0b\xdd\xa6\x0d\x15\xce\xaa\xb8\xe7\x67\x5c\x1a\x64\x66\xb9\x39\x07\xd1\xd3\xc3\x03\x10\xf2\xc1\x57\xcb\x9f\x3f\x7f\xf9\xed\xe9\xeb\x7d\xbc\x4e\xb3\x1c\x84\x19\x46\x24\xe5\xfd\xb1\xc8\x62\x8b\x16\xb5\x40\xe7\x6b\x76\x3d\x85\x72\xf1\xd1\x57\x2f\x81\x13\x36\x4e\x11\xfa\x47\x24\xc1\x18\xcc\x21\x37\x4a\x77\x6f\x5a\x2d\x86\x03\xd1\xe8\xea\x24\xe9\x94\x23\xd6\x29\x3a\x4c\x0d\x13\x66\x48\x9e\x9d\x1a\x8c\x3e\x73\x9d\x6c\xb6\xbb\x22\x2b\xd7\x6d\x55\x08\xb9\x96\x45\x8b\xb8\xdd\xfe\x43\xf5\xa2\xf5\xe9\xe9\xcb\x7d\x22\x78\xdb\x62\x72\x52\xcf\x2a\xf9\xbf\xf7\x13\xff\x61\xcc\x2a\x78\x74\xb1\x4f\x39\x6e\xce\x31\x1d\x30\x3e\x76\x5d\xec\x53\x8e\x95\x8e\x55\xef\x8e\x56\x84\x74\xdf\xd8\xe6\xf6\x45\x1d\x99\xb1\x5d\xc2\x1b\x97\x64\x79\x5a\xb1\xac\x28\xca\x68\x05\xbd\x12\xa8\x1d\xbe\x52\x3b\x67\xb4\x5c\xd6\x70\x7f\x0f\x9f\x7e\x87\x9c\xa5\xf0\x41\x98\x61\x40\x2b\x14\x0f\x84\x87\x0a\xf5\xbd\x39\xa1\xfc\x18\xad\x40\xe9\x71\xa2\xc0\xdd\xd5\xc8\x7c\xe7\x9b\x68\x05\xad\xb2\x8e\x66\x14\xd0\x79\xc4\x9f\x86\x35\x0e\xd7\x35\xa8\x81\x77\x18\xcd\xf3\x70\xd3\x1f\xaf\xe1\xdd\xd8\x09\xa0\x37\x2d\xe4\x01\xb3\x8b\xab\x95\x91\xfb\xa1\x27\xb4\xa1\xcc\xc1\xf5\xf5\x6a\x19\x3f\xa9\x06\xd4\xbe\xbf\x5c\x0d\xdf\x37\x77\xb0\x5e\xe7\xe1\xef\xc7\x22\x1f\x90\xeb\x1a\xbe\x67\xeb\x0d\x2b\x77\x77\x90\x65\x25\xdb\x6e\xab\x3b\xc8\xd2\x0d\xab\x36\xd5\x8f\xc8\x4c\x34\x4e\x34\x67\xea\x83\x08\x6e\x96\x88\x67\x59\x04\x4b\x7e\x2d\x72\x9a\x2c\x06\x28\x7f\x2f\xc3\x19\x7f\x0d\x32\x7a\x27\xc9\x05\xd3\xf3\x26\x70\x77\x93\xe3\xc2\xdc\x7b\x79\x2e\x9e\xdd\x7e\xb2\x7d\x1d\x1a\xa3\x4e\x12\xc9\x89\x33\x39\xf4\x82\x0d\x7d\x32\xbc\x68\xa4\x64\xee\xb0\x24\x50\xe8\xcf\xee\xac\x1d\x12\xa3\x17\x7a\x6b\x46\x1c\x50\x3c\xbb\x69\xa8\x21\x97\xeb\x4d\xde\x14\xbb\xcd\x86\x0b\x9e\xe7\xd5\x7a\x97\x96\x05\xcf\x76\xa9\x6c\x36\x69\x56\xf2\x28\x98\xf4\xfc\xbf\xee\x31\xb7\x4c\x6e\x67\xf9\x78\x00\xae\x25\x9c\x50\x75\x07\x72\x60\xd1\x99\xc9\x0a\xf4\x31\x07\xe9\x7e\xe4\x74\xa8\xdf\x9b\xaf\xc9\xa1\xf5\xbb\x16\x35\xfd\xc2\xa8\x25\x96\x9f\x92\x36\xdf\x20\x62\x2b\x76\x65\x25\xcb\xbc\x6c\xf2\xbc\xe2\xbc\x14\x58\x96\xed\x6e\xdb\xb4\xd5\x6e\xcb\x33\x51\x35\x45\xf2\xf4\xf0\xb0\x9f\x57\xc7\x3e\xab\xf6\x61\xd9\xb8\xbd\xc4\xb1\x37\x67\x36\x5a\x43\x66\xa6\x63\x89\xfa\x26\xc6\x3a\x49\x4e\xa7\x13\xb3\xa6\x31\xe4\x98\x79\x61\x5c\xb0\xe9\x39\xf9\xeb\xd8\x75\x89\x33\x2d\x9d\xb8\xc5\xe4\x88\xf6\xbc\x97\x88\xe3\x32\xe3\xef\x79\x63\x41\xf4\x3a\x1e\xca\xed\xb9\x15\x07\x75\xf4\x2d\xc4\x7b\x87\xb0\x02\xd5\x82\x43\xba\xf3\x1d\x31\xef\xba\x86\x3b\xf4\xd5\x0d\xb3\x09\xfe\x81\x8c\x5f\xa3\x8b\xe6\xed\xcb\xe6\xe6\xad\xe3\x35\xaf\x4c\xdf\x16\x63\xbe\x08\xe6\x25\x6a\x43\xe8\x9f\xff\xc5\x4a\xab\x7a\x0c\x2f\x67\xf7\xda\xc8\x3f\xd7\xf6\xa4\xe8\xb0\xac\xe5\x6b\x48\xb3\xeb\x6b\x33\x09\xd1\x34\x52\xf2\xa2\x5a\x67\xe9\x3a\xdd\x35\xb8\xa9\xda\x5d\xb5\xcd\xdb\xa2\x29\xb6\x65\x81\x37\xac\x5f\x95\x9a\x42\x94\x79\xde\x20\x6f\xe4\x56\xb4\x69\xd9\x48\x59\xa5\x65\x21\x5a\x59\x6e\x73\x51\x6d\x23\x4e\x64\x55\x33\xd1\xbc\xed\xf1\x85\x2c\x07\x8d\x14\x3e\x06\xae\xb2\x08\xe0\x59\x69\x59\xc3\xfd\xe3\xe3\xc2\x8c\x3f\xfb\x8c\x34\x4e\x96\xf7\x17\x9d\x0f\xf7\x8f\x8f\x77\xf0\xd5\xff\x31\xc6\x3e\xfa\xf1\xf6\xdf\x11\x4a\x77\x7b\x3f\x52\x0e\xa9\x86\xcf\x7e\x82\x1e\x91\xfc\x26\x9b\xef\x2e\xdf\x03\x61\xfb\x2d\x0a\x11\xc0\xc0\xb5\x6a\xd1\xd1\x9e\x4f\x74\x30\xb6\x06\xde\xc8\xa9\xf7\x2f\xfb\x83\x92\x12\xfd\xd4\xdb\x09\xa3\xbf\x03\x00\x00\xff\xff\xbb\x6a\x91\xb4\x2a\x09\x00\x00"
-
-func vgg19YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vgg19Yml,
-		"VGG19.yml",
-	)
-}
-
-func vgg19Yml() (*asset, error) {
-	bytes, err := vgg19YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "VGG19.yml", size: 2346, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wrn502Yml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\xcb\x8e\xe3\xba\xd1\xde\xeb\x29\x0a\xf0\x66\x0e\x60\x4b\xbe\xb5\xdb\xad\xc5\xbf\xf8\x27\x8b\x04\x39\xf1\xa2\x33\xc8\x00\x39\x08\x8c\x12\x59\xb2\x18\x53\xa4\x40\x96\xec\xf1\x3c\x7d\x50\x94\xd4\x76\x63\x7a\x90\x59\xa4\x17\x6e\x91\xfc\xea\xca\xaf\xaa\xe8\xb0\xa5\x12\xbe\xbe\x1e\x9e\x96\x30\x03\x59\x81\xaf\xe1\xe6\xfb\x00\xad\xd7\x64\xb3\x3a\x60\x4b\x57\x1f\xce\x65\x06\x30\xa0\xbf\x90\x8b\x3e\xbc\x7e\x81\x19\xbc\x9d\x42\xed\x03\x70\x43\xa3\x14\xc0\x85\x42\x34\xde\x95\xb0\xcb\x97\xf9\xea\x1d\x74\x3c\x02\xe5\x1d\x07\x34\x8e\xb3\x37\xf0\x3a\x17\x37\x26\x80\x71\xb5\x0f\x2d\xf2\xf0\x0d\x91\x5a\x74\x6c\xd4\xdb\xf9\x70\x9a\x89\x1e\x34\x8e\x42\x09\x33\x78\x5b\x44\xe8\x23\x69\x60\x0f\x1d\x05\x41\x0e\xae\x41\x17\x48\x1b\x25\x3a\x33\xb8\xff\xcd\xa0\xed\x2d\x9b\xce\x12\x74\x16\x59\xf0\x11\x14\x3a\xa8\x08\x62\x47\xca\xd4\x86\x74\x06\x80\xad\xde\x6d\xcb\x24\x79\xea\xfa\x12\x02\x9a\x2e\xf8\x7f\x93\xe2\x42\x61\x68\xed\x82\x53\x72\x02\x97\x09\xb9\x50\x5d\x9f\xc0\xea\x57\xc0\xa7\x04\xee\x3a\xb5\xdb\x5a\x2a\x7f\x45\x6e\xc4\x8e\x92\xff\xd5\xa7\x47\xb8\xa6\xa8\x82\xe9\x38\xe5\xfd\xff\x32\x80\x2f\x8d\x89\x63\x8e\xae\x38\x66\x4f\x6e\x95\xbe\x75\x14\x4c\x4b\x8e\x23\x5c\x0d\x37\xf0\xd5\x68\x82\x57\x8a\x46\xf7\x68\xe1\x40\x2c\xb7\x1a\xe1\xd3\xff\xff\xed\x1f\x9f\x61\xbd\x5c\xed\x7e\x83\x86\xb9\x2b\x8b\x02\xc3\x37\x73\xc9\x7d\x38\x15\x58\xc5\x62\xb5\x5b\x3e\xe5\xcb\xe7\xd5\x76\x07\xd5\x0d\xfe\x4e\xe1\x44\x37\xf8\x27\x9e\x7c\xe8\x6f\x67\x0f\xe8\x34\x1c\xcc\xd9\x47\xf8\xab\x6f\xbd\xc6\xb3\x89\x79\x06\xf0\x27\xa2\x0e\xc2\x64\xcd\x4d\xd6\xae\x14\x08\x62\xe3\xaf\x4e\xae\xb8\x22\xc0\xca\x92\x7c\x46\x85\x96\xa0\xef\xe4\x9b\x1b\xdf\x47\x74\x3a\x0a\xa9\x2d\xde\x84\x15\x62\x26\xb2\xb1\x16\x1a\xbc\x10\x98\xb6\x0b\xfe\x62\xdc\x69\xa2\x09\x3a\x45\x39\xfc\xd9\x5f\xe9\x42\x61\x0e\x84\xaa\x11\xee\x26\xc6\x88\x1a\x14\xa0\x22\xc7\x19\xc8\x72\x90\x27\x0d\xa8\x54\x1f\x50\xdd\x40\xf9\xc8\x11\x1c\x61\xb0\x37\xd0\xbe\xaf\xac\x68\x97\xda\x70\x7d\x5b\x51\xb8\xfb\x32\x1f\x9c\xf1\x90\xca\x40\x50\x17\x0a\x37\xd0\x1f\x47\xdc\x60\x14\xe3\xc1\x57\x96\x5a\x51\xa2\x4d\x6b\x9c\x89\x8d\x08\xd6\x84\xdc\x07\x82\x40\x7d\xa4\x79\x06\x70\x6d\x8c\x6a\xa0\xc5\x33\x45\xb1\x1d\xe9\xae\x28\x19\x89\xd6\x5f\x53\x8a\xc4\x74\x0e\x5f\x3c\x30\xaa\xb3\xa4\x30\x81\x47\x33\x71\x2e\x85\xc7\xc2\x8c\x54\xba\x57\x92\x02\xd3\xbd\x62\x40\xd0\xc4\x68\x2c\xe9\x07\x86\xa0\x85\xc8\xbd\xbe\x81\x77\x29\x62\x0c\xaa\x31\x4c\x2a\xf9\xe6\x6b\x61\xcd\x81\x18\x2a\xeb\xd5\x39\x8a\x9b\x15\x0a\xcd\xbc\x1b\xfd\xbd\x26\xcb\x9d\x8f\x04\x08\xce\x5f\xc8\xbe\x57\x71\x6d\xe4\xda\xaf\x04\x9a\x54\x20\x8c\xf2\xd1\x71\x93\xf2\x68\xdc\xb8\x75\x35\x9a\x1b\xb1\xf6\x43\x0e\x73\xf8\x4a\xa0\xd0\xda\xe4\x5c\xa0\x28\x35\xef\x4e\xd3\x39\x44\x0e\x7d\x32\x24\x4c\xd7\xf4\xc1\x25\x7c\xfa\xfa\x7a\x88\xbf\x49\x23\x90\x9b\x6b\x24\x87\x0d\xf2\x98\x34\x0c\x04\x35\x06\x88\xbd\xe4\xc3\x07\xf0\x17\x4a\x5d\xd1\x04\x50\xbe\x6d\xbd\xb3\xb7\xb1\x2b\x35\xc6\x25\x15\xf7\x0b\x57\xbe\x77\x4c\xa1\xc3\xc0\x31\x0b\x54\x53\x20\xa7\x28\x4a\x4f\xbb\xaf\x52\x3b\xc3\x4e\x78\x5c\xc0\x95\xaa\x68\x98\xe4\x93\x58\xe5\x39\x0c\x25\x5d\x4d\x74\x9b\x5a\xf1\x22\x95\x63\x2c\x8b\xe2\x64\xb8\xe9\xab\x5c\xf9\xb6\x88\x9e\xf0\x42\xa1\x98\x7a\xc3\x22\xa1\x0b\x0e\x44\x45\x8b\x91\x29\x14\xca\xc6\x77\xd2\x3f\xab\xe6\x9f\x9a\xf8\x3e\xd5\x76\x21\xd9\x5c\x4c\xd9\x5c\x4c\xd9\xfc\x05\xc1\xba\x77\xa9\xf2\xd0\x2e\xbe\x7b\x5f\x54\xd6\x57\x93\x7b\xa6\xc5\x13\x39\xe2\xc5\x05\xad\xd1\x69\x4a\xe4\xdd\x6d\xf6\xfb\xee\x65\xf1\xfb\x7e\x9f\xcd\xc0\x1a\x45\x2e\x26\xde\xdd\xd3\x31\x6e\x96\xd0\xbb\x40\x91\x83\x51\x4c\x3a\x9b\x81\x71\x5d\xcf\x71\x68\x1a\x13\x76\xd8\x93\x46\x3c\x83\xda\x84\xc8\x03\x0a\xf8\xd6\xd1\x0f\x03\x6f\x91\xb6\x4b\x48\x6e\x65\xc3\x4c\x79\xe8\xb1\x93\x17\x0f\x7a\x12\xe8\x5d\x1b\x16\xc0\x60\xe2\xae\xa5\x43\x19\x9c\x4c\x21\x51\x21\x99\xbe\x6f\x8d\x23\x4c\x4b\xf1\xc9\x48\x8c\x25\xfc\xb1\x99\xc3\x7a\xbd\x4d\x3f\xff\x1a\xcf\x5b\x42\x57\xc2\x1f\xab\xf5\x26\xdf\xed\xe7\xb0\x5a\xed\xf2\xe7\xe7\x97\x39\xac\x96\x9b\xfc\x65\xf3\x32\xc1\x52\xeb\x2c\x61\xfd\xb4\xcb\x7c\xcf\x5d\xcf\x43\xe8\xe2\x55\xb2\x3b\x86\x30\x9c\x65\x30\x06\x3c\xb6\x9e\x04\xc5\x8f\x42\x1e\xf0\x77\xaf\xb3\x0f\xa2\x1e\x31\x16\xab\x94\xcc\x87\xa0\xc7\x54\x7e\x14\xf8\x68\x39\x1e\xfb\x60\xcb\x69\xe6\xc4\x4d\x8e\x2d\x7e\xf7\x0e\xaf\x71\x60\x13\xfb\x40\x79\x9a\x84\x89\xbc\xf1\xe6\x22\x71\x7c\xe3\xcf\xb8\x91\xf3\x37\x7e\xaf\x55\x35\xa4\xce\xb1\x6f\x4b\xd8\xea\xf5\x66\x5b\x3d\xed\x37\x1b\x54\xb8\xdd\xbe\xac\xf7\xcb\xdd\x13\xae\xf6\x4b\x5d\x6d\x96\xab\x1d\x66\x89\x04\x72\x3f\xd3\x5b\x61\x9a\xa4\xa7\x80\xdd\xd0\x9e\xae\x64\x4e\x0d\x47\xe9\x29\xbe\x0f\x8a\x24\x84\x74\x7a\xec\x90\x9b\x5f\x77\x3f\xe9\x8d\x85\xc2\xba\xa6\xe2\x1a\xdc\xd3\x72\xb1\x2e\x34\x75\xd6\xdf\x8e\xe3\xf2\xe8\xfc\x51\x91\xb1\x47\xc1\xe6\x5d\xf0\xec\x87\xe0\x46\x1f\xfe\x17\x16\xc7\xff\x79\xda\x9d\x6a\xc0\xc4\x63\x6a\xd7\x17\xa1\x05\xda\x48\x30\x03\x53\x43\x24\x9e\xcb\x2d\x0f\x33\x41\x5a\xbe\xdc\x18\x18\x99\x67\xf2\xc1\x32\xfc\x61\x94\x7c\x7c\x95\x3d\x3c\xcf\x44\xf2\x9e\xae\xc7\x8c\x0e\x1b\x49\xbd\x26\xe7\x39\x8d\xb0\x9f\x68\xa9\x8d\xa5\xf4\x8a\x8d\x13\x39\x7f\xbc\x20\x79\xe3\x98\x87\xf1\x95\x5c\x1a\x4c\xdf\x19\x41\xb5\x5e\x3f\xed\x69\xa3\xd6\x7b\x85\xd5\xaa\x5e\xd2\x12\x37\x3b\xf5\xfc\x8c\x58\xad\x35\xa9\x87\x64\x3f\x08\xe1\x4e\xbd\x2c\xf5\xba\x5e\xed\x70\xb5\x5d\xea\xe5\x66\xbf\x79\x7a\xd9\xad\x77\x55\xfd\xbc\x5f\xaf\x56\x19\x32\x07\x53\xf5\x3c\xb4\x7d\xfa\xc6\x01\xdf\x66\xd3\xfd\x2c\x03\x38\x1b\xa7\x4b\xf8\x7c\x38\x8c\x99\x91\xb5\x44\xe4\xa8\x0f\xf7\x71\x05\x9f\x3e\x1f\x0e\x73\x78\x95\x9f\x3c\xcf\x65\x6c\x4d\x2f\x8d\xa3\x46\xc6\x48\x5c\xc2\x5f\xa4\x0c\x64\x28\xcf\x60\xdc\x7b\x7b\x38\xa7\x16\x37\x0a\x64\x00\x2d\x3a\x53\x53\xe4\x23\xf6\xdc\xf8\x50\x02\x56\xba\xb7\x3a\xfb\x4f\x00\x00\x00\xff\xff\x00\x12\xb1\x79\x44\x0c\x00\x00"
-
-func wrn502YmlBytes() ([]byte, error) {
-	return bindataRead(
-		_wrn502Yml,
-		"WRN50-2.yml",
-	)
-}
-
-func wrn502Yml() (*asset, error) {
-	bytes, err := wrn502YmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "WRN50-2.yml", size: 3140, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _xceptionYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x0c\xe0\x4b\x0b\x38\x92\x1c\x3b\x4e\xa2\xc3\x0f\xf8\x6d\x4f\x05\x16\x39\x74\x8b\xee\x02\xc5\x22\x18\x92\x23\x8b\x5b\x8a\x24\xc8\x91\x1d\xef\xa7\x5f\x90\x92\x2d\xbb\x69\xb7\x3d\x6c\x0e\x8e\x4d\xbe\xf9\xf7\x66\xf8\x48\x8b\x3d\x35\xf0\x87\x24\xcf\xda\x59\x58\x40\x5a\x00\xd7\xc2\xd1\x0d\x01\x7a\xa7\xc8\x14\x6d\xc0\x9e\x0e\x2e\x7c\x69\x0a\x80\xd1\xe0\x23\xd9\xe8\xc2\x87\x8f\xb0\x80\xf3\x2e\xb4\x2e\x00\x77\x34\x59\x01\xec\x29\x44\xed\x6c\x03\xdb\xb2\x2e\x57\x57\xd0\x69\x0b\xa4\xb3\x1c\x50\x5b\x2e\xce\xe0\x55\x59\xc3\xe2\x0c\xd0\xb6\x75\xa1\x47\x1e\xbf\x43\xa4\x1e\x2d\x6b\x79\xde\x1f\x77\x8b\xe4\x07\xb5\xa5\xd0\xc0\x02\xce\x3f\x22\x0c\x91\x14\xb0\x03\x4f\x21\x21\xc7\xd4\xc0\x07\x52\x5a\x26\x9f\x05\xcc\x7f\x0b\xe8\x07\xc3\xda\x1b\x02\x6f\x90\x13\x3e\x82\x44\x0b\x82\x20\x7a\x92\xba\xd5\xa4\x0a\x00\xec\xd5\x76\xd3\x64\xcb\x9d\x1f\x1a\x08\xa8\x7d\x70\x7f\x91\xe4\x4a\x62\xe8\xcd\x0d\x67\x72\x02\x37\x19\x79\x23\xfd\x90\xc1\xf2\x67\xc0\xbb\x0c\xf6\x5e\x6e\x37\x86\x9a\x9f\xb1\x9b\xb0\x93\xe5\x0f\x73\xba\x84\x2b\x8a\x32\xe8\xdc\xf9\x06\xfe\x57\x00\xfc\x3f\x91\xcc\x14\x7c\x20\x1e\x39\x77\x2d\xbc\xb7\xa7\xe9\xe8\x9d\x1a\x0c\xc5\xd4\x08\xe9\xec\xde\x99\x21\x2d\xa3\x01\x4b\x43\xc8\xff\x38\x75\x37\x02\x46\x10\xa4\xed\x0e\x70\x72\xd8\x93\xd2\xc8\x04\x91\xc9\x83\xb6\x37\x82\xf8\x40\x94\xe8\x0f\xb4\x1b\x0c\x86\x4b\x87\x80\x56\xe5\x41\x52\xe4\xb9\x3b\xe8\x48\x10\xc9\x63\x40\x61\xe8\x0a\xe7\x3c\x85\x31\xcd\x37\x78\x01\xbe\x84\xb4\xce\x18\x77\x20\x05\xe2\x08\x08\xde\x69\xcb\x5f\x63\xde\x96\x05\xc0\x7b\x0b\xdc\xe9\x08\x46\xef\x3a\x5e\x02\xfe\x30\xf6\x34\x18\x83\x55\x14\x22\x3b\xa7\x52\xd1\x68\x5f\xb1\x05\x07\xcd\x1d\x20\xf4\xf8\xa2\x7b\x34\xe6\x08\x06\xc3\x8e\xc0\x0e\xbd\xa0\x90\xf8\x65\x77\xa0\x10\x53\x12\x1f\x53\x06\x4e\x44\x0a\xfb\xb1\x2c\x43\xa8\xd2\x10\xe7\x11\x0e\xce\xbb\x48\x80\x60\xdd\x9e\x0c\x28\x22\xff\xaf\x6d\x00\x0c\xb2\xd3\x4c\x92\x87\x40\xa0\x6d\xf4\x3a\x8c\x44\x9c\x73\x5c\xc2\xa1\xa3\x40\xf3\x42\x01\xe7\x26\x77\xb8\x27\x10\x44\x16\x02\x79\x83\x92\xd4\x58\xca\x0f\x88\x89\x25\xfc\x4e\x10\x3b\x77\x00\xee\x90\x47\x56\x2f\x33\x59\x82\x1a\x84\x20\x75\x16\x9d\x25\xc4\xcc\xba\x39\x82\x1b\x78\x3a\xa9\x31\xf7\xe4\x44\xe5\xa7\x35\x38\x9b\x47\xe2\x7d\x8f\x3b\x7a\x22\x06\x85\x8c\x91\x18\xde\x1c\x3a\x2d\xbb\x6b\xec\x01\x23\x28\x8a\x7a\x67\x49\x25\x85\x78\xbb\xcc\x23\x95\x16\x74\xab\x25\xda\xaf\x62\xbd\x8a\x84\x63\x93\x02\xe8\x14\xae\x00\x90\x06\x63\xcc\xb6\x19\x75\x0a\x2e\x5d\xef\x83\x8e\x69\xd2\xd7\x77\x35\xf4\xda\x98\x2c\x54\xc9\x2a\xe6\x98\xab\xfb\x65\x5d\xd7\xa3\x3d\xc5\x12\x7e\xd3\x56\x52\xae\xe4\xac\xb9\x57\x6d\xea\x30\xe6\xdd\x98\x64\x78\x9e\x91\x44\x74\x4f\x4c\x21\xf1\x82\xd7\x09\x2f\xb3\xc1\x54\x0b\x26\xf7\x3b\xd4\x36\x91\x4e\x60\x1d\x83\x1a\x28\xcd\x8f\xb6\x32\x10\x26\x3d\x94\xe8\x51\x6a\x3e\x82\x18\x18\x02\x72\x47\x21\x01\x10\x7a\x17\x08\xa8\x6d\xb5\xd4\x64\x39\x89\x67\x8a\x3d\x69\xe6\x39\x83\x12\x8a\x40\x2d\x05\xb2\x92\x62\xd2\xdb\xf9\x57\x9e\x53\xf4\x49\x79\x2b\x38\x90\x88\x9a\x29\x7d\x25\x96\x65\x09\xa3\xdc\x88\xc4\xd6\xe5\x35\x71\x03\x1d\xb3\x8f\x4d\x55\xed\x34\x77\x83\x28\xa5\xeb\xab\xe8\x08\xf7\x14\xaa\x93\x6e\xdd\x64\x74\xc5\x81\xa8\xea\x31\x32\x85\x4a\x9a\xf8\x3d\xeb\x5f\x3e\xfd\xfa\xae\x92\xd8\xb6\x54\xf9\xc1\x98\xea\x6e\xbb\xbd\xab\x5a\x6d\xe8\xbb\x16\xad\xec\x9c\x31\xc4\x55\x3a\x56\x37\x86\x30\x58\x6d\x77\x63\xd4\x58\x09\xe3\xc4\x29\xec\xcb\xc4\x7c\xe9\x8f\x57\xce\x30\xbc\xe8\x7d\xe9\xc2\xae\x42\x11\xab\xd5\x76\x55\x97\xf5\xed\xfa\xee\xbe\x58\x80\xd1\x92\xec\x48\xe6\x5c\xf8\xb4\xd8\xc0\x60\x03\x45\x0e\x5a\x32\xa9\x62\x01\xda\xfa\x81\x33\x93\x33\x76\x5c\x4b\xd7\xc1\x02\x5a\x1d\x22\x8f\x28\xe0\xa3\xa7\x57\xd7\xee\x4d\x5e\x6e\xce\xb3\x9b\x8c\x2e\x94\xfe\x94\xc5\x85\x9f\x0c\xba\xba\x0c\x12\x60\x0c\x31\x7b\x99\x27\x20\x35\x3d\x87\xbe\x1a\xcb\xec\x44\xf7\x64\xd3\xc5\x1c\x1b\xf8\xbc\x5e\xc2\xed\xe3\x63\xfe\xf8\x73\xda\xef\x09\x6d\x03\x9f\x57\xb7\x0f\x4b\x38\x7d\x9c\xf6\xa2\x44\x43\x4d\x5a\x29\xd2\xd9\x1c\x78\xac\x37\xa5\x92\x83\x4d\x79\x8f\x7b\x05\x4c\x55\xb6\x84\xe9\xe0\x64\x28\x7e\xab\xce\x11\x3f\xa7\x5a\x7c\xa3\xd4\x09\x63\x50\x64\x06\x2f\x2a\x9d\xf8\xfb\x56\xb5\x53\xe4\xf8\x3c\x04\xd3\xe4\x31\x68\xaa\x2a\xae\x4b\xec\xf1\x6f\x67\xf1\x10\xc7\x41\x66\x17\xa8\xcc\x97\x70\x1e\x8e\x78\xb4\x91\x38\x56\x99\x57\x4b\x3c\x2d\x94\xfc\xc2\xd7\x5e\x65\x47\xf2\x4b\x1c\xfa\x06\x36\xea\x76\xbd\x11\x77\x0f\xeb\x35\x4a\xdc\x6c\x1e\x6f\x1f\xea\xed\x1d\xae\x1e\x6a\x25\xd6\xf5\x6a\x8b\x45\xee\x7c\x6a\xca\xe9\x99\x12\xa7\x43\xbb\x0b\xe8\xbb\xac\x43\x07\x4a\x12\x1b\x21\x50\x74\x43\x90\xf9\x20\xe4\xdd\x67\x8f\xdc\xfd\x7c\xfa\xd3\x81\x18\xcf\xd6\xe9\x28\x54\x8a\xbc\x71\xc7\xe7\xf9\x68\x04\xc7\x6e\xac\x68\x0a\xfc\x9f\x84\x39\xfb\xcf\xeb\xa7\x79\xd7\xf1\x39\x4b\xe8\x3e\x4d\x03\x9a\x48\xb0\x00\xdd\x42\x24\xce\xca\x38\xde\x1b\x02\x23\xa5\x46\x41\xba\x8d\x20\x7d\x49\x8a\x37\x89\xef\x9e\x2e\xdf\x81\x17\x0f\xc2\x64\x39\xb3\x74\x49\xe4\xb8\x90\xdd\x2b\xb2\x8e\xb3\xa6\x7f\xc7\x4b\x12\x9e\xfc\x6e\x8e\xa7\x99\x7c\xdd\x97\x74\xb5\xea\x31\xd5\x39\xa5\x31\xf4\x3c\x08\xaa\x56\xed\x1a\x11\x71\xb3\xa6\x8d\x78\xb8\xa5\xcd\xfd\xe3\x46\x3c\x3c\x6e\x6e\x6b\x71\x5f\x6f\xef\x2f\xe8\x9e\x8d\x24\xaa\x95\xbc\xdf\xd2\xea\x5e\x90\x44\x12\xb5\x50\x54\xaf\xef\x56\xb2\x15\x5b\x25\xf0\xa1\x40\xe6\xa0\xc5\xc0\xa3\x98\xd3\x0b\x07\x9c\x9f\x10\xe7\xbd\x02\xe0\x8b\xb6\xaa\x81\x77\x4f\x4f\x13\x33\xe9\x77\xaa\xe8\xab\x67\xc7\x9b\x77\x4f\x4f\x4b\xf8\x90\x3e\xca\xb2\x7c\x9b\x4e\x6a\x7a\xe2\x6b\xbb\x7b\x9e\xee\xcd\x66\xbe\xc6\x17\xe7\xbb\xf4\xf4\x54\xcf\x72\x36\x19\xa4\xe7\x08\x5a\xdd\x52\xe4\x67\x1c\xb8\x73\xa1\x01\x14\x6a\x30\xaa\xf8\x27\x00\x00\xff\xff\x88\xd4\x25\x2a\xb9\x0c\x00\x00"
-
-func xceptionYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_xceptionYml,
-		"Xception.yml",
-	)
-}
-
-func xceptionYml() (*asset, error) {
-	bytes, err := xceptionYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "Xception.yml", size: 3257, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vocFcn16sYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x6f\xdb\xcc\x11\xbe\xf3\x57\x0c\x5e\x5d\x5a\x40\x24\x45\xc9\xa6\x25\x1e\x0a\xa4\x2e\x92\x06\x0d\xd4\xc0\xf9\xe8\x21\x08\x84\xe1\x72\x28\x6e\x4d\xee\x12\xbb\x43\xc9\xee\xa1\xbf\xfd\xc5\x2c\xa9\x0f\x23\x0e\x12\x1d\x04\x72\xe7\x99\x99\x67\x3e\x97\x06\x3b\x2a\xe0\xed\xfd\x36\xce\x72\x0f\x1f\xdf\x7c\xba\x7f\xf3\x01\x66\x20\xc7\x60\x6b\x78\xb6\x83\x83\xce\x56\xd4\x46\xb5\xc3\x8e\x8e\xd6\x3d\x16\x11\xc0\xa8\xf6\x99\x8c\xb7\xee\xe1\x33\xcc\xe0\x2c\x85\xda\x3a\xe0\x86\x26\x2d\x80\x03\x39\xaf\xad\x29\x20\x4f\x16\x49\xf6\x02\x3a\x89\x40\x59\xc3\x0e\xb5\xe1\xe8\x0c\xce\x92\x05\xcc\xce\x00\x6d\x6a\xeb\x3a\xe4\xf1\x19\x3c\x75\x68\x58\xab\xb3\x7c\x94\x46\x62\x07\xb5\x21\x57\xc0\x0c\xce\x2f\x1e\x06\x4f\x15\xb0\x85\x9e\x9c\x20\x47\x6a\xd0\x3b\xaa\xb4\x12\x9b\x11\x5c\x7e\x33\xe8\x86\x96\x75\xdf\x12\xf4\x2d\xb2\xe0\x3d\x28\x34\x50\x12\xf8\x9e\x94\xae\x35\x55\x11\x00\x76\x55\x7e\x53\x04\xcd\x7d\x3f\x14\xe0\x50\xf7\xce\xfe\x97\x14\xa7\x0a\x5d\xd7\xc6\x1c\x92\xe3\xb8\x08\xc8\x58\xf5\x43\x00\xab\xdf\x01\xef\x03\xb8\xef\x55\x7e\xd3\x52\xf1\x3b\x7a\x13\x76\xd2\xfc\x25\xa7\x6b\x78\x45\x5e\x39\xdd\x73\xc8\xfb\xdf\x22\x00\x3e\x5a\xf0\xec\x08\xbb\x39\x64\x39\xf4\xfa\xe9\x45\xb6\x44\xa6\x2b\x02\x43\x3c\x07\xaf\xac\xd3\x66\x0f\xf9\x6d\xb2\x80\xee\xfd\x17\x10\x39\xed\xb3\xec\x80\xad\x96\x3c\x85\xc2\x52\x05\xd6\xb4\xda\x10\x1c\x35\x37\xd0\xe8\x7d\x03\x9d\xed\xc8\xf0\xd0\x85\x86\x41\xf8\xff\x2d\xf4\x56\x1b\x86\xd2\x5a\xcf\x52\xe5\x8e\x50\xaa\xcd\xe4\x3c\x05\xc7\xb1\x3d\x90\x8b\x07\x23\x1c\xe4\x31\xb4\x99\x75\x7a\xaf\x0d\xb6\x63\x51\x7d\x12\x01\x7c\x6e\xc8\x4f\xfd\xe7\x01\x1d\x9d\x39\x0c\x5e\xa8\xd2\x13\x3b\x84\x0a\x19\xa1\x76\xb6\x83\x7f\xa2\xd3\x0d\x3a\x34\x40\x0c\xd8\x26\x73\x28\x07\x06\x7a\x52\xed\x50\x09\xfe\xd3\xdf\xff\x01\x07\x6c\x93\x30\x25\xab\xcc\x83\xf6\x50\x6b\x43\x31\x0f\x62\x34\xd8\x10\x26\xef\x3f\x7c\xfa\xfa\x70\x1f\x9f\x9c\x7d\x7d\xf7\x2e\xce\xf2\x91\xc6\x5c\x1a\xc6\x54\x01\x26\xaa\x6e\xca\xe1\x44\xaf\x21\x73\x6d\x51\x1b\xe0\xc1\x99\x44\x02\x81\x3f\x90\x63\x6b\x14\xfd\x11\xdc\xaf\x5f\xf5\x3e\xb9\xc2\xb6\x8d\x27\x34\x94\xcf\xe0\x15\xb6\xc2\x5f\x9c\xfa\x47\xdd\xcb\x44\x98\x31\x93\x5e\xa6\xa1\x24\x66\x72\x72\x5a\xe9\x50\x57\xdb\xb3\xee\xf4\xff\xc2\x98\x25\x91\xa3\x9a\x1c\x19\x45\x5e\xa6\xe9\xf2\x16\x06\x09\x7b\x99\xab\x14\x8e\x54\x7a\xcd\x24\x8f\xc4\x2a\x49\x60\x6c\xa6\xf2\xe4\xf7\xb4\x04\x62\x68\x98\x7b\x5f\xa4\x29\xba\x27\x7d\x48\xac\xdb\xa7\x58\xfa\x34\xcb\x17\xb7\xc9\x22\x5f\x66\xd9\x19\x54\xa4\xe9\xf1\x78\x4c\xd4\x21\xae\xed\x60\xaa\x91\x8d\xe0\x6d\x4f\x06\x95\x22\xef\x53\x99\x6d\x32\xbc\x53\x87\xde\xed\x96\x8b\xec\x36\x6d\xb8\x6b\xd3\x0f\xd6\xec\x77\x6f\x87\xb6\x7d\xde\xdd\x5b\x73\xb0\xed\x20\xca\xd8\xee\xb6\xc4\xb2\x70\x7c\xc0\xee\xee\xbf\x7e\x7c\xd8\x85\x10\x12\x51\x7b\x41\x6f\xaf\xb9\x19\xca\x44\xd9\x2e\xf5\x0d\xb5\x0d\x76\xe4\xd2\x5a\x99\xa4\x24\xf7\x48\x2d\x3d\x1f\xb4\x3f\x11\x62\x47\x94\x76\xe8\x99\x5c\x7a\xb0\x2a\xae\x95\x59\x2d\x7d\x34\x83\x56\x2b\x32\x3e\x2c\xd0\x4b\x12\xa6\xc3\x02\xbe\x6c\xff\xb5\xfd\xf7\x7f\xb6\xd1\x0c\xb4\xe9\x07\x0e\x09\xbd\xc0\xc6\x33\x99\xf9\x19\xd4\xda\x85\x51\xe8\x07\x06\x7e\xee\xe9\x87\xdd\x1a\x87\xe3\x02\x74\x87\x7b\x8a\xc6\xf5\x75\x35\xce\x27\x02\x57\x76\x02\xe8\xc5\xc4\x0b\x60\x74\x71\xb1\xd2\xa3\xec\x68\x99\x3c\xa9\x7d\x70\x7d\x39\x9a\xb6\x65\xa5\x3b\x32\x92\x0b\x5f\xc0\xb7\xd5\x1c\x6e\x17\x8b\xf0\xf7\x7d\x92\xcb\xf8\x16\xf0\x2d\x5b\x2e\x93\xfc\x6e\xbd\x59\xce\x21\xcb\xf2\x24\xcf\xd7\x77\x77\x73\xc8\x16\x37\xc9\x62\x91\x6f\x36\xdf\x23\x3b\x70\x3f\xf0\x18\xb0\x70\x09\xde\x26\xe2\xa3\x4c\xb6\x48\x08\xb3\x26\xe4\xc1\x51\x80\xe2\x6b\x81\x8e\xf8\x0b\xd7\xe8\x95\x58\x27\x4c\x8b\x65\x48\xe1\x55\xa8\x53\x02\x5f\x0b\x77\xf2\xec\x77\x83\x6b\x8b\xab\x66\xf1\x9c\x8c\x1d\x33\x78\x72\x53\x57\x86\xe6\x41\x63\xcd\x73\x67\x07\x9f\xe2\x7a\x79\x53\xe2\x6a\xb5\x2a\x71\xb3\x5c\x6e\x56\x48\xeb\x45\x55\xad\xab\x3c\xbf\xab\x56\x9b\x2c\x4b\x1d\x1e\xd3\x72\x59\x65\xd5\x52\x61\x85\xab\x3b\xc2\xec\x66\x9d\xad\xb1\xbc\x53\x2b\x5a\x2e\x37\xea\x66\x53\x96\xcb\xa5\xba\xb9\x5d\xdd\x06\x97\xb5\x6e\x29\x4b\xf8\x89\xa3\xd0\x07\x52\xa2\xd3\xcd\xe4\xa7\xbb\x6d\xef\xb0\x6f\xc2\xca\x39\x92\xde\x37\xec\xc1\x91\xb7\x83\x53\x24\xf1\x04\xe9\xae\x47\x6e\x2e\xb1\x38\x3c\xfe\x24\x94\x5f\xce\xc1\xcb\x11\xc8\x72\x9f\xca\xba\xec\x9d\x65\x2b\x24\xe1\xc4\xe1\xca\x63\x91\xa6\x55\x9b\x28\xac\x6b\x7a\xcd\xe2\x68\x26\x6e\x08\x0f\xcf\x71\x8f\xb2\xc9\x46\xf0\xa9\xf1\xb5\xdf\xa1\x53\x8d\x3e\x48\x57\x60\xeb\x09\x66\xa0\x6b\xf0\x72\x25\x85\x75\x2a\x95\x2e\xd1\x93\x14\x4c\x56\x26\x82\x3c\xb0\x05\x34\x30\x69\x5e\xdf\xfa\x57\xd7\xbf\x68\x5e\x12\x74\x9d\xc3\xf1\x20\x98\xaf\xc8\x58\x0e\x9b\xfb\x27\x56\xa4\x46\xe1\x2b\xc9\x9f\x7a\xf3\xc7\x92\xc8\x5d\xa8\x47\xaa\x27\x4a\xc8\xec\x74\x39\xf0\xb8\x74\xc7\x9b\xca\x8c\xcb\x0b\x2e\xb2\x08\xe0\x51\x9b\xaa\x80\xfb\xed\x76\x62\x2c\xef\xe2\xc9\xd0\xe0\xb0\x3d\xeb\xfc\xe5\x7e\xbb\x9d\xc3\x83\xfc\x25\x49\xf2\xd7\xd3\x7d\xac\xcd\x7e\x27\x17\xa0\x27\x2e\xe0\xbd\x8c\xfd\x96\x58\x76\xc7\x78\x76\xfe\x60\x0a\xfb\x66\x52\x88\x00\x3a\x34\xba\x26\xcf\x3b\x1c\xb8\xb1\xae\x00\x2c\xab\xa1\xad\xa2\x46\x57\x15\xc9\x78\xb9\x81\xa2\x3f\x03\x00\x00\xff\xff\x0e\x9d\xb7\x7d\x52\x0a\x00\x00"
-
-func vocFcn16sYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vocFcn16sYml,
-		"voc-fcn16s.yml",
-	)
-}
-
-func vocFcn16sYml() (*asset, error) {
-	bytes, err := vocFcn16sYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "voc-fcn16s.yml", size: 2642, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vocFcn32sYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4b\x6f\xdb\xce\x11\xbf\xf3\x53\x0c\xfe\xba\xb4\x80\x48\x8a\x94\x2d\x4b\x3c\x14\x48\x5d\x24\x0d\x1a\xa8\x81\xf3\xe8\x21\x08\x84\xe1\x72\x28\x6e\xbd\xdc\x25\xf6\x21\xd9\x3d\xf4\xb3\xff\x31\x4b\xea\x61\xc4\x41\xa2\x83\x40\xee\xfc\x66\xe6\x37\xcf\xa5\xc6\x9e\x2a\x78\x7b\xbf\x4d\x97\xa5\x83\x8f\x6f\x3e\xdd\xbf\xf9\x00\x33\xe0\x63\x30\x2d\x3c\x9b\x60\xa1\x37\x0d\xa9\xa4\xb5\xd8\xd3\xd1\xd8\xc7\x2a\x01\x18\xd5\x3e\x93\x76\xc6\x3e\x7c\x86\x19\x9c\xa5\xd0\x1a\x0b\xbe\xa3\x49\x0b\xe0\x40\xd6\x49\xa3\x2b\x58\x65\x8b\xac\x78\x01\x9d\x44\x20\x8c\xf6\x16\xa5\xf6\xc9\x19\x5c\x64\x0b\x98\x9d\x01\x52\xb7\xc6\xf6\xe8\xc7\x67\x70\xd4\xa3\xf6\x52\x9c\xe5\xa3\x34\x61\x3b\x28\x35\xd9\x0a\x66\x70\x7e\x71\x10\x1c\x35\xe0\x0d\x0c\x64\x19\x39\x52\x83\xc1\x52\x23\x05\xdb\x4c\xe0\xf2\x9b\x41\x1f\x94\x97\x83\x22\x18\x14\x7a\xc6\x3b\x10\xa8\xa1\x26\x70\x03\x09\xd9\x4a\x6a\x12\x00\xec\x9b\xd5\x4d\x15\x35\xf7\x43\xa8\xc0\xa2\x1c\xac\xf9\x2f\x09\x9f\x0b\xb4\xbd\x4a\x7d\x4c\x8e\xf5\x55\x44\xa6\x62\x08\x11\x2c\x7e\x07\xbc\x8f\xe0\x61\x10\xab\x1b\x45\xd5\xef\xe8\x4d\xd8\x49\xf3\x97\x9c\xae\xe1\x0d\x39\x61\xe5\xe0\x63\xde\xff\x96\x00\x38\xa9\xf7\x8a\xc0\x79\x4b\xd8\xcf\x61\x59\xc2\x20\x9f\x5e\x24\x8c\x65\xb2\x21\xd0\xe4\xe7\xe0\x84\xb1\x52\xef\x61\xb5\xcc\x56\xd0\xbf\xff\x02\x2c\xa7\x7d\x51\x1c\x50\xc9\x26\x4b\x00\x62\x71\xa9\x01\xa3\x95\xd4\x04\x47\xe9\x3b\xe8\xe4\xbe\x83\xde\xf4\xa4\x7d\xe8\x63\xd3\x20\xfc\xff\x16\x06\x23\xb5\x87\xda\x18\xe7\xb9\xd2\x3d\x21\x57\xdc\x93\x75\x14\x3d\xa7\xe6\x40\x36\x0d\x9a\x49\xf0\x63\x6c\x35\x63\xe5\x5e\x6a\x54\x63\x61\x1d\xbb\xfc\xdc\x91\x9b\x7a\xd0\x01\x5a\x3a\x73\x08\x1c\x1d\xd0\x93\xb7\x08\x0d\x7a\x84\xd6\x9a\x1e\xfe\x89\x56\x76\x68\x51\x03\x79\x40\x95\xcd\xa1\x0e\x1e\xe8\x49\xa8\xd0\x30\xfe\xd3\xdf\xff\x01\x07\x54\xd9\x79\x52\xa4\x83\x56\x6a\x4a\x7d\x60\xa3\xd1\x06\x33\x79\xff\xe1\xd3\xd7\x87\xfb\xf4\xe4\xec\xeb\xbb\x77\x69\xb1\x1a\x69\xcc\xb9\x69\x74\x13\x61\xac\x6a\xa7\x24\x4e\xf4\x3a\xd2\xd7\x16\xa5\x06\x1f\xac\xce\x38\x10\xf8\x03\x7d\x6a\xb4\xa0\x3f\xa2\xfb\xf5\xab\xde\x27\x57\xa8\x54\x3a\xa1\xa1\x7e\x06\x27\x50\x31\x7f\x76\xea\x1e\xe5\xc0\x53\xa1\xc7\x4c\x3a\x9e\x88\x9a\xbc\x27\xcb\xa7\x8d\x8c\x85\x35\x83\x97\xbd\xfc\x5f\x1c\xb5\x2c\x49\x2c\xb5\x64\x49\x0b\x72\x3c\x52\x97\xb7\x38\x4d\x38\xf0\x70\xe5\x70\xa4\xda\x49\x4f\xfc\x48\x5e\x64\x19\x8c\x1d\x55\x9f\x1c\x9f\x36\x41\x0a\x9d\xf7\x83\xab\xf2\x1c\xed\x93\x3c\x64\xc6\xee\x73\xac\x5d\x5e\xac\x16\xb7\xd9\x62\x55\x16\xc5\x19\x54\xe5\xf9\xf1\x78\xcc\xc4\x21\x6d\x4d\xd0\xcd\x48\x87\xf1\x66\x20\x8d\x42\x90\x73\x39\x0f\x38\x69\xbf\x13\x87\xc1\xee\xca\x45\x71\x9b\x77\xbe\x57\xf9\x07\xa3\xf7\xbb\xb7\x41\xa9\xe7\xdd\xbd\xd1\x07\xa3\x02\x2b\xa3\xda\x6d\xc9\xf3\xd6\x71\x11\xbb\xbb\xff\xfa\xf1\x61\x17\x43\xc8\x58\xed\x05\xbd\xbd\xf4\x5d\xa8\x33\x61\xfa\xdc\x75\xa4\x3a\xec\xc9\xe6\xad\xd0\x59\x4d\xf6\x91\x14\x3d\x1f\xa4\x3b\x11\xf2\x96\x28\xef\xd1\x79\xb2\xf9\xc1\x88\xb4\x15\x7a\x59\xba\x64\x06\x4a\x0a\xd2\x2e\x6e\xd1\x4b\x12\xa6\xc3\x0a\xbe\x6c\xff\xb5\xfd\xf7\x7f\xb6\xc9\x0c\xa4\x1e\x82\x8f\x09\xbd\xc0\xc6\x33\x1e\xfc\x19\xb4\xd2\xc6\x59\x18\x82\x07\xff\x3c\xd0\x0f\x0b\x36\x8d\xc7\x15\xc8\x1e\xf7\x94\x8c\x3b\xec\x6a\xa6\x4f\x04\xae\xec\x44\xd0\x8b\xb1\x67\xc0\xe8\xe2\x62\x65\x40\x5e\xd4\x3c\x7a\x5c\xfb\xe8\xfa\x72\x34\xad\xcc\x46\xf6\xa4\x39\x17\xae\x82\x6f\xcb\x39\xdc\x2e\x16\xf1\xef\xfb\x24\xe7\xf9\xad\xe0\x5b\x51\x96\xd9\xea\x6e\xbd\x29\xe7\x50\x14\xab\x6c\xb5\x5a\xdf\xdd\xcd\xa1\x58\xdc\x64\x8b\xc5\x6a\xb3\xf9\x9e\x98\xe0\x87\xe0\xc7\x80\x99\x4b\xf4\x36\x11\x1f\x65\xbc\x46\x62\x98\x2d\xa1\x0f\x96\x22\x14\x5f\x0b\x74\xc4\x5f\xb8\x26\xaf\xc4\x3a\x61\x14\xd6\x31\x85\x57\xa1\x4e\x09\x7c\x2d\xdc\xc9\xb3\xdb\x05\xab\xaa\xab\x66\x71\x3e\x1b\x3b\x26\x38\xb2\x53\x57\xc6\xe6\x41\x6d\xf4\x73\x6f\x82\xcb\x71\x5d\xde\xd4\xb8\x5c\x2e\x6b\xdc\x94\xe5\x66\x89\xb4\x5e\x34\xcd\xba\x59\xad\xee\x9a\xe5\xa6\x28\x72\x8b\xc7\xbc\x2e\x9b\xa2\x29\x05\x36\xb8\xbc\x23\x2c\x6e\xd6\xc5\x1a\xeb\x3b\xb1\xa4\xb2\xdc\x88\x9b\x4d\x5d\x97\xa5\xb8\xb9\x5d\xde\x46\x97\xad\x54\x54\x64\xfe\xc9\x27\xb1\x0f\xb8\x44\xa7\xeb\xc9\x4d\x17\xdc\xde\xe2\xd0\xc5\x9d\x73\x24\xb9\xef\xbc\x03\x4b\xce\x04\x2b\x88\xe3\x89\xd2\xdd\x80\xbe\xbb\xc4\x62\xf1\xf8\x93\x50\x7e\x39\x07\x3f\x8c\x40\xce\xfb\x72\xb0\xc6\x1b\x26\x09\x27\x0e\x57\x1e\xab\x3c\x6f\x54\x26\xb0\x6d\xe9\x35\x8b\xa3\x99\xb4\x23\x3c\x3c\xa7\x03\xf2\x2a\x1b\xc1\xa7\xc6\x97\x6e\x87\x56\x74\xf2\xc0\x5d\x81\xca\x11\xcc\x40\xb6\xe0\xf8\x52\x8a\xfb\x94\x2b\x5d\xa3\x23\x2e\x18\xef\x4c\x04\x7e\xf0\x06\x50\xc3\xa4\x79\x7d\xf5\x5f\x7d\x03\xb0\xe6\x25\x41\xd7\x39\x1c\x0f\xa2\xf9\x86\xb4\xf1\x71\x75\xff\xc4\x0a\xd7\x28\x7e\x2a\xb9\x53\x6f\xfe\x58\x12\xbe\x0c\xe5\x48\xf5\x44\x09\xbd\xb7\xb2\x0e\x7e\x5c\xba\xe3\x55\xa5\xc7\xe5\x05\x17\x59\x02\xf0\x28\x75\x53\xc1\xfd\x76\x3b\x31\xe6\x77\xf6\xa4\x29\x58\x54\x67\x9d\xbf\xdc\x6f\xb7\x73\x78\xe0\xbf\x2c\xcb\xfe\x7a\xba\x90\xa5\xde\xef\xf8\x06\x74\xe4\x2b\x78\xcf\x63\xbf\x25\xcf\xbb\x63\x3c\x3b\x7f\x35\xc5\x7d\x33\x29\x24\x00\x3d\x6a\xd9\x92\xf3\x3b\x0c\xbe\x33\xb6\x02\xac\x9b\xa0\x9a\xa4\x93\x4d\x43\x3c\x5e\x36\x50\xf2\x67\x00\x00\x00\xff\xff\x05\x35\x34\x19\x57\x0a\x00\x00"
-
-func vocFcn32sYmlBytes() ([]byte, error) {
-	return bindataRead(
-		_vocFcn32sYml,
-		"voc-fcn32s.yml",
-	)
-}
-
-func vocFcn32sYml() (*asset, error) {
-	bytes, err := vocFcn32sYmlBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "voc-fcn32s.yml", size: 2647, mode: os.FileMode(436), modTime: time.Unix(1588566874, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"BVLC-AlexNet.yml": bvlcAlexnetYml,
-	"BVLC-GoogLeNet.yml": bvlcGooglenetYml,
-	"BVLC-Reference-CaffeNet.yml": bvlcReferenceCaffenetYml,
-	"BVLC-Reference-RCNN-ILSVRC13.yml": bvlcReferenceRcnnIlsvrc13Yml,
-	"DPN68.yml": dpn68Yml,
-	"DPN92.yml": dpn92Yml,
-	"Inception-ResNet-v2.yml": inceptionResnetV2Yml,
-	"Inception-v3.yml": inceptionV3Yml,
-	"Inception-v4.yml": inceptionV4Yml,
-	"InceptionBN-21K.yml": inceptionbn21kYml,
-	"NIN.yml": ninYml,
-	"ResNeXt101-32x4d.yml": resnext10132x4dYml,
-	"ResNeXt26-32x4d-priv.yml": resnext2632x4dPrivYml,
-	"ResNeXt50-32x4d.yml": resnext5032x4dYml,
-	"ResNet101-v2.yml": resnet101V2Yml,
-	"ResNet101.yml": resnet101Yml,
-	"ResNet152-v2.yml": resnet152V2Yml,
-	"ResNet152.yml": resnet152Yml,
-	"ResNet18-priv.yml": resnet18PrivYml,
-	"ResNet269-v2.yml": resnet269V2Yml,
-	"ResNet50_v1.yml": resnet50_v1Yml,
-	"SqueezeNet-v1.0.yml": squeezenetV10Yml,
-	"SqueezeNet-v1.1.yml": squeezenetV11Yml,
-	"VGG16.yml": vgg16Yml,
-	"VGG16_SOD.yml": vgg16_sodYml,
-	"VGG16_SOS.yml": vgg16_sosYml,
-	"VGG19.yml": vgg19Yml,
-	"WRN50-2.yml": wrn502Yml,
-	"Xception.yml": xceptionYml,
-	"voc-fcn16s.yml": vocFcn16sYml,
-	"voc-fcn32s.yml": vocFcn32sYml,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"BVLC-AlexNet.yml": &bintree{bvlcAlexnetYml, map[string]*bintree{}},
-	"BVLC-GoogLeNet.yml": &bintree{bvlcGooglenetYml, map[string]*bintree{}},
-	"BVLC-Reference-CaffeNet.yml": &bintree{bvlcReferenceCaffenetYml, map[string]*bintree{}},
-	"BVLC-Reference-RCNN-ILSVRC13.yml": &bintree{bvlcReferenceRcnnIlsvrc13Yml, map[string]*bintree{}},
-	"DPN68.yml": &bintree{dpn68Yml, map[string]*bintree{}},
-	"DPN92.yml": &bintree{dpn92Yml, map[string]*bintree{}},
-	"Inception-ResNet-v2.yml": &bintree{inceptionResnetV2Yml, map[string]*bintree{}},
-	"Inception-v3.yml": &bintree{inceptionV3Yml, map[string]*bintree{}},
-	"Inception-v4.yml": &bintree{inceptionV4Yml, map[string]*bintree{}},
-	"InceptionBN-21K.yml": &bintree{inceptionbn21kYml, map[string]*bintree{}},
-	"NIN.yml": &bintree{ninYml, map[string]*bintree{}},
-	"ResNeXt101-32x4d.yml": &bintree{resnext10132x4dYml, map[string]*bintree{}},
-	"ResNeXt26-32x4d-priv.yml": &bintree{resnext2632x4dPrivYml, map[string]*bintree{}},
-	"ResNeXt50-32x4d.yml": &bintree{resnext5032x4dYml, map[string]*bintree{}},
-	"ResNet101-v2.yml": &bintree{resnet101V2Yml, map[string]*bintree{}},
-	"ResNet101.yml": &bintree{resnet101Yml, map[string]*bintree{}},
-	"ResNet152-v2.yml": &bintree{resnet152V2Yml, map[string]*bintree{}},
-	"ResNet152.yml": &bintree{resnet152Yml, map[string]*bintree{}},
-	"ResNet18-priv.yml": &bintree{resnet18PrivYml, map[string]*bintree{}},
-	"ResNet269-v2.yml": &bintree{resnet269V2Yml, map[string]*bintree{}},
-	"ResNet50_v1.yml": &bintree{resnet50_v1Yml, map[string]*bintree{}},
-	"SqueezeNet-v1.0.yml": &bintree{squeezenetV10Yml, map[string]*bintree{}},
-	"SqueezeNet-v1.1.yml": &bintree{squeezenetV11Yml, map[string]*bintree{}},
-	"VGG16.yml": &bintree{vgg16Yml, map[string]*bintree{}},
-	"VGG16_SOD.yml": &bintree{vgg16_sodYml, map[string]*bintree{}},
-	"VGG16_SOS.yml": &bintree{vgg16_sosYml, map[string]*bintree{}},
-	"VGG19.yml": &bintree{vgg19Yml, map[string]*bintree{}},
-	"WRN50-2.yml": &bintree{wrn502Yml, map[string]*bintree{}},
-	"Xception.yml": &bintree{xceptionYml, map[string]*bintree{}},
-	"voc-fcn16s.yml": &bintree{vocFcn16sYml, map[string]*bintree{}},
-	"voc-fcn32s.yml": &bintree{vocFcn32sYml, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
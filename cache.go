@@ -0,0 +1,96 @@
+package tensorrt
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/rai-project/config"
+	"github.com/rai-project/tensorrt/cache"
+)
+
+// CacheDir returns the directory the model work directories are kept in.
+func CacheDir() string {
+	return config.App.TempDir
+}
+
+// CacheQuota returns the disk quota of the model work directories in bytes,
+// 0 when there is none.
+func CacheQuota() (int64, error) {
+	if Config.CacheQuota == "" {
+		return 0, nil
+	}
+	quota, err := humanize.ParseBytes(Config.CacheQuota)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid tensorrt.cache_quota %q", Config.CacheQuota)
+	}
+	return int64(quota), nil
+}
+
+// CacheManager returns the manager of the model work directories.
+func CacheManager() (*cache.Manager, error) {
+	quota, err := CacheQuota()
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(CacheDir(), quota), nil
+}
+
+// EnforceCacheQuota evicts the least recently used models that are not
+// loaded until the work directories fit in the quota.
+func EnforceCacheQuota(ctx context.Context) {
+	m, err := CacheManager()
+	if err != nil {
+		log.WithError(err).Error("not enforcing the cache quota")
+		return
+	}
+	evicted, err := m.Enforce(ctx)
+	for _, e := range evicted {
+		log.WithField("dir", e.Dir).WithField("size", humanize.Bytes(uint64(e.Size))).Info("evicted model from the cache")
+	}
+	if err != nil {
+		log.WithError(err).Error("failed to enforce the cache quota")
+	}
+}
+
+// cacheSweepDelay is how long a sweep waits for more loads before running, so
+// a burst of loads is followed by a single sweep.
+var cacheSweepDelay = time.Second
+
+var cacheSweeper struct {
+	once    sync.Once
+	request chan struct{}
+	// sweep enforces the quota, EnforceCacheQuota outside of the tests
+	sweep func(context.Context)
+}
+
+// SweepCache requests the cache quota to be enforced in the background. The
+// sweeps run one at a time in a single goroutine, and the requests made while
+// a sweep is pending are merged into it.
+func SweepCache() {
+	cacheSweeper.once.Do(func() {
+		cacheSweeper.request = make(chan struct{}, 1)
+		if cacheSweeper.sweep == nil {
+			cacheSweeper.sweep = EnforceCacheQuota
+		}
+		go sweepCache(cacheSweeper.request, cacheSweeper.sweep)
+	})
+	select {
+	case cacheSweeper.request <- struct{}{}:
+	default:
+	}
+}
+
+func sweepCache(request chan struct{}, sweep func(context.Context)) {
+	for range request {
+		time.Sleep(cacheSweepDelay)
+		// the requests made while waiting are covered by this sweep
+		select {
+		case <-request:
+		default:
+		}
+		sweep(context.Background())
+	}
+}
@@ -0,0 +1,317 @@
+// Package cache manages the disk space used by the model work directories:
+// it lists them, records when they were last used and evicts the least
+// recently used ones to stay under a quota.
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/artifact"
+)
+
+// LastUsedFile is touched in a work directory every time its model is loaded.
+const LastUsedFile = ".last_used"
+
+// inUsePrefix prefixes the markers of the processes using a work directory,
+// the marker name ends with the process id and the token of the process.
+// Every process has one marker, however many of its predictors hold the
+// directory.
+const inUsePrefix = ".in_use."
+
+// token tells the markers of this process from those left by a crashed
+// process that had the same pid, the agent is pid 1 in every container.
+var token = newToken()
+
+func newToken() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(b)
+}
+
+func markerName(pid int, token string) string {
+	return inUsePrefix + strconv.Itoa(pid) + "." + token
+}
+
+// maxDepth bounds how deep below the root work directories are searched for.
+const maxDepth = 8
+
+// Entry is a model work directory.
+type Entry struct {
+	Dir      string
+	Size     int64
+	LastUsed time.Time
+	// InUse is set when a running process has the model loaded
+	InUse bool
+}
+
+// Manager manages the work directories below Root.
+type Manager struct {
+	Root string
+	// Quota is the disk space the work directories may use, in bytes, 0 for
+	// no limit
+	Quota int64
+}
+
+// New ...
+func New(root string, quota int64) *Manager {
+	return &Manager{Root: root, Quota: quota}
+}
+
+// Touch records that the model of dir was just used.
+func Touch(dir string) error {
+	path := filepath.Join(dir, LastUsedFile)
+	now := time.Now()
+	if err := os.Chtimes(path, now, now); err == nil {
+		return nil
+	}
+	return ioutil.WriteFile(path, nil, 0644)
+}
+
+// holders counts the holders of the work directories marked in use by this
+// process. The marker is one per process, it is written by the first holder
+// and removed with the last one.
+var holders = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: map[string]int{}}
+
+// Acquire marks dir as in use by this process until the returned function is
+// called. Directories in use are never evicted. Acquire can be called several
+// times for a directory, it stays in use until every release is called.
+func Acquire(dir string) (func(), error) {
+	dir = filepath.Clean(dir)
+	path := filepath.Join(dir, markerName(os.Getpid(), token))
+
+	holders.Lock()
+	defer holders.Unlock()
+	if holders.counts[dir] == 0 {
+		if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+			return nil, errors.Wrapf(err, "cannot mark %s as in use", dir)
+		}
+	}
+	if err := Touch(dir); err != nil {
+		if holders.counts[dir] == 0 {
+			os.Remove(path)
+		}
+		return nil, err
+	}
+	holders.counts[dir]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			holders.Lock()
+			defer holders.Unlock()
+			holders.counts[dir]--
+			if holders.counts[dir] == 0 {
+				delete(holders.counts, dir)
+				os.Remove(path)
+			}
+		})
+	}, nil
+}
+
+// List returns the work directories below the root, least recently used
+// first. Work directories are recognized by their artifact.LockFile.
+func (m *Manager) List() ([]Entry, error) {
+	var entries []Entry
+	root := filepath.Clean(m.Root)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) || os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if depth(root, path) > maxDepth {
+			return filepath.SkipDir
+		}
+		if _, err := os.Stat(filepath.Join(path, artifact.LockFile)); err != nil {
+			return nil
+		}
+		entry, err := readEntry(path)
+		if err != nil {
+			return err
+		}
+		if entry.Size != 0 {
+			entries = append(entries, entry)
+		}
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot list the work directories in %s", m.Root)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].LastUsed.Before(entries[j].LastUsed)
+	})
+	return entries, nil
+}
+
+func depth(root, path string) int {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == "." {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
+// readEntry sizes the work directory. Its last use is the time LastUsedFile
+// was touched, or the time its newest file was written for directories
+// populated before LastUsedFile existed.
+func readEntry(dir string) (Entry, error) {
+	entry := Entry{Dir: dir}
+	var touched, newest time.Time
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := info.Name()
+		switch {
+		case info.IsDir():
+		case name == artifact.LockFile:
+		case name == LastUsedFile:
+			touched = info.ModTime()
+		case strings.HasPrefix(name, inUsePrefix) && filepath.Dir(path) == dir:
+			if markerAlive(strings.TrimPrefix(name, inUsePrefix)) {
+				entry.InUse = true
+			}
+		default:
+			entry.Size += info.Size()
+			if info.ModTime().After(newest) {
+				newest = info.ModTime()
+			}
+		}
+		return nil
+	})
+	entry.LastUsed = touched
+	if touched.IsZero() {
+		entry.LastUsed = newest
+	}
+	return entry, err
+}
+
+// markerAlive reports whether the process that wrote the marker, named
+// pid.token, still runs. Markers of this pid with another token were left by
+// an earlier process.
+func markerAlive(marker string) bool {
+	parts := strings.SplitN(marker, ".", 2)
+	pid, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	if pid == os.Getpid() {
+		return len(parts) == 2 && parts[1] == token
+	}
+	return alive(pid)
+}
+
+func alive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
+
+// Usage returns the disk space used by the entries.
+func Usage(entries []Entry) int64 {
+	var total int64
+	for _, e := range entries {
+		total += e.Size
+	}
+	return total
+}
+
+// Prune evicts the least recently used work directories until they use at
+// most quota bytes. A negative quota evicts every directory that is not in
+// use. It returns the evicted entries.
+func (m *Manager) Prune(ctx context.Context, quota int64) ([]Entry, error) {
+	entries, err := m.List()
+	if err != nil {
+		return nil, err
+	}
+	total := Usage(entries)
+
+	var evicted []Entry
+	for _, e := range entries {
+		if quota >= 0 && total <= quota {
+			break
+		}
+		if e.InUse {
+			continue
+		}
+		ok, err := m.evict(ctx, e)
+		if err != nil {
+			return evicted, err
+		}
+		if ok {
+			evicted = append(evicted, e)
+			total -= e.Size
+		}
+	}
+	return evicted, nil
+}
+
+// Enforce prunes down to the quota of the manager, if it has one.
+func (m *Manager) Enforce(ctx context.Context) ([]Entry, error) {
+	if m.Quota <= 0 {
+		return nil, nil
+	}
+	return m.Prune(ctx, m.Quota)
+}
+
+// evictLockTimeout is how long eviction waits for a work directory that is
+// being downloaded to, before skipping it.
+const evictLockTimeout = 100 * time.Millisecond
+
+// evict removes the content of the work directory, keeping its lock file so
+// processes waiting on the lock keep excluding each other. Directories that
+// are locked or became in use are skipped.
+func (m *Manager) evict(ctx context.Context, e Entry) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, evictLockTimeout)
+	defer cancel()
+	unlock, err := artifact.Lock(ctx, filepath.Join(e.Dir, artifact.LockFile))
+	if err != nil {
+		return false, nil
+	}
+	defer unlock()
+
+	current, err := readEntry(e.Dir)
+	if err != nil {
+		return false, err
+	}
+	if current.InUse {
+		return false, nil
+	}
+
+	files, err := ioutil.ReadDir(e.Dir)
+	if err != nil {
+		return false, errors.Wrapf(err, "cannot evict %s", e.Dir)
+	}
+	for _, f := range files {
+		if f.Name() == artifact.LockFile {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(e.Dir, f.Name())); err != nil {
+			return false, errors.Wrapf(err, "cannot evict %s", e.Dir)
+		}
+	}
+	return true, nil
+}
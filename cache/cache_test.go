@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/stretchr/testify/assert"
+)
+
+// workDir creates a model work directory below root holding size bytes and
+// last used at lastUsed.
+func workDir(t *testing.T, root, name string, size int, lastUsed time.Time) string {
+	dir := filepath.Join(root, name, "1.0")
+	assert.NoError(t, os.MkdirAll(dir, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, artifact.LockFile), nil, 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "model.caffemodel"), make([]byte, size), 0644))
+	assert.NoError(t, Touch(dir))
+	assert.NoError(t, os.Chtimes(filepath.Join(dir, LastUsedFile), lastUsed, lastUsed))
+	return dir
+}
+
+func tempRoot(t *testing.T) string {
+	root, err := ioutil.TempDir("", "cache")
+	assert.NoError(t, err)
+	return root
+}
+
+func dirs(entries []Entry) []string {
+	var dirs []string
+	for _, e := range entries {
+		dirs = append(dirs, e.Dir)
+	}
+	return dirs
+}
+
+func TestList(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	now := time.Now()
+	alexnet := workDir(t, root, "alexnet", 300, now.Add(-time.Hour))
+	resnet := workDir(t, root, "resnet", 500, now.Add(-2*time.Hour))
+	vgg := workDir(t, root, "vgg", 100, now)
+	// not a work directory
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(root, "scratch.txt"), make([]byte, 1000), 0644))
+
+	entries, err := New(root, 0).List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{resnet, alexnet, vgg}, dirs(entries))
+	assert.Equal(t, int64(300), entries[1].Size)
+	assert.Equal(t, int64(900), Usage(entries))
+	for _, e := range entries {
+		assert.False(t, e.InUse)
+	}
+
+	assert.NoError(t, Touch(resnet))
+	entries, err = New(root, 0).List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{alexnet, vgg, resnet}, dirs(entries))
+}
+
+func TestAcquire(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	dir := workDir(t, root, "alexnet", 100, time.Now().Add(-time.Hour))
+	release, err := Acquire(dir)
+	assert.NoError(t, err)
+
+	entries, err := New(root, 0).List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.True(t, entries[0].InUse)
+		assert.WithinDuration(t, time.Now(), entries[0].LastUsed, time.Minute)
+	}
+
+	release()
+	entries, err = New(root, 0).List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.False(t, entries[0].InUse)
+	}
+}
+
+func TestAcquireRefcount(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	dir := workDir(t, root, "alexnet", 100, time.Now().Add(-time.Hour))
+	first, err := Acquire(dir)
+	assert.NoError(t, err)
+	second, err := Acquire(dir)
+	assert.NoError(t, err)
+
+	inUse := func() bool {
+		entries, err := New(root, 0).List()
+		assert.NoError(t, err)
+		return len(entries) == 1 && entries[0].InUse
+	}
+
+	first()
+	first()
+	assert.True(t, inUse(), "the directory stays in use while a holder has not released it")
+	second()
+	assert.False(t, inUse())
+}
+
+func TestAcquireDeadProcess(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	dir := workDir(t, root, "alexnet", 100, time.Now())
+	// pid_max is below 1<<22, so no process has this pid
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, inUsePrefix+"4194304"), nil, 0644))
+
+	entries, err := New(root, 0).List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.False(t, entries[0].InUse)
+	}
+}
+
+func TestAcquireStaleMarkerOfSamePid(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	dir := workDir(t, root, "alexnet", 100, time.Now())
+	// left by an earlier process with the pid of this one, as the agent
+	// restarted in a container
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, markerName(os.Getpid(), "0123456789abcdef")), nil, 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, inUsePrefix+strconv.Itoa(os.Getpid())), nil, 0644))
+
+	entries, err := New(root, 0).List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.False(t, entries[0].InUse)
+	}
+
+	release, err := Acquire(dir)
+	assert.NoError(t, err)
+	defer release()
+	entries, err = New(root, 0).List()
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.True(t, entries[0].InUse)
+	}
+}
+
+func TestEnforce(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	now := time.Now()
+	oldest := workDir(t, root, "resnet", 500, now.Add(-3*time.Hour))
+	loaded := workDir(t, root, "alexnet", 300, now.Add(-2*time.Hour))
+	older := workDir(t, root, "nin", 200, now.Add(-time.Hour))
+	newest := workDir(t, root, "vgg", 100, now)
+
+	release, err := Acquire(loaded)
+	assert.NoError(t, err)
+	defer release()
+	// Acquire touches the directory, make it old again
+	assert.NoError(t, os.Chtimes(filepath.Join(loaded, LastUsedFile), now.Add(-2*time.Hour), now.Add(-2*time.Hour)))
+
+	m := New(root, 450)
+	evicted, err := m.Enforce(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{oldest, older}, dirs(evicted))
+
+	entries, err := m.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{loaded, newest}, dirs(entries))
+
+	// the lock file of evicted directories is kept for processes waiting on it
+	files, err := ioutil.ReadDir(oldest)
+	assert.NoError(t, err)
+	if assert.Len(t, files, 1) {
+		assert.Equal(t, artifact.LockFile, files[0].Name())
+	}
+
+	// loaded models are kept even when over the quota
+	evicted, err = m.Prune(context.Background(), -1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{newest}, dirs(evicted))
+	entries, err = m.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{loaded}, dirs(entries))
+
+	evicted, err = New(root, 0).Enforce(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, evicted)
+}
+
+func TestEvictLocked(t *testing.T) {
+	root := tempRoot(t)
+	defer os.RemoveAll(root)
+
+	dir := workDir(t, root, "alexnet", 100, time.Now())
+	unlock, err := artifact.Lock(context.Background(), filepath.Join(dir, artifact.LockFile))
+	assert.NoError(t, err)
+	defer unlock()
+
+	evicted, err := New(root, 0).Prune(context.Background(), -1)
+	assert.NoError(t, err)
+	assert.Empty(t, evicted)
+	_, err = os.Stat(filepath.Join(dir, "model.caffemodel"))
+	assert.NoError(t, err)
+}
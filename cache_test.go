@@ -0,0 +1,36 @@
+package tensorrt
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSweepCache(t *testing.T) {
+	delay := cacheSweepDelay
+	defer func() {
+		cacheSweepDelay = delay
+	}()
+	cacheSweepDelay = 50 * time.Millisecond
+
+	var sweeps, running int32
+	cacheSweeper.sweep = func(context.Context) {
+		assert.Equal(t, int32(1), atomic.AddInt32(&running, 1), "sweeps do not overlap")
+		atomic.AddInt32(&sweeps, 1)
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	}
+
+	for ii := 0; ii < 10; ii++ {
+		SweepCache()
+	}
+	time.Sleep(4 * cacheSweepDelay)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&sweeps), "a burst of requests is swept once")
+
+	SweepCache()
+	time.Sleep(4 * cacheSweepDelay)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&sweeps))
+}
@@ -0,0 +1,67 @@
+package tensorrt
+
+import (
+	"github.com/k0kubun/pp"
+	"github.com/rai-project/config"
+	"github.com/rai-project/vipertags"
+)
+
+type tensorrtConfig struct {
+	IgnoreVersionConstraints bool          `json:"ignore_version_constraints" config:"tensorrt.ignore_version_constraints" default:"false"`
+	ModelsDirs               []string      `json:"models_dirs" config:"tensorrt.models_dirs"`
+	WatchModelsDirs          bool          `json:"watch_models_dirs" config:"tensorrt.watch_models_dirs" default:"true"`
+	Offline                  bool          `json:"offline" config:"tensorrt.offline" default:"false"`
+	StoreDir                 string        `json:"store_dir" config:"tensorrt.store_dir"`
+	Mirrors                  []string      `json:"mirrors" config:"tensorrt.mirrors"`
+	RequireChecksums         bool          `json:"require_checksums" config:"tensorrt.require_checksums" default:"false"`
+	DownloadParallelism      int           `json:"download_parallelism" config:"tensorrt.download_parallelism" default:"4"`
+	CacheQuota               string        `json:"cache_quota" config:"tensorrt.cache_quota"`
+	MetricsAddress           string        `json:"metrics_address" config:"tensorrt.metrics_address"`
+	ProfileLayers            bool          `json:"profile_layers" config:"tensorrt.profile_layers" default:"false"`
+	MemoryBudget             string        `json:"memory_budget" config:"tensorrt.memory_budget"`
+	WorkspaceSize            string        `json:"workspace_size" config:"tensorrt.workspace_size" default:"1GiB"`
+	Device                   int           `json:"device" config:"tensorrt.device" default:"0"`
+	done                     chan struct{} `json:"-" config:"-"`
+}
+
+var (
+	// Config holds the configuration of the TensorRT agent
+	Config = &tensorrtConfig{
+		done: make(chan struct{}),
+	}
+)
+
+// ConfigName ...
+func (tensorrtConfig) ConfigName() string {
+	return "TensorRT"
+}
+
+// SetDefaults ...
+func (a *tensorrtConfig) SetDefaults() {
+	vipertags.SetDefaults(a)
+}
+
+// Read ...
+func (a *tensorrtConfig) Read() {
+	defer close(a.done)
+	vipertags.Fill(a)
+}
+
+// Wait ...
+func (c tensorrtConfig) Wait() {
+	<-c.done
+}
+
+// String ...
+func (c tensorrtConfig) String() string {
+	return pp.Sprintln(c)
+}
+
+// Debug ...
+func (c tensorrtConfig) Debug() {
+	log.Debug("TensorRT Config = ", c)
+}
+
+func init() {
+	config.Register(Config)
+}
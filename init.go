@@ -13,8 +13,9 @@ var (
 func init() {
 	config.AfterInit(func() {
 		log = logger.New().WithField("pkg", "tensorrt")
-		if !supportedSystem {
-			log.Error("tensorrt is only available on linux/amd64 and linux/arm64. not registering tensorrt")
+		logVersions()
+		if _, err := CurrentPlatform(); err != nil {
+			log.WithError(err).Error("not registering tensorrt")
 		}
 	})
 }
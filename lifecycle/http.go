@@ -0,0 +1,49 @@
+package lifecycle
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Report is the status of the models of a registry.
+type Report struct {
+	// Ready is set when every model that is neither closed nor failed is
+	// ready
+	Ready bool `json:"ready"`
+	// Failed lists the keys of the models that failed
+	Failed []string `json:"failed,omitempty"`
+	Models []Status `json:"models"`
+}
+
+// Report describes the models of the registry.
+func (r *Registry) Report() Report {
+	report := Report{Ready: r.Ready(), Models: r.List()}
+	for _, status := range r.Failed() {
+		report.Failed = append(report.Failed, status.Key())
+	}
+	return report
+}
+
+// StatusHandler serves the report of r as JSON.
+func StatusHandler(r *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(r.Report())
+	})
+}
+
+// ReadyHandler answers readiness probes: 200 when r is ready, 503 otherwise.
+func ReadyHandler(r *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if r.Ready() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+}
@@ -0,0 +1,269 @@
+// Package lifecycle tracks the state of the models the agent loads, from the
+// download of their files to the close of their predictor, so that
+// orchestrators can wait for them to be ready before routing traffic.
+package lifecycle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// State of a model.
+type State string
+
+// States of a model, in the order they are entered ...
+const (
+	Pending     State = "pending"
+	Downloading State = "downloading"
+	Building    State = "building"
+	Ready       State = "ready"
+	Failed      State = "failed"
+	Draining    State = "draining"
+	Closed      State = "closed"
+)
+
+// transitions lists the states each state may move to.
+var transitions = map[State][]State{
+	Pending:     {Downloading, Building, Ready, Failed, Closed},
+	Downloading: {Building, Ready, Failed, Closed},
+	Building:    {Ready, Failed, Closed},
+	Ready:       {Failed, Draining, Closed},
+	Failed:      {Closed},
+	Draining:    {Closed},
+	Closed:      {},
+}
+
+// Terminal returns whether the model can no longer change state, but to be
+// closed.
+func (s State) Terminal() bool {
+	return s == Failed || s == Closed
+}
+
+// TransitionError is returned when a model is moved to a state it cannot
+// move to from its current state.
+type TransitionError struct {
+	Model string
+	From  State
+	To    State
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("model %s cannot move from %s to %s", e.Model, e.From, e.To)
+}
+
+// Transition records a state change.
+type Transition struct {
+	State State     `json:"state"`
+	Time  time.Time `json:"time"`
+	Error string    `json:"error,omitempty"`
+}
+
+// Status is a snapshot of the lifecycle of a model.
+type Status struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	State   State  `json:"state"`
+	// Since is when the model entered State
+	Since time.Time `json:"since"`
+	// LastError is the error of the last failure, empty when it never failed
+	LastError string       `json:"last_error,omitempty"`
+	History   []Transition `json:"history"`
+}
+
+// Key returns the key of the model, as Key does.
+func (s Status) Key() string {
+	return Key(s.Name, s.Version)
+}
+
+// Key returns the case insensitive key of a model.
+func Key(name, version string) string {
+	return strings.ToLower(name + ":" + version)
+}
+
+// Model is the lifecycle of one load of a model. It is safe for concurrent
+// use.
+type Model struct {
+	mu     sync.Mutex
+	now    func() time.Time
+	status Status
+}
+
+func newModel(name, version string, now func() time.Time) *Model {
+	t := now()
+	return &Model{
+		now: now,
+		status: Status{
+			Name:    name,
+			Version: version,
+			State:   Pending,
+			Since:   t,
+			History: []Transition{{State: Pending, Time: t}},
+		},
+	}
+}
+
+// Set moves the model to state. Moving a model to the state it is in does
+// nothing, other invalid moves return a *TransitionError.
+func (m *Model) Set(state State) error {
+	return m.set(state, "")
+}
+
+// Fail moves the model to Failed, recording err.
+func (m *Model) Fail(err error) error {
+	msg := "unknown error"
+	if err != nil {
+		msg = err.Error()
+	}
+	return m.set(Failed, msg)
+}
+
+func (m *Model) set(state State, msg string) error {
+	if m == nil {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	from := m.status.State
+	if from == state {
+		if msg != "" {
+			m.status.LastError = msg
+		}
+		return nil
+	}
+	allowed := false
+	for _, to := range transitions[from] {
+		allowed = allowed || to == state
+	}
+	if !allowed {
+		return &TransitionError{Model: m.status.Key(), From: from, To: state}
+	}
+	t := m.now()
+	m.status.State = state
+	m.status.Since = t
+	if msg != "" {
+		m.status.LastError = msg
+	}
+	m.status.History = append(m.status.History, Transition{State: state, Time: t, Error: msg})
+	return nil
+}
+
+// State returns the current state of the model, the empty state for a nil
+// model.
+func (m *Model) State() State {
+	if m == nil {
+		return ""
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status.State
+}
+
+// Status returns a snapshot of the lifecycle of the model, the zero Status
+// for a nil model.
+func (m *Model) Status() Status {
+	if m == nil {
+		return Status{}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := m.status
+	status.History = append([]Transition(nil), m.status.History...)
+	return status
+}
+
+// Registry holds the lifecycle of the latest load of every model.
+type Registry struct {
+	// Now returns the time transitions are recorded at, time.Now by default
+	Now func() time.Time
+
+	mu     sync.RWMutex
+	models map[string]*Model
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{Now: time.Now, models: map[string]*Model{}}
+}
+
+// Default is the registry the predictors of the agent report to.
+var Default = NewRegistry()
+
+// Track returns the lifecycle of a new load of the model. A model that is
+// still Pending is reused, so that a load can be announced before it starts;
+// otherwise a new Pending lifecycle replaces the previous one.
+func (r *Registry) Track(name, version string) *Model {
+	key := Key(name, version)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if m, ok := r.models[key]; ok && m.State() == Pending {
+		return m
+	}
+	now := r.Now
+	if now == nil {
+		now = time.Now
+	}
+	m := newModel(name, version, now)
+	r.models[key] = m
+	return m
+}
+
+// Lookup returns the lifecycle of the latest load of a model.
+func (r *Registry) Lookup(name, version string) (*Model, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	m, ok := r.models[Key(name, version)]
+	return m, ok
+}
+
+// Remove forgets the model.
+func (r *Registry) Remove(name, version string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.models, Key(name, version))
+}
+
+// List returns the status of every model, ordered by key.
+func (r *Registry) List() []Status {
+	r.mu.RLock()
+	statuses := make([]Status, 0, len(r.models))
+	for _, m := range r.models {
+		statuses = append(statuses, m.Status())
+	}
+	r.mu.RUnlock()
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Key() < statuses[j].Key()
+	})
+	return statuses
+}
+
+// Ready returns whether at least one model is ready and every model that is
+// neither closed nor failed is. Failed models are reported by Failed, they
+// do not keep the others from serving.
+func (r *Registry) Ready() bool {
+	ready := false
+	for _, s := range r.List() {
+		switch s.State {
+		case Closed, Failed:
+		case Ready:
+			ready = true
+		default:
+			return false
+		}
+	}
+	return ready
+}
+
+// Failed returns the status of the models that failed to load or serve,
+// ordered by key.
+func (r *Registry) Failed() []Status {
+	var failed []Status
+	for _, s := range r.List() {
+		if s.State == Failed {
+			failed = append(failed, s)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,155 @@
+package lifecycle
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRegistry() (*Registry, *time.Time) {
+	r := NewRegistry()
+	now := time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+	r.Now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return r, &now
+}
+
+func TestLifecycle(t *testing.T) {
+	r, now := newTestRegistry()
+	m := r.Track("ResNet50_v1", "1.0")
+	assert.Equal(t, Pending, m.State())
+
+	for _, state := range []State{Downloading, Building, Ready, Draining, Closed} {
+		assert.NoError(t, m.Set(state))
+		assert.Equal(t, state, m.State())
+	}
+	status := m.Status()
+	assert.Equal(t, "resnet50_v1:1.0", status.Key())
+	assert.Equal(t, *now, status.Since)
+	assert.Empty(t, status.LastError)
+	if assert.Len(t, status.History, 6) {
+		assert.Equal(t, Pending, status.History[0].State)
+		assert.True(t, status.History[0].Time.Before(status.History[5].Time))
+	}
+
+	// closed models never change state again
+	err := m.Set(Ready)
+	if assert.IsType(t, &TransitionError{}, err) {
+		assert.Equal(t, "model resnet50_v1:1.0 cannot move from closed to ready", err.Error())
+	}
+	assert.NoError(t, m.Set(Closed))
+	assert.Len(t, m.Status().History, 6)
+}
+
+func TestLifecycleFail(t *testing.T) {
+	r, _ := newTestRegistry()
+	m := r.Track("ResNet50_v1", "1.0")
+	assert.NoError(t, m.Set(Downloading))
+	assert.NoError(t, m.Fail(errors.New("connection reset")))
+
+	status := m.Status()
+	assert.Equal(t, Failed, status.State)
+	assert.Equal(t, "connection reset", status.LastError)
+	assert.Equal(t, "connection reset", status.History[2].Error)
+	assert.True(t, status.State.Terminal())
+
+	assert.Error(t, m.Set(Ready))
+	assert.Error(t, m.Set(Downloading))
+	assert.NoError(t, m.Set(Closed))
+	assert.Equal(t, "connection reset", m.Status().LastError)
+}
+
+func TestLifecycleNil(t *testing.T) {
+	var m *Model
+	assert.NoError(t, m.Set(Ready))
+	assert.NoError(t, m.Fail(nil))
+	assert.Equal(t, State(""), m.State())
+	assert.Equal(t, Status{}, m.Status())
+}
+
+func TestRegistryTrack(t *testing.T) {
+	r, _ := newTestRegistry()
+	announced := r.Track("ResNet50_v1", "1.0")
+	// the load announced as pending is the one that runs
+	m := r.Track("resnet50_V1", "1.0")
+	assert.True(t, announced == m)
+	assert.NoError(t, m.Set(Downloading))
+
+	// a reload replaces it
+	reload := r.Track("ResNet50_v1", "1.0")
+	assert.False(t, reload == m)
+	assert.Equal(t, Pending, reload.State())
+	found, ok := r.Lookup("ResNet50_v1", "1.0")
+	assert.True(t, ok)
+	assert.True(t, found == reload)
+
+	// the previous load still moves on its own
+	assert.NoError(t, m.Set(Ready))
+	assert.Equal(t, Pending, reload.State())
+
+	r.Remove("ResNet50_v1", "1.0")
+	_, ok = r.Lookup("ResNet50_v1", "1.0")
+	assert.False(t, ok)
+}
+
+func TestRegistryReady(t *testing.T) {
+	r, _ := newTestRegistry()
+	assert.False(t, r.Ready(), "no model is tracked")
+
+	resnet := r.Track("ResNet50_v1", "1.0")
+	alexnet := r.Track("AlexNet", "1.0")
+	vgg := r.Track("VGG16", "1.0")
+	assert.NoError(t, resnet.Set(Ready))
+	assert.NoError(t, alexnet.Set(Building))
+	assert.False(t, r.Ready())
+
+	assert.NoError(t, alexnet.Set(Ready))
+	assert.NoError(t, vgg.Fail(errors.New("out of memory")))
+	assert.True(t, r.Ready(), "a failed model does not keep the others from serving")
+	if failed := r.Failed(); assert.Len(t, failed, 1) {
+		assert.Equal(t, "vgg16:1.0", failed[0].Key())
+		assert.Equal(t, "out of memory", failed[0].LastError)
+	}
+
+	assert.NoError(t, vgg.Set(Closed))
+	assert.True(t, r.Ready())
+	assert.Empty(t, r.Failed())
+
+	assert.NoError(t, resnet.Fail(errors.New("out of memory")))
+	assert.NoError(t, alexnet.Fail(errors.New("out of memory")))
+	assert.False(t, r.Ready(), "no model is ready")
+
+	var keys []string
+	for _, s := range r.List() {
+		keys = append(keys, s.Key())
+	}
+	assert.Equal(t, []string{"alexnet:1.0", "resnet50_v1:1.0", "vgg16:1.0"}, keys)
+}
+
+func TestHandlers(t *testing.T) {
+	r, _ := newTestRegistry()
+	assert.NoError(t, r.Track("ResNet50_v1", "1.0").Set(Ready))
+	assert.NoError(t, r.Track("VGG16", "1.0").Fail(errors.New("out of memory")))
+
+	w := httptest.NewRecorder()
+	ReadyHandler(r).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	StatusHandler(r).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	var report Report
+	assert.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	assert.Equal(t, Report{Ready: true, Failed: []string{"vgg16:1.0"}, Models: r.List()}, report)
+
+	w = httptest.NewRecorder()
+	StatusHandler(r).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
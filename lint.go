@@ -0,0 +1,37 @@
+package tensorrt
+
+import (
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// NewLinter returns a manifest linter that checks manifests against
+// FrameworkManifest, with the TensorRT version advertised by the agent.
+func NewLinter() manifest.Linter {
+	return newLinter(FrameworkManifest.Version)
+}
+
+// newLinter returns a manifest linter that checks manifests against
+// FrameworkManifest at the given TensorRT version.
+func newLinter(version string) manifest.Linter {
+	containers := map[string]manifest.Container{}
+	for arch, c := range FrameworkManifest.Container {
+		if c == nil {
+			continue
+		}
+		containers[arch] = manifest.Container{Cpu: c.Cpu, Gpu: c.Gpu}
+	}
+	return manifest.Linter{
+		Framework: manifest.Framework{
+			Name:    FrameworkManifest.Name,
+			Version: version,
+		},
+		Containers: containers,
+	}
+}
+
+// LintBuiltinModels lints the manifests compiled into the agent. They are
+// checked against CompiledVersion, the version they are written for, and not
+// against the version detected on the host.
+func LintBuiltinModels() ([]manifest.Issue, error) {
+	return newLinter(CompiledVersion).LintFS(BuiltinModels)
+}
@@ -0,0 +1,34 @@
+package tensorrt
+
+import (
+	"testing"
+
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltinModelsLint(t *testing.T) {
+	issues, err := LintBuiltinModels()
+	assert.NoError(t, err)
+	for _, issue := range issues {
+		if issue.Severity == manifest.SeverityError {
+			t.Error(issue)
+		} else {
+			t.Log(issue)
+		}
+	}
+}
+
+func TestBuiltinModelsLintDetectedVersion(t *testing.T) {
+	version := FrameworkManifest.Version
+	defer func() {
+		FrameworkManifest.Version = version
+	}()
+	FrameworkManifest.Version = "1.0.0"
+
+	issues, err := LintBuiltinModels()
+	assert.NoError(t, err)
+	for _, issue := range issues {
+		assert.NotEqual(t, manifest.SeverityError, issue.Severity, "%v", issue)
+	}
+}
@@ -0,0 +1,17 @@
+package tensorrt
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rai-project/config"
+)
+
+func TestMain(m *testing.M) {
+	config.Init(
+		config.AppName("carml"),
+		config.DebugMode(true),
+		config.VerboseMode(true),
+	)
+	os.Exit(m.Run())
+}
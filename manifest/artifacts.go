@@ -0,0 +1,82 @@
+package manifest
+
+import (
+	"strings"
+
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/rai-project/tensorrt/plan"
+)
+
+// EngineMetadataAttribute overrides the URL of the metadata of a prebuilt
+// engine. By default the metadata is expected next to the plan, at the graph
+// URL with plan.MetadataExtension appended.
+const EngineMetadataAttribute = "engine_metadata_url"
+
+// ArchiveDirAttribute names the directory of an archive model holding its
+// graph and weights. Only that directory is extracted, and graph_path and
+// weights_path are relative to it.
+const ArchiveDirAttribute = "archive_dir"
+
+// GraphURL returns the URL the graph, or the archive, is downloaded from.
+func (m Manifest) GraphURL() string {
+	if m.Model.IsArchive {
+		return m.Model.BaseUrl
+	}
+	return joinURL(m.Model.BaseUrl, m.Model.GraphPath)
+}
+
+// WeightsURL returns the URL the weights, or the archive, are downloaded from.
+func (m Manifest) WeightsURL() string {
+	if m.Model.IsArchive {
+		return m.Model.BaseUrl
+	}
+	return joinURL(m.Model.BaseUrl, m.Model.WeightsPath)
+}
+
+// Artifacts lists the files the model is downloaded from. The paths of the
+// artifacts are left empty, they depend on the model work directory.
+func (m Manifest) Artifacts() ([]artifact.Artifact, error) {
+	format, err := m.Format()
+	if err != nil {
+		return nil, err
+	}
+
+	files := []artifact.Artifact{{Kind: artifact.Graph, URL: m.GraphURL(), Checksum: m.Model.GraphChecksum}}
+	if format.HasWeights() {
+		files = append(files, artifact.Artifact{Kind: artifact.Weights, URL: m.WeightsURL(), Checksum: m.Model.WeightsChecksum})
+	}
+
+	var artifacts []artifact.Artifact
+	if m.Model.IsArchive {
+		artifacts = append(artifacts, artifact.Artifact{
+			Kind:     artifact.Archive,
+			URL:      m.Model.BaseUrl,
+			Contents: files,
+			Dir:      m.Attributes[ArchiveDirAttribute],
+		})
+	} else {
+		artifacts = append(artifacts, files...)
+	}
+	if format == FormatEngine {
+		url := m.Attributes[EngineMetadataAttribute]
+		if url == "" {
+			url = plan.MetadataPath(m.GraphURL())
+		}
+		artifacts = append(artifacts, artifact.Artifact{Kind: artifact.EngineMetadata, URL: url})
+	}
+	if url := stringParameter(m.Output.Parameters, "features_url"); url != "" {
+		artifacts = append(artifacts, artifact.Artifact{
+			Kind:     artifact.Features,
+			URL:      url,
+			Checksum: stringParameter(m.Output.Parameters, "features_checksum"),
+		})
+	}
+	return artifacts, nil
+}
+
+func joinURL(base, path string) string {
+	if base == "" {
+		return path
+	}
+	return strings.TrimSuffix(base, "/") + "/" + strings.TrimPrefix(path, "/")
+}
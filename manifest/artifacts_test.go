@@ -0,0 +1,50 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArtifacts(t *testing.T) {
+	m, err := Parse([]byte(cleanManifest))
+	assert.NoError(t, err)
+	artifacts, err := m.Artifacts()
+	assert.NoError(t, err)
+
+	kinds := []string{}
+	for _, a := range artifacts {
+		kinds = append(kinds, a.Kind)
+		assert.NotEmpty(t, a.URL)
+		assert.Empty(t, a.Path)
+	}
+	assert.Equal(t, []string{artifact.Graph, artifact.Weights, artifact.Features}, kinds)
+	assert.Equal(t, m.GraphURL(), artifacts[0].URL)
+	assert.Equal(t, m.Model.GraphChecksum, artifacts[0].Checksum)
+
+	engine := Manifest{
+		Name:       "ResNet50_v1",
+		Model:      Model{BaseUrl: "http://example.com/models/", GraphPath: "/resnet50.plan"},
+		Attributes: map[string]string{FormatAttribute: "engine"},
+	}
+	artifacts, err = engine.Artifacts()
+	assert.NoError(t, err)
+	if assert.Len(t, artifacts, 2) {
+		assert.Equal(t, "http://example.com/models/resnet50.plan", artifacts[0].URL)
+		assert.Equal(t, artifact.EngineMetadata, artifacts[1].Kind)
+		assert.Equal(t, "http://example.com/models/resnet50.plan.json", artifacts[1].URL)
+	}
+
+	archive := Manifest{
+		Model:      Model{BaseUrl: "http://example.com/model.tar.gz", GraphPath: "deploy.prototxt", WeightsPath: "w.caffemodel", IsArchive: true},
+		Attributes: map[string]string{ArchiveDirAttribute: "resnet50/v1"},
+	}
+	artifacts, err = archive.Artifacts()
+	assert.NoError(t, err)
+	if assert.Len(t, artifacts, 1) {
+		assert.Equal(t, artifact.Archive, artifacts[0].Kind)
+		assert.Equal(t, "resnet50/v1", artifacts[0].Dir)
+		assert.Len(t, artifacts[0].Contents, 2)
+	}
+}
@@ -0,0 +1,94 @@
+package manifest
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the serialization format of a model graph. It selects the
+// TensorRT parser used to build the engine.
+type Format string
+
+const (
+	FormatCaffe  Format = "caffe"
+	FormatEngine Format = "engine"
+)
+
+// FormatAttribute is the manifest attribute used to declare the model format.
+// When it is absent the format is detected from the graph path.
+const FormatAttribute = "model_format"
+
+var (
+	formats = []Format{FormatCaffe, FormatEngine}
+
+	formatExtensions = map[string]Format{
+		".prototxt":   FormatCaffe,
+		".caffemodel": FormatCaffe,
+		".plan":       FormatEngine,
+		".engine":     FormatEngine,
+		".trt":        FormatEngine,
+	}
+
+	// unsupportedExtensions are the extensions of the graphs of the formats
+	// the linked go-tensorrt has no parser for, refused rather than handed
+	// to the Caffe parser
+	unsupportedExtensions = map[string]string{
+		".onnx": "onnx",
+		".uff":  "uff",
+	}
+)
+
+// ParseFormat parses a declared model format.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	for _, known := range formats {
+		if f == known {
+			return f, nil
+		}
+	}
+	return "", errors.Errorf("unknown model format %q", s)
+}
+
+// DetectFormat guesses the model format from the extension of the graph path.
+// Paths without a recognized extension are assumed to be caffe graphs, which
+// is what every manifest written before formats existed describes.
+func DetectFormat(graphPath string) Format {
+	ext := strings.ToLower(path.Ext(stripQuery(graphPath)))
+	if f, ok := formatExtensions[ext]; ok {
+		return f
+	}
+	return FormatCaffe
+}
+
+// ResolveFormat returns the declared format if there is one, and the detected
+// format otherwise. Graphs of unsupported formats are refused whatever the
+// declared format.
+func ResolveFormat(declared, graphPath string) (Format, error) {
+	ext := strings.ToLower(path.Ext(stripQuery(graphPath)))
+	if name, ok := unsupportedExtensions[ext]; ok {
+		return "", errors.Errorf("the %s format of %s is not supported, only caffe models and engine plans are", name, graphPath)
+	}
+	if strings.TrimSpace(declared) == "" {
+		return DetectFormat(graphPath), nil
+	}
+	return ParseFormat(declared)
+}
+
+// HasWeights reports whether models of this format keep their weights in a
+// file separate from the graph.
+func (f Format) HasWeights() bool {
+	return f == FormatCaffe
+}
+
+func (f Format) String() string {
+	return string(f)
+}
+
+func stripQuery(url string) string {
+	if idx := strings.IndexAny(url, "?#"); idx >= 0 {
+		return url[:idx]
+	}
+	return url
+}
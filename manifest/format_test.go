@@ -0,0 +1,44 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectFormat(t *testing.T) {
+	cases := map[string]Format{
+		"https://raw.githubusercontent.com/BVLC/caffe/master/models/bvlc_alexnet/deploy.prototxt": FormatCaffe,
+		"resnet50_fp16.plan":              FormatEngine,
+		"resnet50.engine?token=abc":       FormatEngine,
+		"http://example.com/models/graph": FormatCaffe,
+	}
+	for graphPath, expected := range cases {
+		assert.Equal(t, expected, DetectFormat(graphPath), graphPath)
+	}
+}
+
+func TestResolveFormat(t *testing.T) {
+	format, err := ResolveFormat("", "model.plan")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatEngine, format)
+
+	format, err = ResolveFormat(" Engine ", "http://example.com/download?id=42")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatEngine, format)
+
+	_, err = ResolveFormat("tensorflow", "model.pb")
+	assert.Error(t, err)
+
+	for _, declared := range []string{"", "onnx", "uff", "caffe"} {
+		_, err = ResolveFormat(declared, "http://example.com/models/resnet50.ONNX")
+		assert.Error(t, err, "declared %q", declared)
+		_, err = ResolveFormat(declared, "ssd.uff?token=abc")
+		assert.Error(t, err, "declared %q", declared)
+	}
+}
+
+func TestFormatHasWeights(t *testing.T) {
+	assert.True(t, FormatCaffe.HasWeights())
+	assert.False(t, FormatEngine.HasWeights())
+}
@@ -0,0 +1,26 @@
+package manifest
+
+import (
+	"io/fs"
+
+	"github.com/pkg/errors"
+)
+
+// FindFS returns the paths of the manifests in fsys and its subdirectories,
+// in lexical order.
+func FindFS(fsys fs.FS) ([]string, error) {
+	var paths []string
+	err := fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && IsManifestFile(path) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot walk the manifests")
+	}
+	return paths, nil
+}
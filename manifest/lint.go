@@ -0,0 +1,424 @@
+package manifest
+
+import (
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/artifact"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Severity is how serious a lint issue is. Errors describe manifests that are
+// wrong or will not load, warnings describe manifests that load but are
+// incomplete.
+type Severity int
+
+const (
+	SeverityWarning Severity = iota
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Issue is a single problem found in a manifest.
+type Issue struct {
+	File     string
+	Model    string
+	Severity Severity
+	Rule     string
+	Message  string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: [%s] %s", i.File, i.Severity, i.Rule, i.Message)
+}
+
+// HasErrors reports whether any of the issues is an error.
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	knownInputParameters = []string{
+		"color_mode", "dimensions", "element_type", "input_layer", "layout", "mean", "scale",
+	}
+	knownOutputParameters = []string{
+		"element_type", "features_checksum", "features_url", "probabilities_layer",
+	}
+	topLevelFields = []string{
+		"name", "framework", "version", "container", "description", "references",
+		"license", "inputs", "output", "model", "attributes", "hidden",
+	}
+)
+
+// Linter checks manifests against the schema and against the framework that
+// will serve them. It never touches the network.
+type Linter struct {
+	// Framework is the name and version of the framework the manifests are
+	// registered with. The manifests' version constraints are checked against
+	// it.
+	Framework Framework
+	// Containers are the container images the framework advertises, keyed by
+	// architecture.
+	Containers map[string]Container
+}
+
+// LintFiles lints the manifests at the given paths. Directories are searched
+// for .yml and .yaml files.
+func (l Linter) LintFiles(paths ...string) ([]Issue, error) {
+	var issues []Issue
+	for _, path := range paths {
+		files, err := manifestFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot read %s", file)
+			}
+			issues = append(issues, l.Lint(file, data)...)
+		}
+	}
+	return issues, nil
+}
+
+// LintFS lints the manifests in fsys. Issues are labelled with the paths
+// within fsys.
+func (l Linter) LintFS(fsys fs.FS) ([]Issue, error) {
+	paths, err := FindFS(fsys)
+	if err != nil {
+		return nil, err
+	}
+	var issues []Issue
+	for _, path := range paths {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read %s", path)
+		}
+		issues = append(issues, l.Lint(path, data)...)
+	}
+	return issues, nil
+}
+
+func manifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot lint %s", path)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && IsManifestFile(file) {
+			files = append(files, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot walk %s", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// IsManifestFile reports whether the file name has a YAML extension.
+func IsManifestFile(file string) bool {
+	ext := strings.ToLower(filepath.Ext(file))
+	return ext == ".yml" || ext == ".yaml"
+}
+
+// Lint checks a single manifest. file is only used to label the issues.
+func (l Linter) Lint(file string, data []byte) []Issue {
+	r := &report{file: file}
+
+	m, err := Parse(data)
+	if err != nil {
+		r.errorf("schema", "%v", err)
+		return r.issues
+	}
+	r.model = m.CanonicalName()
+
+	if err := yaml.UnmarshalStrict(data, new(Manifest)); err != nil {
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			for _, msg := range typeErr.Errors {
+				r.errorf("schema", "%s", msg)
+			}
+		} else {
+			r.errorf("schema", "%v", err)
+		}
+	}
+
+	l.lintModel(r, m)
+	l.lintFramework(r, m)
+	l.lintContainers(r, m)
+	l.lintChecksums(r, m)
+	l.lintLayers(r, m)
+	l.lintParameters(r, m)
+	l.lintAttributes(r, m)
+
+	return r.issues
+}
+
+func (l Linter) lintModel(r *report, m *Manifest) {
+	if err := m.Validate(); err != nil {
+		r.errorf("model", "%v", err)
+	}
+	if m.Version != "" {
+		if _, err := semver.NewVersion(m.Version); err != nil {
+			r.errorf("schema", "model version %q is not a semantic version", m.Version)
+		}
+	}
+	if len(m.Inputs) == 0 {
+		r.errorf("schema", "model has no inputs")
+	}
+	for ii, input := range m.Inputs {
+		if input.Type == "" {
+			r.errorf("schema", "input %d has no type", ii)
+		}
+	}
+	if m.Output.Type == "" {
+		r.errorf("schema", "output has no type")
+	}
+}
+
+func (l Linter) lintFramework(r *report, m *Manifest) {
+	if l.Framework.Name != "" && !strings.EqualFold(m.Framework.Name, l.Framework.Name) {
+		r.errorf("framework", "framework %q is not %s", m.Framework.Name, l.Framework.Name)
+	}
+	if m.Framework.Version == "" {
+		r.errorf("framework", "framework version constraint is missing")
+		return
+	}
+	if l.Framework.Version == "" {
+		return
+	}
+	ok, err := ConstraintMatches(m.Framework.Version, l.Framework.Version)
+	if err != nil {
+		r.errorf("framework", "%v", err)
+		return
+	}
+	if !ok {
+		r.errorf("framework", "framework version constraint %q does not match %s %s",
+			m.Framework.Version, l.Framework.Name, l.Framework.Version)
+	}
+}
+
+// ConstraintMatches reports whether version satisfies the framework version
+// constraint of a manifest.
+func ConstraintMatches(constraint, version string) (bool, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid framework version constraint %q", constraint)
+	}
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid framework version %q", version)
+	}
+	return c.Check(v), nil
+}
+
+func (l Linter) lintContainers(r *report, m *Manifest) {
+	archs := make([]string, 0, len(m.Container))
+	for arch := range m.Container {
+		archs = append(archs, arch)
+	}
+	sort.Strings(archs)
+
+	for _, arch := range archs {
+		c := m.Container[arch]
+		gpuIsCpu := imageTagHas(c.Gpu, "cpu")
+		cpuIsGpu := imageTagHas(c.Cpu, "gpu")
+		if gpuIsCpu && cpuIsGpu {
+			r.errorf("container", "%s cpu and gpu container images are swapped (gpu: %s, cpu: %s)", arch, c.Gpu, c.Cpu)
+			continue
+		}
+		if gpuIsCpu {
+			r.errorf("container", "%s gpu container %s is a cpu image", arch, c.Gpu)
+			continue
+		}
+		if l.Containers == nil {
+			continue
+		}
+		advertised, ok := l.Containers[arch]
+		if !ok {
+			r.warnf("container", "%s is not an architecture the framework provides containers for", arch)
+			continue
+		}
+		if c.Gpu != advertised.Gpu {
+			r.warnf("container", "%s gpu container %s differs from the framework's %s", arch, c.Gpu, advertised.Gpu)
+		}
+		switch {
+		case c.Cpu == advertised.Cpu:
+		case advertised.Cpu == "":
+			r.warnf("container", "%s cpu container %s is not used, the framework needs a gpu", arch, c.Cpu)
+		default:
+			r.warnf("container", "%s cpu container %s differs from the framework's %s", arch, c.Cpu, advertised.Cpu)
+		}
+	}
+}
+
+// imageTagHas reports whether the tag of a container image mentions the
+// given device, e.g. "amd64-cpu" for "cpu".
+func imageTagHas(image, device string) bool {
+	idx := strings.LastIndex(image, ":")
+	if idx < 0 {
+		return false
+	}
+	for _, part := range strings.FieldsFunc(image[idx+1:], func(r rune) bool { return r == '-' || r == '_' || r == '.' }) {
+		if part == device {
+			return true
+		}
+	}
+	return false
+}
+
+func (l Linter) lintChecksums(r *report, m *Manifest) {
+	format, err := m.Format()
+	if err != nil {
+		return
+	}
+	if !m.Model.IsArchive {
+		if m.Model.GraphPath != "" && m.Model.GraphChecksum == "" {
+			r.warnf("checksum", "graph_path has no graph_checksum")
+		}
+		if format.HasWeights() && m.Model.WeightsPath != "" && m.Model.WeightsChecksum == "" {
+			r.warnf("checksum", "weights_path has no weights_checksum")
+		}
+	}
+	if stringParameter(m.Output.Parameters, "features_url") != "" && stringParameter(m.Output.Parameters, "features_checksum") == "" {
+		r.warnf("checksum", "features_url has no features_checksum")
+	}
+
+	checksums := []struct{ field, value string }{
+		{"graph_checksum", m.Model.GraphChecksum},
+		{"weights_checksum", m.Model.WeightsChecksum},
+		{"features_checksum", stringParameter(m.Output.Parameters, "features_checksum")},
+	}
+	for _, c := range checksums {
+		if c.value == "" {
+			continue
+		}
+		if _, err := artifact.ParseChecksum(c.value); err != nil {
+			r.errorf("checksum", "%s: %v", c.field, err)
+		}
+	}
+}
+
+func (l Linter) lintLayers(r *report, m *Manifest) {
+	for ii, input := range m.Inputs {
+		if stringParameter(input.Parameters, "input_layer") == "" {
+			r.warnf("layer", "input %d does not name its layer (input_layer)", ii)
+		}
+	}
+	if m.Output.Type != "" && stringParameter(m.Output.Parameters, "probabilities_layer") == "" {
+		r.warnf("layer", "output does not name its layer (probabilities_layer)")
+	}
+}
+
+func (l Linter) lintParameters(r *report, m *Manifest) {
+	for ii, input := range m.Inputs {
+		for _, name := range unknownKeys(input.Parameters, knownInputParameters) {
+			r.errorf("parameter", "input %d has unknown parameter %q", ii, name)
+		}
+	}
+	for _, name := range unknownKeys(m.Output.Parameters, knownOutputParameters) {
+		r.errorf("parameter", "output has unknown parameter %q", name)
+	}
+}
+
+func (l Linter) lintAttributes(r *report, m *Manifest) {
+	for _, field := range topLevelFields {
+		if _, ok := m.Attributes[field]; ok {
+			r.errorf("attribute", "attribute %q has no effect, %s is a top-level field", field, field)
+		}
+	}
+	if _, ok := m.Attributes[FormatAttribute]; ok {
+		if _, err := m.Format(); err != nil {
+			r.errorf("attribute", "%v", err)
+		}
+	}
+	if dir, ok := m.Attributes[ArchiveDirAttribute]; ok {
+		clean := path.Clean(strings.Replace(dir, `\`, "/", -1))
+		switch {
+		case !m.Model.IsArchive:
+			r.warnf("attribute", "%s has no effect, the model is not an archive", ArchiveDirAttribute)
+		case path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../"):
+			r.errorf("attribute", "%s %q is not a relative path inside the archive", ArchiveDirAttribute, dir)
+		}
+	}
+}
+
+func unknownKeys(params map[string]interface{}, known []string) []string {
+	var unknown []string
+	for name := range params {
+		if !contains(known, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+func contains(lst []string, s string) bool {
+	for _, e := range lst {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func stringParameter(params map[string]interface{}, name string) string {
+	v, ok := params[name]
+	if !ok || v == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(v))
+}
+
+type report struct {
+	file   string
+	model  string
+	issues []Issue
+}
+
+func (r *report) add(severity Severity, rule, format string, args ...interface{}) {
+	r.issues = append(r.issues, Issue{
+		File:     r.file,
+		Model:    r.model,
+		Severity: severity,
+		Rule:     rule,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *report) errorf(rule, format string, args ...interface{}) {
+	r.add(SeverityError, rule, format, args...)
+}
+
+func (r *report) warnf(rule, format string, args ...interface{}) {
+	r.add(SeverityWarning, rule, format, args...)
+}
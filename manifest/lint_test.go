@@ -0,0 +1,282 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testLinter = Linter{
+	Framework: Framework{Name: "TensorRT", Version: "7.0.0"},
+	Containers: map[string]Container{
+		"amd64": {
+			Gpu: "raiproject/carml-tensorrt:amd64-gpu",
+		},
+	},
+}
+
+const cleanManifest = `
+name: ResNet50_v1
+framework:
+  name: TensorRT
+  version: 7.0.0
+version: 1.0
+container:
+  amd64:
+    gpu: raiproject/carml-tensorrt:amd64-gpu
+inputs:
+  - type: image
+    parameters:
+      input_layer: 'data'
+      dimensions: [3, 224, 224]
+      mean: [123.68, 116.779, 103.939]
+output:
+  type: classification
+  parameters:
+    probabilities_layer: 'prob'
+    features_url: http://s3.amazonaws.com/store.carml.org/synsets/imagenet/synset.txt
+    features_checksum: 4d234b5833aca44928065a180db3016a
+model:
+  graph_path: http://s3.amazonaws.com/store.carml.org/models/caffe/resnet50/ResNet-50-deploy.prototxt
+  weights_path: http://s3.amazonaws.com/store.carml.org/models/caffe/resnet50/ResNet-50-model.caffemodel
+  is_archive: false
+  graph_checksum: 4ba3f945e7b86b07648e4f4351de0699
+  weights_checksum: 44b20660c5948391734036963e855dd2
+attributes:
+  kind: CNN
+`
+
+const driftedManifest = `
+name: NIN
+framework:
+  name: TensorRT
+  version: 6.0.1
+version: 1.0
+container:
+  amd64:
+    gpu: raiproject/carml-tensorrt:amd64-cpu
+    cpu: raiproject/carml-tensorrt:amd64-gpu
+inputs:
+  - type: image
+    parameters:
+      layer_name: data
+      dimensions: [3, 224, 224]
+output:
+  type: feature
+  parameters:
+    features_url: http://s3.amazonaws.com/store.carml.org/synsets/imagenet/synset.txt
+model:
+  model_type: caffe
+  graph_path: http://s3.amazonaws.com/store.carml.org/models/caffe/nin/deploy.prototxt
+  weights_path: http://s3.amazonaws.com/store.carml.org/models/caffe/nin/nin_imagenet.caffemodel
+  is_archive: false
+attributes:
+  kind: CNN
+  hidden: true
+`
+
+func issuesByRule(issues []Issue) map[string][]Issue {
+	rules := map[string][]Issue{}
+	for _, issue := range issues {
+		rules[issue.Rule] = append(rules[issue.Rule], issue)
+	}
+	return rules
+}
+
+func TestLintClean(t *testing.T) {
+	issues := testLinter.Lint("ResNet50_v1.yml", []byte(cleanManifest))
+	assert.Empty(t, issues)
+	assert.False(t, HasErrors(issues))
+}
+
+func TestLintDrifted(t *testing.T) {
+	issues := testLinter.Lint("NIN.yml", []byte(driftedManifest))
+	assert.True(t, HasErrors(issues))
+
+	rules := issuesByRule(issues)
+	assert.Len(t, rules["schema"], 1)
+	assert.Contains(t, rules["schema"][0].Message, "model_type")
+	assert.Len(t, rules["framework"], 1)
+	assert.Contains(t, rules["framework"][0].Message, `"6.0.1"`)
+	assert.Len(t, rules["container"], 1)
+	assert.Contains(t, rules["container"][0].Message, "swapped")
+	assert.Len(t, rules["checksum"], 3)
+	assert.Len(t, rules["layer"], 2)
+	assert.Len(t, rules["parameter"], 1)
+	assert.Contains(t, rules["parameter"][0].Message, "layer_name")
+	assert.Len(t, rules["attribute"], 1)
+
+	for _, issue := range issues {
+		assert.Equal(t, "NIN.yml", issue.File)
+		assert.Equal(t, "NIN:1.0", issue.Model)
+	}
+}
+
+func TestLintInvalidYaml(t *testing.T) {
+	issues := testLinter.Lint("broken.yml", []byte("name: [unterminated"))
+	assert.Len(t, issues, 1)
+	assert.Equal(t, SeverityError, issues[0].Severity)
+}
+
+func TestLintContainers(t *testing.T) {
+	m := `
+name: m
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+container:
+  amd64:
+    gpu: raiproject/carml-tensorrt:amd64-cpu
+    cpu: raiproject/carml-tensorrt:amd64-cpu
+  arm64:
+    gpu: raiproject/carml-tensorrt:arm64-gpu
+    cpu: raiproject/carml-tensorrt:arm64-gpu
+inputs: [{type: image, parameters: {input_layer: data}}]
+output: {type: classification, parameters: {probabilities_layer: prob}}
+model: {graph_path: m.plan, graph_checksum: 4ba3f945e7b86b07648e4f4351de0699}
+`
+	rules := issuesByRule(testLinter.Lint("m.yml", []byte(m)))
+	assert.Len(t, rules["container"], 2)
+	assert.Equal(t, SeverityError, rules["container"][0].Severity)
+	assert.Contains(t, rules["container"][0].Message, "amd64 gpu container")
+	assert.Equal(t, SeverityWarning, rules["container"][1].Severity)
+	assert.Contains(t, rules["container"][1].Message, "arm64")
+}
+
+func TestConstraintMatches(t *testing.T) {
+	ok, err := ConstraintMatches("7.0.0", "7.0.0")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ConstraintMatches(">=6.0.1", "7.0.0")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ConstraintMatches("6.0.1", "7.0.0")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, err = ConstraintMatches("not a constraint", "7.0.0")
+	assert.Error(t, err)
+}
+
+func TestLintFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifest-lint")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "ResNet50_v1.yml"), []byte(cleanManifest), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "NIN.yaml"), []byte(driftedManifest), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# models"), 0644))
+
+	issues, err := testLinter.LintFiles(dir)
+	assert.NoError(t, err)
+	assert.True(t, HasErrors(issues))
+	for _, issue := range issues {
+		assert.Equal(t, filepath.Join(dir, "NIN.yaml"), issue.File)
+	}
+
+	_, err = testLinter.LintFiles(filepath.Join(dir, "missing.yml"))
+	assert.Error(t, err)
+}
+
+func TestLintFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"ResNet50_v1.yml":  {Data: []byte(cleanManifest)},
+		"drifted/NIN.yaml": {Data: []byte(driftedManifest)},
+		"README.md":        {Data: []byte("# models")},
+	}
+
+	paths, err := FindFS(fsys)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ResNet50_v1.yml", "drifted/NIN.yaml"}, paths)
+
+	issues, err := testLinter.LintFS(fsys)
+	assert.NoError(t, err)
+	assert.True(t, HasErrors(issues))
+	for _, issue := range issues {
+		assert.Equal(t, "drifted/NIN.yaml", issue.File)
+	}
+}
+
+func TestLintChecksums(t *testing.T) {
+	m := `
+name: m
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+inputs: [{type: image, parameters: {input_layer: data}}]
+output: {type: classification, parameters: {probabilities_layer: prob, features_url: http://example.com/synset.txt, features_checksum: abc}}
+model:
+  graph_path: m.plan
+  graph_checksum: sha256:9c56cc51b374c3ba189210d5b6d4bf57790d351c96c47c02190ecf1e430635ab
+`
+	rules := issuesByRule(testLinter.Lint("m.yml", []byte(m)))
+	if assert.Len(t, rules["checksum"], 1) {
+		assert.Equal(t, SeverityError, rules["checksum"][0].Severity)
+		assert.Contains(t, rules["checksum"][0].Message, "features_checksum")
+	}
+}
+
+func TestLintArchiveDir(t *testing.T) {
+	m := `
+name: m
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+inputs: [{type: image, parameters: {input_layer: data}}]
+output: {type: classification, parameters: {probabilities_layer: prob}}
+model: {base_url: http://example.com/m.tar.gz, graph_path: m.plan, is_archive: %v, graph_checksum: 4ba3f945e7b86b07648e4f4351de0699}
+attributes: {archive_dir: %q}
+`
+	rules := issuesByRule(testLinter.Lint("m.yml", []byte(fmt.Sprintf(m, true, "models/m"))))
+	assert.Empty(t, rules["attribute"])
+
+	rules = issuesByRule(testLinter.Lint("m.yml", []byte(fmt.Sprintf(m, true, "../m"))))
+	if assert.Len(t, rules["attribute"], 1) {
+		assert.Equal(t, SeverityError, rules["attribute"][0].Severity)
+	}
+
+	rules = issuesByRule(testLinter.Lint("m.yml", []byte(fmt.Sprintf(m, false, "models/m"))))
+	if assert.Len(t, rules["attribute"], 1) {
+		assert.Equal(t, SeverityWarning, rules["attribute"][0].Severity)
+	}
+}
+
+func TestLintUnsupportedFormat(t *testing.T) {
+	m := `
+name: m
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+inputs: [{type: image, parameters: {input_layer: data}}]
+output: {type: classification, parameters: {probabilities_layer: prob}}
+model: {graph_path: m.onnx, graph_checksum: 4ba3f945e7b86b07648e4f4351de0699}
+`
+	rules := issuesByRule(testLinter.Lint("m.yml", []byte(m)))
+	if assert.Len(t, rules["model"], 1) {
+		assert.Equal(t, SeverityError, rules["model"][0].Severity)
+		assert.Contains(t, rules["model"][0].Message, "onnx format")
+	}
+}
+
+func TestLintCpuContainer(t *testing.T) {
+	m := `
+name: m
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+container:
+  amd64:
+    gpu: raiproject/carml-tensorrt:amd64-gpu
+    cpu: raiproject/carml-tensorrt:amd64-cpu
+inputs: [{type: image, parameters: {input_layer: data}}]
+output: {type: classification, parameters: {probabilities_layer: prob}}
+model: {graph_path: m.plan, graph_checksum: 4ba3f945e7b86b07648e4f4351de0699}
+`
+	rules := issuesByRule(testLinter.Lint("m.yml", []byte(m)))
+	if assert.Len(t, rules["container"], 1) {
+		assert.Equal(t, SeverityWarning, rules["container"][0].Severity)
+		assert.Contains(t, rules["container"][0].Message, "needs a gpu")
+	}
+}
@@ -0,0 +1,113 @@
+package manifest
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Manifest mirrors the YAML layout of a model manifest in builtin_models. It
+// lets the agent inspect manifests before they are handed to dlframework.
+type Manifest struct {
+	Name        string               `yaml:"name"`
+	Framework   Framework            `yaml:"framework"`
+	Version     string               `yaml:"version"`
+	Container   map[string]Container `yaml:"container"`
+	Description string               `yaml:"description"`
+	References  []string             `yaml:"references"`
+	License     string               `yaml:"license"`
+	Inputs      []Type               `yaml:"inputs"`
+	Output      Type                 `yaml:"output"`
+	Model       Model                `yaml:"model"`
+	Attributes  map[string]string    `yaml:"attributes"`
+	Hidden      bool                 `yaml:"hidden"`
+}
+
+// Framework is the framework name and version constraint of a manifest.
+type Framework struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// Container lists the container images for one architecture.
+type Container struct {
+	Cpu string `yaml:"cpu"`
+	Gpu string `yaml:"gpu"`
+}
+
+// Type describes a model input or output.
+type Type struct {
+	Type        string                 `yaml:"type"`
+	Description string                 `yaml:"description"`
+	Parameters  map[string]interface{} `yaml:"parameters"`
+}
+
+// Model describes where the model graph and weights are fetched from.
+type Model struct {
+	BaseUrl         string `yaml:"base_url"`
+	GraphPath       string `yaml:"graph_path"`
+	WeightsPath     string `yaml:"weights_path"`
+	IsArchive       bool   `yaml:"is_archive"`
+	GraphChecksum   string `yaml:"graph_checksum"`
+	WeightsChecksum string `yaml:"weights_checksum"`
+}
+
+// Parse decodes a YAML model manifest.
+func Parse(data []byte) (*Manifest, error) {
+	m := new(Manifest)
+	if err := yaml.Unmarshal(data, m); err != nil {
+		return nil, errors.Wrap(err, "failed to parse model manifest")
+	}
+	return m, nil
+}
+
+// CanonicalName returns the name:version pair used to look the model up.
+func (m Manifest) CanonicalName() string {
+	return m.Name + ":" + m.Version
+}
+
+// Format resolves the model format of the manifest.
+func (m Manifest) Format() (Format, error) {
+	return ResolveFormat(m.Attributes[FormatAttribute], m.Model.GraphPath)
+}
+
+// Validate checks that the manifest describes a model the predictor can load.
+func (m Manifest) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return errors.New("model name is missing")
+	}
+	if strings.TrimSpace(m.Version) == "" {
+		return errors.Errorf("model %v has no version", m.Name)
+	}
+	if m.Framework.Name == "" {
+		return errors.Errorf("model %v has no framework name", m.CanonicalName())
+	}
+	if m.Model.IsArchive && m.Model.BaseUrl == "" {
+		return errors.Errorf("model %v is an archive but has no base_url", m.CanonicalName())
+	}
+	format, err := m.Format()
+	if err != nil {
+		return errors.Wrapf(err, "model %v", m.CanonicalName())
+	}
+	return ValidateSource(format, m.Model.GraphPath, m.Model.WeightsPath)
+}
+
+// ValidateSource checks that the graph and weights paths match what the
+// format expects: caffe models need both, engine plans are described by the
+// graph path alone.
+func ValidateSource(format Format, graphPath, weightsPath string) error {
+	if graphPath == "" {
+		return errors.Errorf("%v model has no graph_path", format)
+	}
+	if format.HasWeights() {
+		if weightsPath == "" {
+			return errors.Errorf("%v model has no weights_path", format)
+		}
+		return nil
+	}
+	if weightsPath != "" {
+		return errors.Errorf("%v model is described by graph_path only, but weights_path is set", format)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const engineManifest = `
+name: ResNet50_Engine
+framework:
+  name: TensorRT
+  version: 7.0.0
+version: 1.0
+inputs:
+  - type: image
+    parameters:
+      dimensions: [3, 224, 224]
+output:
+  type: classification
+model:
+  graph_path: http://s3.amazonaws.com/store.carml.org/models/engine/resnet50.plan
+  is_archive: false
+  graph_checksum: 4ba3f945e7b86b07648e4f4351de0699
+attributes:
+  model_format: engine
+`
+
+func TestParse(t *testing.T) {
+	m, err := Parse([]byte(engineManifest))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.Equal(t, "ResNet50_Engine:1.0", m.CanonicalName())
+	assert.Equal(t, "7.0.0", m.Framework.Version)
+	assert.Equal(t, "image", m.Inputs[0].Type)
+
+	format, err := m.Format()
+	assert.NoError(t, err)
+	assert.Equal(t, FormatEngine, format)
+	assert.NoError(t, m.Validate())
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse([]byte("name: [unterminated"))
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	m, err := Parse([]byte(engineManifest))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	withWeights := *m
+	withWeights.Model.WeightsPath = "http://example.com/resnet50.caffemodel"
+	assert.Error(t, withWeights.Validate())
+
+	caffe := *m
+	caffe.Attributes = map[string]string{FormatAttribute: "caffe"}
+	assert.Error(t, caffe.Validate())
+	caffe.Model.WeightsPath = "http://example.com/resnet50.caffemodel"
+	assert.NoError(t, caffe.Validate())
+
+	unknown := *m
+	unknown.Attributes = map[string]string{FormatAttribute: "tflite"}
+	assert.Error(t, unknown.Validate())
+
+	onnx := *m
+	onnx.Attributes = nil
+	onnx.Model.GraphPath = "http://s3.amazonaws.com/store.carml.org/models/onnx/resnet50.onnx"
+	assert.Error(t, onnx.Validate())
+
+	archive := *m
+	archive.Model.IsArchive = true
+	assert.Error(t, archive.Validate())
+
+	noName := *m
+	noName.Name = ""
+	assert.Error(t, noName.Validate())
+}
+
+func TestValidateSource(t *testing.T) {
+	assert.NoError(t, ValidateSource(FormatCaffe, "deploy.prototxt", "model.caffemodel"))
+	assert.Error(t, ValidateSource(FormatCaffe, "deploy.prototxt", ""))
+	assert.NoError(t, ValidateSource(FormatEngine, "model.plan", ""))
+	assert.Error(t, ValidateSource(FormatEngine, "", ""))
+}
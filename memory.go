@@ -0,0 +1,45 @@
+package tensorrt
+
+import (
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/memory"
+)
+
+// MemoryBudget returns the device memory the loaded models may use in bytes,
+// 0 when there is no budget.
+func MemoryBudget() (int64, error) {
+	if Config.MemoryBudget == "" {
+		return 0, nil
+	}
+	budget, err := humanize.ParseBytes(Config.MemoryBudget)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid tensorrt.memory_budget %q", Config.MemoryBudget)
+	}
+	return int64(budget), nil
+}
+
+// WorkspaceSize returns the workspace the engines are estimated to use in
+// bytes, before they are built.
+func WorkspaceSize() (int64, error) {
+	if Config.WorkspaceSize == "" {
+		return 0, nil
+	}
+	size, err := humanize.ParseBytes(Config.WorkspaceSize)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid tensorrt.workspace_size %q", Config.WorkspaceSize)
+	}
+	return int64(size), nil
+}
+
+// ReserveMemory reserves the device memory of a model in memory.Default. It
+// returns a *memory.BudgetError when the models would use more than
+// tensorrt.memory_budget.
+func ReserveMemory(model string, u memory.Usage) (*memory.Reservation, error) {
+	budget, err := MemoryBudget()
+	if err != nil {
+		return nil, err
+	}
+	memory.Default.SetLimit(budget)
+	return memory.Default.Reserve(model, u)
+}
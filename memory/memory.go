@@ -0,0 +1,188 @@
+// Package memory accounts the device memory of the loaded models: the
+// engine, its workspace and its binding buffers. A budget bounds the memory
+// the models of an agent may use, so models can be packed on shared GPUs.
+package memory
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+// Float32Size is the size of the elements of float32 bindings.
+const Float32Size = 4
+
+// Binding is the device buffer of an input or output tensor.
+type Binding struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+	// IsInput is set for input bindings
+	IsInput bool `json:"is_input"`
+}
+
+// Usage is the estimated device memory of a model. go-tensorrt does not
+// report the memory of the engines it builds, so the engine is estimated at
+// the size of the model files and the workspace at its configured size.
+type Usage struct {
+	// Engine is the size of the deserialized engine, its weights
+	Engine int64 `json:"engine_bytes"`
+	// Workspace is the scratch memory of the engine
+	Workspace int64     `json:"workspace_bytes"`
+	Bindings  []Binding `json:"bindings,omitempty"`
+}
+
+// BindingBytes returns the size of the binding buffers.
+func (u Usage) BindingBytes() int64 {
+	var total int64
+	for _, b := range u.Bindings {
+		total += b.Bytes
+	}
+	return total
+}
+
+// Total ...
+func (u Usage) Total() int64 {
+	return u.Engine + u.Workspace + u.BindingBytes()
+}
+
+// TensorBytes returns the size of a buffer of batchSize tensors of the
+// given dimensions, excluding the batch dimension. Dimensions that are not
+// known, below 1, count as 1.
+func TensorBytes(batchSize int, dims []int, elementSize int) int64 {
+	n := int64(batchSize) * int64(elementSize)
+	for _, d := range dims {
+		if d > 1 {
+			n *= int64(d)
+		}
+	}
+	return n
+}
+
+// BudgetError is returned when loading a model would exceed the budget.
+type BudgetError struct {
+	Model    string
+	Required int64
+	Used     int64
+	Limit    int64
+}
+
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("model %s needs %s of device memory, %s of the %s budget are used",
+		e.Model, humanize.IBytes(uint64(e.Required)), humanize.IBytes(uint64(e.Used)), humanize.IBytes(uint64(e.Limit)))
+}
+
+// IsBudgetError reports whether the cause of err is a *BudgetError.
+func IsBudgetError(err error) bool {
+	_, ok := errors.Cause(err).(*BudgetError)
+	return ok
+}
+
+// Entry is the memory accounted to a model.
+type Entry struct {
+	Model string `json:"model"`
+	Usage Usage  `json:"usage"`
+}
+
+// Budget accounts the device memory of models against a limit. It is safe
+// for concurrent use.
+type Budget struct {
+	mu           sync.Mutex
+	limit        int64
+	reservations map[*Reservation]struct{}
+}
+
+// NewBudget returns a budget of limit bytes, 0 for no limit.
+func NewBudget(limit int64) *Budget {
+	return &Budget{limit: limit, reservations: map[*Reservation]struct{}{}}
+}
+
+// Default is the budget of the models of the agent.
+var Default = NewBudget(0)
+
+// Limit returns the budget in bytes, 0 when there is no limit.
+func (b *Budget) Limit() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.limit
+}
+
+// SetLimit changes the budget, the memory already reserved is kept.
+func (b *Budget) SetLimit(limit int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.limit = limit
+}
+
+// Used returns the memory reserved by all the models.
+func (b *Budget) Used() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.used()
+}
+
+func (b *Budget) used() int64 {
+	var used int64
+	for r := range b.reservations {
+		used += r.usage.Total()
+	}
+	return used
+}
+
+// Reserve accounts u to a model, unless the models would then use more than
+// the limit. The memory stays reserved until the reservation is released.
+func (b *Budget) Reserve(model string, u Usage) (*Reservation, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	used := b.used()
+	if b.limit > 0 && used+u.Total() > b.limit {
+		return nil, &BudgetError{Model: model, Required: u.Total(), Used: used, Limit: b.limit}
+	}
+	r := &Reservation{b: b, model: model, usage: u}
+	b.reservations[r] = struct{}{}
+	return r, nil
+}
+
+// Entries returns the memory reserved per model, sorted by model.
+func (b *Budget) Entries() []Entry {
+	b.mu.Lock()
+	entries := make([]Entry, 0, len(b.reservations))
+	for r := range b.reservations {
+		entries = append(entries, Entry{Model: r.model, Usage: r.usage})
+	}
+	b.mu.Unlock()
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Model < entries[j].Model
+	})
+	return entries
+}
+
+// Reservation is the memory reserved for a loaded model. The methods of a
+// nil *Reservation do nothing.
+type Reservation struct {
+	b     *Budget
+	model string
+	usage Usage
+}
+
+// Usage returns the reserved memory.
+func (r *Reservation) Usage() Usage {
+	if r == nil {
+		return Usage{}
+	}
+	r.b.mu.Lock()
+	defer r.b.mu.Unlock()
+	return r.usage
+}
+
+// Release frees the reserved memory.
+func (r *Reservation) Release() {
+	if r == nil {
+		return
+	}
+	r.b.mu.Lock()
+	defer r.b.mu.Unlock()
+	delete(r.b.reservations, r)
+}
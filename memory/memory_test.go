@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+const mb = 1 << 20
+
+// usage returns a fake usage of engine, workspace and one input and one
+// output binding, in MiB.
+func usage(engine, workspace, input, output int64) Usage {
+	return Usage{
+		Engine:    engine * mb,
+		Workspace: workspace * mb,
+		Bindings: []Binding{
+			{Name: "data", Bytes: input * mb, IsInput: true},
+			{Name: "prob", Bytes: output * mb},
+		},
+	}
+}
+
+func TestUsage(t *testing.T) {
+	u := usage(100, 50, 4, 1)
+	assert.Equal(t, int64(5*mb), u.BindingBytes())
+	assert.Equal(t, int64(155*mb), u.Total())
+	assert.Equal(t, int64(0), Usage{}.Total())
+
+	// 8 images of 3x224x224 float32
+	assert.Equal(t, int64(8*3*224*224*4), TensorBytes(8, []int{3, 224, 224}, Float32Size))
+	assert.Equal(t, int64(8*1000*4), TensorBytes(8, []int{-1, 1000}, Float32Size))
+}
+
+func TestBudget(t *testing.T) {
+	b := NewBudget(1000 * mb)
+	resnet, err := b.Reserve("resnet50_v1:1.0", usage(100, 256, 4, 1))
+	assert.NoError(t, err)
+	vgg, err := b.Reserve("vgg16:1.0", usage(500, 128, 4, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(994*mb), b.Used())
+
+	r, err := b.Reserve("alexnet:1.0", usage(200, 64, 4, 1))
+	assert.Nil(t, r)
+	if assert.Error(t, err) {
+		assert.True(t, IsBudgetError(errors.Wrap(err, "load")))
+		e := err.(*BudgetError)
+		assert.Equal(t, "alexnet:1.0", e.Model)
+		assert.Equal(t, int64(269*mb), e.Required)
+		assert.Equal(t, int64(994*mb), e.Used)
+		assert.Equal(t, "model alexnet:1.0 needs 269 MiB of device memory, 994 MiB of the 1000 MiB budget are used", err.Error())
+	}
+	assert.Equal(t, int64(994*mb), b.Used(), "refused models are not accounted")
+
+	vgg.Release()
+	vgg.Release()
+	_, err = b.Reserve("alexnet:1.0", usage(200, 64, 4, 1))
+	assert.NoError(t, err)
+	// a model loaded twice is accounted twice
+	_, err = b.Reserve("alexnet:1.0", usage(200, 64, 4, 1))
+	assert.NoError(t, err)
+	entries := b.Entries()
+	if assert.Len(t, entries, 3) {
+		assert.Equal(t, []string{"alexnet:1.0", "alexnet:1.0", "resnet50_v1:1.0"}, []string{entries[0].Model, entries[1].Model, entries[2].Model})
+		assert.Equal(t, int64(269*mb), entries[0].Usage.Total())
+	}
+	assert.Equal(t, int64(899*mb), b.Used())
+
+	resnet.Release()
+	assert.Equal(t, int64(538*mb), b.Used())
+}
+
+func TestBudgetUnlimited(t *testing.T) {
+	b := NewBudget(0)
+	_, err := b.Reserve("vgg16:1.0", usage(1<<20, 0, 0, 0))
+	assert.NoError(t, err)
+	b.SetLimit(100 * mb)
+	assert.Equal(t, int64(100*mb), b.Limit())
+	_, err = b.Reserve("alexnet:1.0", usage(1, 0, 0, 0))
+	assert.True(t, IsBudgetError(err))
+
+	var r *Reservation
+	assert.Equal(t, Usage{}, r.Usage())
+	r.Release()
+}
+
+func TestBudgetConcurrent(t *testing.T) {
+	b := NewBudget(10 * mb)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		reserved int
+	)
+	for ii := 0; ii < 20; ii++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := b.Reserve("alexnet:1.0", usage(1, 0, 0, 0)); err == nil {
+				mu.Lock()
+				reserved++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 10, reserved)
+	assert.Equal(t, int64(10*mb), b.Used())
+}
@@ -0,0 +1,50 @@
+package tensorrt
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+var registration struct {
+	sync.RWMutex
+	ignoreVersionConstraints bool
+	skipped                  []string
+}
+
+func setRegistration(ignoreVersionConstraints bool, skipped []string) {
+	registration.Lock()
+	defer registration.Unlock()
+	registration.ignoreVersionConstraints = ignoreVersionConstraints
+	registration.skipped = skipped
+}
+
+// Metadata describes the TensorRT library the agent serves models with and
+// the outcome of the last model registration.
+func Metadata() map[string]string {
+	registration.RLock()
+	defer registration.RUnlock()
+
+	constraints := "enforced"
+	if registration.ignoreVersionConstraints {
+		constraints = "ignored"
+	}
+	return map[string]string{
+		"tensorrt_version":          FrameworkManifest.Version,
+		"compiled_tensorrt_version": CompiledVersion,
+		"cuda_version":              LinkedVersions.CUDA,
+		"cuda_driver_version":       LinkedVersions.CUDADriver,
+		"cudnn_version":             LinkedVersions.CUDNN,
+		"version_constraints":       constraints,
+		"skipped_models":            strings.Join(registration.skipped, ","),
+	}
+}
+
+func metadataFields() logrus.Fields {
+	fields := logrus.Fields{}
+	for k, v := range Metadata() {
+		fields[k] = v
+	}
+	return fields
+}
@@ -0,0 +1,51 @@
+package tensorrt
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/metrics"
+)
+
+// Paths of the metrics server.
+const (
+	// MetricsPath is the path the Prometheus metrics are served at
+	MetricsPath = "/metrics"
+	// StatusPath is the path the lifecycle of the loaded models is served at
+	StatusPath = "/v1/status"
+	// ReadyPath answers readiness probes, as the inference servers do
+	ReadyPath = "/v2/health/ready"
+)
+
+// metricsHandler serves the metrics and the lifecycle of the models the
+// agent loads.
+func metricsHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, metrics.Handler())
+	mux.Handle(StatusPath, lifecycle.StatusHandler(lifecycle.Default))
+	mux.Handle(ReadyPath, lifecycle.ReadyHandler(lifecycle.Default))
+	return mux
+}
+
+// ServeMetrics serves the Prometheus metrics and the status of the models on
+// Config.MetricsAddress in the background. No server is returned when the
+// address is not configured.
+func ServeMetrics() (*http.Server, error) {
+	if Config.MetricsAddress == "" {
+		return nil, nil
+	}
+	lis, err := net.Listen("tcp", Config.MetricsAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot listen on tensorrt.metrics_address %s", Config.MetricsAddress)
+	}
+	srv := &http.Server{Handler: metricsHandler()}
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Error("stopped serving metrics")
+		}
+	}()
+	log.WithField("address", lis.Addr().String()).Info("serving metrics")
+	return srv, nil
+}
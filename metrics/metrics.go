@@ -0,0 +1,294 @@
+// Package metrics records Prometheus metrics of the models the agent serves:
+// requests, errors, latencies, batch sizes, queue depths, load durations and
+// download sizes, labeled by model name and version.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/memory"
+)
+
+// Namespace prefixes the names of the metrics.
+const Namespace = "tensorrt"
+
+// Stages of a prediction ...
+const (
+	Preprocess  = "preprocess"
+	Inference   = "inference"
+	Postprocess = "postprocess"
+)
+
+// Stages of the inference of a prediction, see backend.Timings ...
+const (
+	Compute      = "compute"
+	DeviceToHost = "device_to_host"
+)
+
+// Phases of the load of a model ...
+const (
+	Download = "download"
+	Build    = "build"
+)
+
+// Kinds of device memory ...
+const (
+	EngineMemory    = "engine"
+	WorkspaceMemory = "workspace"
+	BindingsMemory  = "bindings"
+)
+
+// Types of errors ...
+const (
+	InputError       = "input"
+	UnavailableError = "unavailable"
+	CanceledError    = "canceled"
+	TimeoutError     = "timeout"
+	InternalError    = "internal"
+)
+
+// ErrorType classifies err into one of the types of errors.
+func ErrorType(err error) string {
+	switch cause := errors.Cause(err); {
+	case backend.IsInputError(err):
+		return InputError
+	case cause == backend.ErrUnavailable:
+		return UnavailableError
+	case cause == context.Canceled:
+		return CanceledError
+	case cause == context.DeadlineExceeded:
+		return TimeoutError
+	default:
+		return InternalError
+	}
+}
+
+// Metrics holds the collectors of the metrics.
+type Metrics struct {
+	Requests        *prometheus.CounterVec
+	Errors          *prometheus.CounterVec
+	Latency         *prometheus.HistogramVec
+	Inference       *prometheus.HistogramVec
+	BatchSize       *prometheus.HistogramVec
+	QueueDepth      *prometheus.GaugeVec
+	LoadDuration    *prometheus.HistogramVec
+	DownloadBytes   *prometheus.CounterVec
+	EstimatedMemory *prometheus.GaugeVec
+	DeviceMemory    *prometheus.GaugeVec
+}
+
+var modelLabels = []string{"model", "version"}
+
+// New returns the metrics registered with reg.
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		Requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "requests_total",
+			Help:      "Prediction requests.",
+		}, modelLabels),
+		Errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "errors_total",
+			Help:      "Failed prediction requests, by type of error.",
+		}, append(modelLabels, "type")),
+		Latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "latency_seconds",
+			Help:      "Latency of the preprocess, inference and postprocess stages of the predictions.",
+			Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 16),
+		}, append(modelLabels, "stage")),
+		Inference: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "inference_stage_seconds",
+			Help:      "Duration of the compute stage, which includes the copy of the inputs to the device, and of the device to host copy stage of the inferences.",
+			Buckets:   prometheus.ExponentialBuckets(0.00005, 2, 18),
+		}, append(modelLabels, "stage")),
+		BatchSize: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "batch_size",
+			Help:      "Batch size of the predictions.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}, modelLabels),
+		QueueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "queue_depth",
+			Help:      "Predictions waiting for or running on the model.",
+		}, modelLabels),
+		LoadDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "load_duration_seconds",
+			Help:      "Duration of the download and build phases of the loads of the models.",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 14),
+		}, append(modelLabels, "phase")),
+		DownloadBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "download_bytes_total",
+			Help:      "Bytes of model artifacts downloaded.",
+		}, modelLabels),
+		EstimatedMemory: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "estimated_device_memory_bytes",
+			Help:      "Estimated device memory of the engine, workspace and bindings of the loaded models.",
+		}, append(modelLabels, "kind")),
+		DeviceMemory: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "device_memory_bytes",
+			Help:      "Device memory the engine builds of the loaded models took, measured as the drop of the free memory of the device.",
+		}, modelLabels),
+	}
+	reg.MustRegister(m.Requests, m.Errors, m.Latency, m.Inference, m.BatchSize, m.QueueDepth, m.LoadDuration, m.DownloadBytes, m.EstimatedMemory, m.DeviceMemory)
+	return m
+}
+
+// Default are the metrics registered with the default Prometheus registry.
+var Default = New(prometheus.DefaultRegisterer)
+
+// Handler serves the metrics of the default Prometheus registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Model returns the metrics of a model. The metrics of a nil *Metrics are
+// not recorded.
+func (m *Metrics) Model(name, version string) *Model {
+	if m == nil {
+		return nil
+	}
+	return &Model{m: m, labels: prometheus.Labels{"model": name, "version": version}}
+}
+
+// Model records the metrics of a model.
+type Model struct {
+	m      *Metrics
+	labels prometheus.Labels
+}
+
+func (m *Model) with(name, value string) prometheus.Labels {
+	labels := prometheus.Labels{name: value}
+	for k, v := range m.labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// Request counts a prediction request.
+func (m *Model) Request() {
+	if m == nil {
+		return
+	}
+	m.m.Requests.With(m.labels).Inc()
+}
+
+// Error counts a failed request, err is classified with ErrorType.
+func (m *Model) Error(err error) {
+	if m == nil || err == nil {
+		return
+	}
+	m.m.Errors.With(m.with("type", ErrorType(err))).Inc()
+}
+
+// Observe records the latency of a stage of a prediction.
+func (m *Model) Observe(stage string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.m.Latency.With(m.with("stage", stage)).Observe(d.Seconds())
+}
+
+// Since records the latency of a stage started at start and returns the
+// current time, to start the next stage at.
+func (m *Model) Since(stage string, start time.Time) time.Time {
+	now := time.Now()
+	m.Observe(stage, now.Sub(start))
+	return now
+}
+
+// ObserveStage records the duration of a stage of an inference.
+func (m *Model) ObserveStage(stage string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.m.Inference.With(m.with("stage", stage)).Observe(d.Seconds())
+}
+
+// ObserveTimings records the stages of an inference, timings that are zero
+// were not measured and are not recorded.
+func (m *Model) ObserveTimings(t backend.Timings) {
+	if t.IsZero() {
+		return
+	}
+	m.ObserveStage(Compute, t.Compute)
+	m.ObserveStage(DeviceToHost, t.DeviceToHost)
+}
+
+// Batch records the batch size of a prediction.
+func (m *Model) Batch(size int) {
+	if m == nil {
+		return
+	}
+	m.m.BatchSize.With(m.labels).Observe(float64(size))
+}
+
+// Enqueue counts a prediction in the queue of the model until the returned
+// function is called.
+func (m *Model) Enqueue() func() {
+	if m == nil {
+		return func() {}
+	}
+	g := m.m.QueueDepth.With(m.labels)
+	g.Inc()
+	return g.Dec
+}
+
+// Load records the duration of a phase of the load of the model.
+func (m *Model) Load(phase string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.m.LoadDuration.With(m.with("phase", phase)).Observe(d.Seconds())
+}
+
+// Downloaded counts bytes of artifacts downloaded for the model.
+func (m *Model) Downloaded(bytes int64) {
+	if m == nil || bytes <= 0 {
+		return
+	}
+	m.m.DownloadBytes.With(m.labels).Add(float64(bytes))
+}
+
+// Memory records the estimated device memory of the loaded model.
+func (m *Model) Memory(u memory.Usage) {
+	if m == nil {
+		return
+	}
+	m.m.EstimatedMemory.With(m.with("kind", EngineMemory)).Set(float64(u.Engine))
+	m.m.EstimatedMemory.With(m.with("kind", WorkspaceMemory)).Set(float64(u.Workspace))
+	m.m.EstimatedMemory.With(m.with("kind", BindingsMemory)).Set(float64(u.BindingBytes()))
+}
+
+// MeasuredMemory records the measured device memory of the loaded model.
+func (m *Model) MeasuredMemory(bytes int64) {
+	if m == nil {
+		return
+	}
+	m.m.DeviceMemory.With(m.labels).Set(float64(bytes))
+}
+
+// ForgetMemory stops reporting the device memory of the model, once it is
+// closed.
+func (m *Model) ForgetMemory() {
+	if m == nil {
+		return
+	}
+	m.m.DeviceMemory.Delete(m.labels)
+	for _, kind := range []string{EngineMemory, WorkspaceMemory, BindingsMemory} {
+		m.m.EstimatedMemory.Delete(m.with("kind", kind))
+	}
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+// scrape returns the metrics of reg in the Prometheus text format.
+func scrape(t *testing.T, reg *prometheus.Registry) string {
+	ts := httptest.NewServer(promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	defer ts.Close()
+	resp, err := http.Get(ts.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func TestErrorType(t *testing.T) {
+	assert.Equal(t, InputError, ErrorType(&backend.InputError{Message: "bad shape"}))
+	assert.Equal(t, UnavailableError, ErrorType(errors.Wrap(backend.ErrUnavailable, "draining")))
+	assert.Equal(t, CanceledError, ErrorType(context.Canceled))
+	assert.Equal(t, TimeoutError, ErrorType(errors.Wrap(context.DeadlineExceeded, "predict")))
+	assert.Equal(t, InternalError, ErrorType(errors.New("cuda error")))
+}
+
+func TestModel(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := New(reg).Model("ResNet50_v1", "1.0")
+
+	m.Request()
+	m.Request()
+	m.Error(&backend.InputError{Message: "bad shape"})
+	m.Error(nil)
+	m.Observe(Preprocess, 2*time.Millisecond)
+	m.Observe(Inference, 10*time.Millisecond)
+	m.ObserveTimings(backend.Timings{Compute: 4 * time.Millisecond, DeviceToHost: time.Millisecond})
+	m.ObserveTimings(backend.Timings{})
+	m.Batch(4)
+	done := m.Enqueue()
+	m.Enqueue()
+	done()
+	m.Load(Download, 3*time.Second)
+	m.Downloaded(1024)
+	m.Downloaded(1024)
+	m.Memory(memory.Usage{Engine: 100, Workspace: 50, Bindings: []memory.Binding{{Name: "data", Bytes: 8}, {Name: "prob", Bytes: 2}}})
+	m.MeasuredMemory(130)
+
+	body := scrape(t, reg)
+	for _, line := range []string{
+		`tensorrt_requests_total{model="ResNet50_v1",version="1.0"} 2`,
+		`tensorrt_errors_total{model="ResNet50_v1",type="input",version="1.0"} 1`,
+		`tensorrt_latency_seconds_count{model="ResNet50_v1",stage="preprocess",version="1.0"} 1`,
+		`tensorrt_latency_seconds_sum{model="ResNet50_v1",stage="inference",version="1.0"} 0.01`,
+		`tensorrt_inference_stage_seconds_count{model="ResNet50_v1",stage="device_to_host",version="1.0"} 1`,
+		`tensorrt_inference_stage_seconds_sum{model="ResNet50_v1",stage="compute",version="1.0"} 0.004`,
+		`tensorrt_batch_size_bucket{model="ResNet50_v1",version="1.0",le="4"} 1`,
+		`tensorrt_batch_size_bucket{model="ResNet50_v1",version="1.0",le="2"} 0`,
+		`tensorrt_queue_depth{model="ResNet50_v1",version="1.0"} 1`,
+		`tensorrt_load_duration_seconds_count{model="ResNet50_v1",phase="download",version="1.0"} 1`,
+		`tensorrt_download_bytes_total{model="ResNet50_v1",version="1.0"} 2048`,
+		`tensorrt_estimated_device_memory_bytes{kind="engine",model="ResNet50_v1",version="1.0"} 100`,
+		`tensorrt_estimated_device_memory_bytes{kind="workspace",model="ResNet50_v1",version="1.0"} 50`,
+		`tensorrt_estimated_device_memory_bytes{kind="bindings",model="ResNet50_v1",version="1.0"} 10`,
+		`tensorrt_device_memory_bytes{model="ResNet50_v1",version="1.0"} 130`,
+	} {
+		assert.Contains(t, body, line)
+	}
+	assert.NotContains(t, body, `stage="postprocess"`)
+
+	m.ForgetMemory()
+	body = scrape(t, reg)
+	assert.NotContains(t, body, "tensorrt_estimated_device_memory_bytes{")
+	assert.NotContains(t, body, "tensorrt_device_memory_bytes{")
+}
+
+func TestNilMetrics(t *testing.T) {
+	var metrics *Metrics
+	m := metrics.Model("ResNet50_v1", "1.0")
+	assert.Nil(t, m)
+	m.Request()
+	m.Error(errors.New("cuda error"))
+	m.Observe(Inference, time.Millisecond)
+	m.Since(Postprocess, time.Now())
+	m.ObserveTimings(backend.Timings{Compute: time.Millisecond})
+	m.Batch(1)
+	m.Enqueue()()
+	m.Load(Build, time.Second)
+	m.Downloaded(1)
+	m.Memory(memory.Usage{Engine: 1})
+	m.MeasuredMemory(1)
+	m.ForgetMemory()
+}
@@ -0,0 +1,47 @@
+package tensorrt
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHandlerStatus(t *testing.T) {
+	defer lifecycle.Default.Remove("ResNet50_v1", "1.0")
+	defer lifecycle.Default.Remove("AlexNet", "1.0")
+	ts := httptest.NewServer(metricsHandler())
+	defer ts.Close()
+
+	get := func(path string) *http.Response {
+		resp, err := http.Get(ts.URL + path)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	resp := get(ReadyPath)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "no model is loaded")
+
+	assert.NoError(t, lifecycle.Default.Track("ResNet50_v1", "1.0").Set(lifecycle.Ready))
+	assert.NoError(t, lifecycle.Default.Track("AlexNet", "1.0").Fail(errors.New("out of memory")))
+	resp = get(ReadyPath)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = get(StatusPath)
+	defer resp.Body.Close()
+	var report lifecycle.Report
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	assert.True(t, report.Ready)
+	assert.Equal(t, []string{"alexnet:1.0"}, report.Failed)
+	assert.Len(t, report.Models, 2)
+
+	resp = get(MetricsPath)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,127 @@
+package tensorrt
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// readModelsDir reads the manifests in dir and its subdirectories, in
+// lexical order.
+func readModelsDir(dir string) ([]manifestFile, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read models directory %s", dir)
+	}
+	if !info.IsDir() {
+		return nil, errors.Errorf("models directory %s is not a directory", dir)
+	}
+
+	files, err := readManifestsFS(os.DirFS(dir), dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read models directory %s", dir)
+	}
+	return files, nil
+}
+
+// modelConflict records a manifest that was dropped because another manifest
+// declares the same model name and version.
+type modelConflict struct {
+	Model   string
+	Kept    string
+	Dropped string
+	// Override is set when a user manifest replaced a builtin one.
+	Override bool
+}
+
+// mergeManifests combines the builtin manifests with the ones read from the
+// models directories. Models are identified by name and version, ignoring
+// case, and conflicts are resolved as follows:
+//
+//   - a user manifest overrides the builtin manifest of the same model;
+//   - when several user manifests declare the same model, the first one wins,
+//     in the order the directories are configured and then in lexical order
+//     within a directory.
+//
+// User manifests that cannot be parsed are skipped.
+func mergeManifests(builtin, user []manifestFile) ([]manifestFile, []modelConflict, []skippedModel) {
+	var conflicts []modelConflict
+	var skipped []skippedModel
+
+	merged := make([]manifestFile, 0, len(builtin)+len(user))
+	index := map[string]int{}
+	fromUser := map[string]bool{}
+
+	add := func(file manifestFile, isUser bool) {
+		m, err := manifest.Parse(file.Data)
+		if err != nil {
+			skipped = append(skipped, skippedModel{File: file.Name, Reason: err.Error()})
+			return
+		}
+		name := m.CanonicalName()
+		key := strings.ToLower(name)
+		idx, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			fromUser[key] = isUser
+			merged = append(merged, file)
+			return
+		}
+		if isUser && !fromUser[key] {
+			conflicts = append(conflicts, modelConflict{Model: name, Kept: file.Name, Dropped: merged[idx].Name, Override: true})
+			merged[idx] = file
+			fromUser[key] = true
+			return
+		}
+		conflicts = append(conflicts, modelConflict{Model: name, Kept: merged[idx].Name, Dropped: file.Name})
+	}
+
+	for _, file := range builtin {
+		add(file, false)
+	}
+	for _, file := range user {
+		add(file, true)
+	}
+	return merged, conflicts, skipped
+}
+
+// userManifests reads the manifests of every configured models directory.
+// Directories that cannot be read are logged and skipped.
+func userManifests(dirs []string) []manifestFile {
+	var files []manifestFile
+	for _, dir := range dirs {
+		dirFiles, err := readModelsDir(dir)
+		if err != nil {
+			log.WithError(err).Error("not loading the models in " + dir)
+			continue
+		}
+		log.WithField("dir", dir).WithField("manifests", len(dirFiles)).Info("loaded models directory")
+		files = append(files, dirFiles...)
+	}
+	return files
+}
+
+// collectManifests returns the builtin manifests merged with the manifests of
+// the configured models directories.
+func collectManifests() ([]manifestFile, error) {
+	builtin, err := builtinManifests()
+	if err != nil {
+		return nil, err
+	}
+
+	merged, conflicts, skipped := mergeManifests(builtin, userManifests(Config.ModelsDirs))
+	for _, c := range conflicts {
+		entry := log.WithField("model", c.Model).WithField("kept", c.Kept).WithField("dropped", c.Dropped)
+		if c.Override {
+			entry.Info("user manifest overrides the builtin model")
+		} else {
+			entry.Warn("model is declared by more than one manifest, keeping the first one")
+		}
+	}
+	for _, s := range skipped {
+		log.WithField("file", s.File).Error(s.Reason + ", not registering it")
+	}
+	return merged, nil
+}
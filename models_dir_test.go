@@ -0,0 +1,81 @@
+package tensorrt
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeManifest(t *testing.T, dir, file string, m manifestFile) string {
+	path := filepath.Join(dir, file)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NoError(t, ioutil.WriteFile(path, m.Data, 0644))
+	return path
+}
+
+func TestReadModelsDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "models-dir")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	writeManifest(t, dir, "b.yml", testManifest("Private-B", "7.0.0"))
+	writeManifest(t, dir, "nested/a.yaml", testManifest("Private-A", "7.0.0"))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("# models"), 0644))
+
+	files, err := readModelsDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, filepath.Join(dir, "b.yml"), files[0].Name)
+	assert.Equal(t, filepath.Join(dir, "nested", "a.yaml"), files[1].Name)
+
+	_, err = readModelsDir(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+	_, err = readModelsDir(filepath.Join(dir, "b.yml"))
+	assert.Error(t, err)
+}
+
+func TestMergeManifests(t *testing.T) {
+	builtin := []manifestFile{
+		testManifest("ResNet50_v1", "7.0.0"),
+		testManifest("VGG16", "7.0.0"),
+	}
+
+	override := testManifest("resnet50_V1", ">=7.0.0")
+	override.Name = "/models/a/resnet50.yml"
+	private := testManifest("Private", "7.0.0")
+	private.Name = "/models/a/private.yml"
+	duplicate := testManifest("Private", "7.0.0")
+	duplicate.Name = "/models/b/private.yml"
+	broken := manifestFile{Name: "/models/b/broken.yml", Data: []byte("name: [")}
+
+	merged, conflicts, skipped := mergeManifests(builtin, []manifestFile{override, private, duplicate, broken})
+	assert.Len(t, merged, 3)
+	assert.Equal(t, "/models/a/resnet50.yml", merged[0].Name)
+	assert.Equal(t, "VGG16.yml", merged[1].Name)
+	assert.Equal(t, "/models/a/private.yml", merged[2].Name)
+
+	assert.Len(t, conflicts, 2)
+	assert.True(t, conflicts[0].Override)
+	assert.Equal(t, "ResNet50_v1.yml", conflicts[0].Dropped)
+	assert.False(t, conflicts[1].Override)
+	assert.Equal(t, "/models/a/private.yml", conflicts[1].Kept)
+	assert.Equal(t, "/models/b/private.yml", conflicts[1].Dropped)
+
+	assert.Len(t, skipped, 1)
+	assert.Equal(t, "/models/b/broken.yml", skipped[0].File)
+}
+
+func TestMergeManifestsUserOverrideIsFinal(t *testing.T) {
+	first := testManifest("VGG16", "7.0.0")
+	first.Name = "/models/a/vgg16.yml"
+	second := testManifest("VGG16", "7.0.0")
+	second.Name = "/models/b/vgg16.yml"
+
+	merged, conflicts, _ := mergeManifests([]manifestFile{testManifest("VGG16", "7.0.0")}, []manifestFile{first, second})
+	assert.Len(t, merged, 1)
+	assert.Equal(t, "/models/a/vgg16.yml", merged[0].Name)
+	assert.Len(t, conflicts, 2)
+}
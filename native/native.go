@@ -0,0 +1,10 @@
+// Package native queries the NVIDIA libraries the agent is linked against.
+// The libraries are only linked into builds tagged gpu, on linux with cgo,
+// so that importers build and test without the CUDA and TensorRT toolchain;
+// other builds use a stub that reports the libraries as unavailable.
+package native
+
+import "github.com/pkg/errors"
+
+// ErrUnavailable is returned by the stub implementation.
+var ErrUnavailable = errors.New("the NVIDIA libraries are not available in this build")
@@ -0,0 +1,70 @@
+//go:build linux && cgo && gpu
+// +build linux,cgo,gpu
+
+package native
+
+// #cgo CFLAGS: -I/usr/local/cuda/include
+// #cgo LDFLAGS: -L/usr/local/cuda/lib64 -lcudart -lcudnn -lnvinfer
+// #include <cuda_runtime_api.h>
+// #include <cudnn.h>
+//
+// // NvInfer.h is a C++ header; getInferLibVersion is exported with C linkage.
+// int getInferLibVersion(void);
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// ComputeCapability returns the compute capability of a CUDA device as
+// "major.minor".
+func ComputeCapability(device int) (string, error) {
+	var major, minor C.int
+	if rc := C.cudaDeviceGetAttribute(&major, C.cudaDevAttrComputeCapabilityMajor, C.int(device)); rc != C.cudaSuccess {
+		return "", cudaError(rc)
+	}
+	if rc := C.cudaDeviceGetAttribute(&minor, C.cudaDevAttrComputeCapabilityMinor, C.int(device)); rc != C.cudaSuccess {
+		return "", cudaError(rc)
+	}
+	return fmt.Sprintf("%d.%d", int(major), int(minor)), nil
+}
+
+// MemGetInfo returns the free and total memory of a CUDA device, in bytes.
+func MemGetInfo(device int) (free, total int64, err error) {
+	// the current device is per thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if rc := C.cudaSetDevice(C.int(device)); rc != C.cudaSuccess {
+		return 0, 0, cudaError(rc)
+	}
+	var f, t C.size_t
+	if rc := C.cudaMemGetInfo(&f, &t); rc != C.cudaSuccess {
+		return 0, 0, cudaError(rc)
+	}
+	return int64(f), int64(t), nil
+}
+
+// Detect queries the versions of the loaded TensorRT, CUDA and cuDNN
+// libraries.
+func Detect() (Versions, error) {
+	var runtimeVersion, driverVersion C.int
+	if rc := C.cudaRuntimeGetVersion(&runtimeVersion); rc != C.cudaSuccess {
+		return Versions{}, cudaError(rc)
+	}
+	if rc := C.cudaDriverGetVersion(&driverVersion); rc != C.cudaSuccess {
+		return Versions{}, cudaError(rc)
+	}
+	return Versions{
+		TensorRT:   tensorRTVersion(int(C.getInferLibVersion())),
+		CUDA:       cudaVersion(int(runtimeVersion)),
+		CUDADriver: cudaVersion(int(driverVersion)),
+		CUDNN:      cudnnVersion(int(C.cudnnGetVersion())),
+	}, nil
+}
+
+func cudaError(rc C.cudaError_t) error {
+	return errors.Errorf("cuda error %d: %s", int(rc), C.GoString(C.cudaGetErrorString(rc)))
+}
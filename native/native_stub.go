@@ -0,0 +1,21 @@
+//go:build !linux || !cgo || !gpu
+// +build !linux !cgo !gpu
+
+package native
+
+// ComputeCapability returns the compute capability of a CUDA device as
+// "major.minor".
+func ComputeCapability(device int) (string, error) {
+	return "", ErrUnavailable
+}
+
+// MemGetInfo returns the free and total memory of a CUDA device, in bytes.
+func MemGetInfo(device int) (free, total int64, err error) {
+	return 0, 0, ErrUnavailable
+}
+
+// Detect queries the versions of the loaded TensorRT, CUDA and cuDNN
+// libraries.
+func Detect() (Versions, error) {
+	return Versions{}, ErrUnavailable
+}
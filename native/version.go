@@ -0,0 +1,37 @@
+package native
+
+import "fmt"
+
+// Versions are the versions of the NVIDIA libraries the agent is linked
+// against, formatted as "major.minor[.patch]".
+type Versions struct {
+	TensorRT   string
+	CUDA       string
+	CUDADriver string
+	CUDNN      string
+}
+
+// tensorRTVersion decodes getInferLibVersion. TensorRT 10 widened the minor
+// field, so the encoding changed from major*1000+minor*100+patch to
+// major*10000+minor*100+patch.
+func tensorRTVersion(v int) string {
+	if v >= 10000 {
+		return fmt.Sprintf("%d.%d.%d", v/10000, (v%10000)/100, v%100)
+	}
+	return fmt.Sprintf("%d.%d.%d", v/1000, (v%1000)/100, v%100)
+}
+
+// cudaVersion decodes cudaRuntimeGetVersion and cudaDriverGetVersion, which
+// encode versions as major*1000+minor*10.
+func cudaVersion(v int) string {
+	return fmt.Sprintf("%d.%d", v/1000, (v%1000)/10)
+}
+
+// cudnnVersion decodes cudnnGetVersion. Like TensorRT, cuDNN 9 changed the
+// encoding from major*1000+minor*100+patch to major*10000+minor*100+patch.
+func cudnnVersion(v int) string {
+	if v >= 90000 {
+		return fmt.Sprintf("%d.%d.%d", v/10000, (v%10000)/100, v%100)
+	}
+	return fmt.Sprintf("%d.%d.%d", v/1000, (v%1000)/100, v%100)
+}
@@ -0,0 +1,27 @@
+package native
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTensorRTVersion(t *testing.T) {
+	assert.Equal(t, "6.0.1", tensorRTVersion(6001))
+	assert.Equal(t, "7.0.0", tensorRTVersion(7000))
+	assert.Equal(t, "7.1.3", tensorRTVersion(7103))
+	assert.Equal(t, "8.6.1", tensorRTVersion(8601))
+	assert.Equal(t, "10.3.0", tensorRTVersion(100300))
+}
+
+func TestCUDAVersion(t *testing.T) {
+	assert.Equal(t, "10.2", cudaVersion(10020))
+	assert.Equal(t, "11.0", cudaVersion(11000))
+	assert.Equal(t, "12.4", cudaVersion(12040))
+}
+
+func TestCUDNNVersion(t *testing.T) {
+	assert.Equal(t, "7.6.5", cudnnVersion(7605))
+	assert.Equal(t, "8.9.7", cudnnVersion(8907))
+	assert.Equal(t, "9.1.0", cudnnVersion(90100))
+}
@@ -0,0 +1,132 @@
+package plan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Requirements is what a predictor expects from the engine it is about to
+// deserialize.
+type Requirements struct {
+	TensorRTVersion   string
+	ComputeCapability string
+	BatchSize         int
+	Bindings          []Binding
+}
+
+// IncompatibleError is returned when an engine plan cannot be used on the
+// current device. It lists every mismatch, not only the first one.
+type IncompatibleError struct {
+	Path    string
+	Reasons []string
+}
+
+func (e *IncompatibleError) Error() string {
+	return fmt.Sprintf("engine plan %s is incompatible: %s", e.Path, strings.Join(e.Reasons, "; "))
+}
+
+// Check verifies that the engine described by the metadata can serve the
+// requirements. TensorRT plans are not portable across library versions or
+// GPU architectures, so both must match exactly.
+func (m Metadata) Check(req Requirements) error {
+	var reasons []string
+	if req.TensorRTVersion != "" {
+		same, err := sameVersion(m.TensorRTVersion, req.TensorRTVersion)
+		if err != nil {
+			reasons = append(reasons, err.Error())
+		} else if !same {
+			reasons = append(reasons, fmt.Sprintf("built with TensorRT %s, running TensorRT %s", m.TensorRTVersion, req.TensorRTVersion))
+		}
+	}
+	if req.ComputeCapability != "" && normalizeComputeCapability(m.ComputeCapability) != normalizeComputeCapability(req.ComputeCapability) {
+		reasons = append(reasons, fmt.Sprintf("built for compute capability %s, device has %s", m.ComputeCapability, req.ComputeCapability))
+	}
+	if m.MaxBatchSize > 0 && req.BatchSize > m.MaxBatchSize {
+		reasons = append(reasons, fmt.Sprintf("batch size %d exceeds the engine maximum of %d", req.BatchSize, m.MaxBatchSize))
+	}
+	for _, expected := range req.Bindings {
+		actual, ok := m.Binding(expected.Name)
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("binding %q not found", expected.Name))
+			continue
+		}
+		if actual.IsInput != expected.IsInput {
+			reasons = append(reasons, fmt.Sprintf("binding %q is not an %s", expected.Name, bindingKind(expected.IsInput)))
+		}
+		if len(expected.Shape) != 0 && len(actual.Shape) != 0 && !shapeMatches(actual.Shape, expected.Shape) {
+			reasons = append(reasons, fmt.Sprintf("binding %q has shape %v, expected %v", expected.Name, actual.Shape, expected.Shape))
+		}
+	}
+	if len(reasons) != 0 {
+		return &IncompatibleError{Path: m.Path, Reasons: reasons}
+	}
+	return nil
+}
+
+func bindingKind(isInput bool) string {
+	if isInput {
+		return "input"
+	}
+	return "output"
+}
+
+// shapeMatches compares an engine binding shape with the shape a predictor
+// expects. Engines built with an explicit batch dimension carry one leading
+// dimension more than the manifest, and dynamic dimensions (-1) match any
+// size.
+func shapeMatches(actual, expected []int) bool {
+	if len(actual) == len(expected)+1 {
+		actual = actual[1:]
+	}
+	if len(actual) != len(expected) {
+		return false
+	}
+	for ii := range actual {
+		if actual[ii] != -1 && actual[ii] != expected[ii] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameVersion compares the major, minor and patch components of two versions.
+// Build numbers (the fourth component) are ignored. Versions that cannot be
+// parsed are never the same.
+func sameVersion(a, b string) (bool, error) {
+	va, err := versionComponents(a)
+	if err != nil {
+		return false, err
+	}
+	vb, err := versionComponents(b)
+	if err != nil {
+		return false, err
+	}
+	return va == vb, nil
+}
+
+// versionComponents parses the major, minor and patch components of v, the
+// missing ones are 0.
+func versionComponents(v string) ([3]int, error) {
+	var components [3]int
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(v), "v"), ".")
+	for ii := 0; ii < len(parts) && ii < len(components); ii++ {
+		n, err := strconv.Atoi(parts[ii])
+		if err != nil || n < 0 {
+			return components, errors.Errorf("invalid TensorRT version %q", v)
+		}
+		components[ii] = n
+	}
+	return components, nil
+}
+
+// normalizeComputeCapability accepts "7.5", "75" and "sm_75".
+func normalizeComputeCapability(cc string) string {
+	cc = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(cc)), "sm_")
+	if !strings.Contains(cc, ".") && len(cc) >= 2 {
+		cc = cc[:len(cc)-1] + "." + cc[len(cc)-1:]
+	}
+	return cc
+}
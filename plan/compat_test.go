@@ -0,0 +1,116 @@
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var resnet50Requirements = Requirements{
+	TensorRTVersion:   "7.0.0",
+	ComputeCapability: "7.5",
+	BatchSize:         4,
+	Bindings: []Binding{
+		{Name: "data", Shape: []int{3, 224, 224}, IsInput: true},
+		{Name: "prob"},
+	},
+}
+
+func TestCheckCompatible(t *testing.T) {
+	meta, err := ReadMetadata(fixture("resnet50.plan"))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.NoError(t, meta.Check(resnet50Requirements))
+}
+
+func TestCheckIncompatible(t *testing.T) {
+	meta, err := ReadMetadata(fixture("resnet50.plan"))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	req := resnet50Requirements
+	req.TensorRTVersion = "6.0.1"
+	req.ComputeCapability = "sm_70"
+	req.BatchSize = 16
+	req.Bindings = []Binding{
+		{Name: "data", Shape: []int{3, 299, 299}, IsInput: true},
+		{Name: "prob", IsInput: true},
+		{Name: "softmax"},
+	}
+	err = meta.Check(req)
+	assert.Error(t, err)
+
+	incompatible, ok := err.(*IncompatibleError)
+	assert.True(t, ok)
+	if !ok {
+		return
+	}
+	assert.Equal(t, fixture("resnet50.plan"), incompatible.Path)
+	assert.Len(t, incompatible.Reasons, 6)
+	assert.Contains(t, err.Error(), "TensorRT 6.0.1")
+	assert.Contains(t, err.Error(), `binding "softmax" not found`)
+}
+
+func TestCheckDynamicShapes(t *testing.T) {
+	meta, err := ReadMetadata(fixture("dynamic.plan"))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	err = meta.Check(Requirements{
+		TensorRTVersion:   "7.0.0",
+		ComputeCapability: "7.0",
+		BatchSize:         64,
+		Bindings: []Binding{
+			{Name: "input", Shape: []int{3, 224, 224}, IsInput: true},
+			{Name: "output", Shape: []int{1000}},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestSameVersion(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		same bool
+	}{
+		{"7.0.0", "7.0.0.11", true},
+		{"7.0", "7.0.0", true},
+		{"7.0.0", "7.1.3", false},
+		{"6.0.1", "7.0.0", false},
+	} {
+		same, err := sameVersion(c.a, c.b)
+		assert.NoError(t, err)
+		assert.Equal(t, c.same, same, "%s and %s", c.a, c.b)
+	}
+
+	for _, c := range [][2]string{{"7.x", "7.y"}, {"", "7.0.0"}, {"7.0.0", "seven"}} {
+		_, err := sameVersion(c[0], c[1])
+		assert.Error(t, err, "%q and %q", c[0], c[1])
+	}
+}
+
+func TestCheckInvalidVersion(t *testing.T) {
+	meta, err := ReadMetadata(fixture("resnet50.plan"))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	meta.TensorRTVersion = "7.x"
+	req := resnet50Requirements
+	req.TensorRTVersion = "7.y"
+	err = meta.Check(req)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid TensorRT version "7.x"`)
+}
+
+func TestNormalizeComputeCapability(t *testing.T) {
+	assert.Equal(t, "7.5", normalizeComputeCapability("7.5"))
+	assert.Equal(t, "7.5", normalizeComputeCapability("75"))
+	assert.Equal(t, "7.0", normalizeComputeCapability("sm_70"))
+	assert.Equal(t, "8.6", normalizeComputeCapability(" SM_86 "))
+}
@@ -0,0 +1,118 @@
+package plan
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// MetadataExtension is appended to the path of an engine plan to locate the
+// metadata describing it.
+const MetadataExtension = ".json"
+
+// Metadata describes a serialized TensorRT engine. A plan can only be
+// inspected by deserializing it, which fails in unhelpful ways when it was
+// built for another TensorRT version or GPU, so the information needed to
+// check compatibility is kept in a JSON file next to the plan
+// (resnet50.plan -> resnet50.plan.json). trtexec does not write it, it is
+// generated with WriteMetadata when the plan is built.
+type Metadata struct {
+	// Path is the plan the metadata was read for.
+	Path              string    `json:"-"`
+	TensorRTVersion   string    `json:"tensorrt_version"`
+	ComputeCapability string    `json:"compute_capability"`
+	Precision         string    `json:"precision,omitempty"`
+	MaxBatchSize      int       `json:"max_batch_size,omitempty"`
+	Bindings          []Binding `json:"bindings"`
+}
+
+// Binding is an input or output tensor of an engine. Dimensions set to -1 are
+// dynamic.
+type Binding struct {
+	Name    string `json:"name"`
+	Shape   []int  `json:"shape,omitempty"`
+	IsInput bool   `json:"is_input"`
+	Dtype   string `json:"dtype,omitempty"`
+}
+
+// MetadataPath returns the metadata path for an engine plan.
+func MetadataPath(planPath string) string {
+	return planPath + MetadataExtension
+}
+
+// ReadMetadata reads the metadata of the engine plan at planPath.
+func ReadMetadata(planPath string) (*Metadata, error) {
+	f, err := os.Open(MetadataPath(planPath))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read the metadata of engine plan %s", planPath)
+	}
+	defer f.Close()
+
+	meta, err := ParseMetadata(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid metadata for engine plan %s", planPath)
+	}
+	meta.Path = planPath
+	return meta, nil
+}
+
+// ParseMetadata decodes and validates engine metadata.
+func ParseMetadata(r io.Reader) (*Metadata, error) {
+	meta := new(Metadata)
+	if err := json.NewDecoder(r).Decode(meta); err != nil {
+		return nil, errors.Wrap(err, "failed to decode engine metadata")
+	}
+	if err := meta.Validate(); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// Validate checks that the metadata holds what is needed to check the
+// compatibility of the plan.
+func (m Metadata) Validate() error {
+	if m.TensorRTVersion == "" {
+		return errors.New("engine metadata has no tensorrt_version")
+	}
+	if m.ComputeCapability == "" {
+		return errors.New("engine metadata has no compute_capability")
+	}
+	if len(m.Bindings) == 0 {
+		return errors.New("engine metadata lists no bindings")
+	}
+	for _, b := range m.Bindings {
+		if b.Name == "" {
+			return errors.New("engine metadata has a binding without a name")
+		}
+	}
+	return nil
+}
+
+// WriteMetadata validates meta and writes it next to the engine plan at
+// planPath.
+func WriteMetadata(planPath string, meta Metadata) error {
+	if err := meta.Validate(); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode engine metadata")
+	}
+	if err := ioutil.WriteFile(MetadataPath(planPath), append(data, '\n'), 0644); err != nil {
+		return errors.Wrapf(err, "cannot write the metadata of engine plan %s", planPath)
+	}
+	return nil
+}
+
+// Binding returns the binding with the given name.
+func (m Metadata) Binding(name string) (Binding, bool) {
+	for _, b := range m.Bindings {
+		if b.Name == name {
+			return b, true
+		}
+	}
+	return Binding{}, false
+}
@@ -0,0 +1,82 @@
+package plan
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fixture(name string) string {
+	return filepath.Join("_fixtures", name)
+}
+
+func TestReadMetadata(t *testing.T) {
+	meta, err := ReadMetadata(fixture("resnet50.plan"))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	assert.Equal(t, fixture("resnet50.plan"), meta.Path)
+	assert.Equal(t, "7.0.0.11", meta.TensorRTVersion)
+	assert.Equal(t, "7.5", meta.ComputeCapability)
+	assert.Equal(t, 8, meta.MaxBatchSize)
+	assert.Len(t, meta.Bindings, 2)
+
+	data, ok := meta.Binding("data")
+	assert.True(t, ok)
+	assert.True(t, data.IsInput)
+	assert.Equal(t, []int{3, 224, 224}, data.Shape)
+
+	_, ok = meta.Binding("fc1000")
+	assert.False(t, ok)
+}
+
+func TestReadMetadataErrors(t *testing.T) {
+	_, err := ReadMetadata(fixture("missing.plan"))
+	assert.Error(t, err)
+
+	_, err = ReadMetadata(fixture("no_version.plan"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "tensorrt_version")
+
+	_, err = ReadMetadata(fixture("truncated.plan"))
+	assert.Error(t, err)
+}
+
+func TestParseMetadataRequiresBindings(t *testing.T) {
+	_, err := ParseMetadata(strings.NewReader(`{"tensorrt_version": "7.0.0", "compute_capability": "7.5"}`))
+	assert.Error(t, err)
+
+	_, err = ParseMetadata(strings.NewReader(`{"tensorrt_version": "7.0.0", "compute_capability": "7.5", "bindings": [{"shape": [1]}]}`))
+	assert.Error(t, err)
+}
+
+func TestWriteMetadata(t *testing.T) {
+	dir, err := ioutil.TempDir("", "plan")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	planPath := filepath.Join(dir, "resnet50.plan")
+
+	meta := Metadata{
+		TensorRTVersion:   "7.0.0",
+		ComputeCapability: "7.5",
+		Precision:         "fp16",
+		MaxBatchSize:      8,
+		Bindings:          resnet50Requirements.Bindings,
+	}
+	assert.NoError(t, WriteMetadata(planPath, meta))
+	read, err := ReadMetadata(planPath)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	meta.Path = planPath
+	assert.Equal(t, meta, *read)
+
+	meta.ComputeCapability = ""
+	assert.Error(t, WriteMetadata(planPath, meta))
+}
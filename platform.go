@@ -0,0 +1,99 @@
+package tensorrt
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework"
+)
+
+// Platform describes what the agent supports on an OS and architecture.
+type Platform struct {
+	OS   string
+	Arch string
+	// Container holds the images advertised for the architecture. Platforms
+	// without published images can still run the agent natively. TensorRT
+	// needs a GPU, so no cpu image is advertised.
+	Container *dlframework.ContainerHardware
+	// DLA is set when the platform has NVIDIA deep learning accelerators.
+	DLA bool
+	// Precisions lists the inference precisions TensorRT supports on the
+	// platform's GPUs.
+	Precisions []string
+}
+
+// Platforms is the support matrix of the agent. It decides both which
+// container images FrameworkManifest advertises and whether Register
+// proceeds.
+var Platforms = []Platform{
+	{
+		OS:   "linux",
+		Arch: "amd64",
+		Container: &dlframework.ContainerHardware{
+			Gpu: "raiproject/carml-tensorrt:amd64-gpu",
+		},
+		Precisions: []string{"fp32", "fp16", "int8"},
+	},
+	{
+		OS:   "linux",
+		Arch: "ppc64le",
+		Container: &dlframework.ContainerHardware{
+			Gpu: "raiproject/carml-tensorrt:ppc64le-gpu",
+		},
+		Precisions: []string{"fp32", "fp16", "int8"},
+	},
+	{
+		OS:         "linux",
+		Arch:       "arm64",
+		DLA:        true,
+		Precisions: []string{"fp32", "fp16", "int8"},
+	},
+}
+
+// hostPlatform returns the OS and architecture the agent runs on. Tests
+// replace it to pretend to run elsewhere.
+var hostPlatform = func() (string, string) {
+	return runtime.GOOS, runtime.GOARCH
+}
+
+// LookupPlatform finds the support matrix entry for an OS and architecture.
+func LookupPlatform(os, arch string) (Platform, bool) {
+	for _, p := range Platforms {
+		if p.OS == os && p.Arch == arch {
+			return p, true
+		}
+	}
+	return Platform{}, false
+}
+
+// CurrentPlatform returns the support matrix entry of the host, or an error
+// when the agent does not support it.
+func CurrentPlatform() (Platform, error) {
+	os, arch := hostPlatform()
+	p, ok := LookupPlatform(os, arch)
+	if !ok {
+		return Platform{}, errors.Errorf("tensorrt is not available on %s/%s", os, arch)
+	}
+	return p, nil
+}
+
+// SupportsPrecision reports whether TensorRT can run at the given precision
+// on the platform.
+func (p Platform) SupportsPrecision(precision string) bool {
+	for _, e := range p.Precisions {
+		if e == precision {
+			return true
+		}
+	}
+	return false
+}
+
+func platformContainers(platforms []Platform) map[string]*dlframework.ContainerHardware {
+	containers := map[string]*dlframework.ContainerHardware{}
+	for _, p := range platforms {
+		if p.Container != nil {
+			containers[p.Arch] = p.Container
+		}
+	}
+	return containers
+}
@@ -0,0 +1,66 @@
+package tensorrt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func withHostPlatform(os, arch string, f func()) {
+	orig := hostPlatform
+	defer func() { hostPlatform = orig }()
+	hostPlatform = func() (string, string) { return os, arch }
+	f()
+}
+
+func TestCurrentPlatform(t *testing.T) {
+	withHostPlatform("linux", "ppc64le", func() {
+		p, err := CurrentPlatform()
+		assert.NoError(t, err)
+		assert.Equal(t, "ppc64le", p.Arch)
+		assert.False(t, p.DLA)
+		assert.True(t, p.SupportsPrecision("fp16"))
+		assert.False(t, p.SupportsPrecision("fp64"))
+	})
+
+	withHostPlatform("linux", "arm64", func() {
+		p, err := CurrentPlatform()
+		assert.NoError(t, err)
+		assert.True(t, p.DLA)
+		assert.Nil(t, p.Container)
+	})
+
+	withHostPlatform("darwin", "amd64", func() {
+		_, err := CurrentPlatform()
+		assert.Error(t, err)
+	})
+}
+
+func TestRegisterUnsupportedPlatform(t *testing.T) {
+	withHostPlatform("windows", "amd64", func() {
+		err := register()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "windows/amd64")
+	})
+}
+
+func TestFrameworkManifestContainers(t *testing.T) {
+	assert.Len(t, FrameworkManifest.Container, 2)
+	for _, p := range Platforms {
+		c, ok := FrameworkManifest.Container[p.Arch]
+		if p.Container == nil {
+			assert.False(t, ok, p.Arch)
+			continue
+		}
+		assert.Equal(t, p.Container, c, p.Arch)
+		// TensorRT needs a GPU, there are no cpu images
+		assert.Empty(t, c.Cpu, p.Arch)
+		assert.Contains(t, c.Gpu, p.Arch+"-gpu")
+	}
+
+	containers := platformContainers([]Platform{
+		{OS: "linux", Arch: "arm64", Container: Platforms[0].Container},
+	})
+	assert.Len(t, containers, 1)
+	assert.Contains(t, containers, "arm64")
+}
@@ -0,0 +1,177 @@
+package predictor
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	olog "github.com/opentracing/opentracing-go/log"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// ArchiveDirAttribute is the model attribute naming the directory of an
+// archive to extract, graph_path and weights_path are then relative to it. It
+// is re-exported from the manifest package for the users of the predictor.
+const ArchiveDirAttribute = manifest.ArchiveDirAttribute
+
+// artifacts lists the files of the model and where they are placed in the
+// work directory.
+func (p *ImagePredictor) artifacts() []artifact.Artifact {
+	model := p.Model
+
+	files := []artifact.Artifact{{
+		Kind:     artifact.Graph,
+		URL:      p.GetGraphUrl(),
+		Checksum: p.GetGraphChecksum(),
+		Path:     p.GetGraphPath(),
+	}}
+	if p.format.HasWeights() {
+		files = append(files, artifact.Artifact{
+			Kind:     artifact.Weights,
+			URL:      p.GetWeightsUrl(),
+			Checksum: p.GetWeightsChecksum(),
+			Path:     p.GetWeightsPath(),
+		})
+	}
+
+	var artifacts []artifact.Artifact
+	if model.Model.IsArchive {
+		artifacts = append(artifacts, artifact.Artifact{
+			Kind:     artifact.Archive,
+			URL:      model.Model.BaseUrl,
+			Path:     filepath.Join(p.WorkDir, path.Base(model.Model.BaseUrl)),
+			Contents: files,
+			Dir:      model.GetAttributes()[ArchiveDirAttribute],
+		})
+	} else {
+		artifacts = append(artifacts, files...)
+	}
+	if p.format == manifest.FormatEngine {
+		artifacts = append(artifacts, artifact.Artifact{
+			Kind: artifact.EngineMetadata,
+			URL:  p.GetEngineMetadataUrl(),
+			Path: p.GetEngineMetadataPath(),
+		})
+	}
+	if p.GetFeaturesUrl() != "" {
+		artifacts = append(artifacts, artifact.Artifact{
+			Kind:     artifact.Features,
+			URL:      p.GetFeaturesUrl(),
+			Checksum: p.GetFeaturesChecksum(),
+			Path:     p.GetFeaturesPath(),
+		})
+	}
+	return artifacts
+}
+
+// downloadArtifact returns the function downloading a from one of its
+// candidate urls and verifying it. Archives are extracted into the work
+// directory and their contents are verified.
+func (p *ImagePredictor) downloadArtifact(downloader *artifact.Downloader, a artifact.Artifact) func(context.Context, string) error {
+	return func(ctx context.Context, url string) error {
+		if err := downloader.Download(ctx, a, url, a.Path); err != nil {
+			return err
+		}
+		if a.Kind == artifact.Archive {
+			if err := p.extract(a); err != nil {
+				return err
+			}
+		}
+		return artifact.Verify(a)
+	}
+}
+
+// extract unpacks the archive a into the work directory and removes it.
+func (p *ImagePredictor) extract(a artifact.Artifact) error {
+	defer os.Remove(a.Path)
+	return artifact.Extract(a, p.WorkDir)
+}
+
+// newDownloader returns a downloader reporting its progress to the log and
+// to span.
+func (p *ImagePredictor) newDownloader(span opentracing.Span) *artifact.Downloader {
+	downloader := artifact.NewDownloader()
+	mm := p.modelMetrics()
+	var (
+		mu      sync.Mutex
+		written = map[string]int64{}
+	)
+	downloader.Progress = func(progress artifact.Progress) {
+		// count the bytes written since the last progress of the url, a
+		// restarted download starts over
+		mu.Lock()
+		delta := progress.Written - written[progress.URL]
+		if delta < 0 {
+			delta = progress.Written
+		}
+		written[progress.URL] = progress.Written
+		if progress.Done {
+			delete(written, progress.URL)
+		}
+		mu.Unlock()
+		mm.Downloaded(delta)
+
+		span.LogFields(
+			olog.String("event", "download progress"),
+			olog.String("artifact", progress.Artifact.Kind),
+			olog.String("url", progress.URL),
+			olog.Int64("written", progress.Written),
+			olog.Int64("total", progress.Total),
+			olog.Bool("done", progress.Done),
+		)
+		log.WithField("model", p.modelName()).WithField("url", progress.URL).Info(progress.String())
+	}
+	return downloader
+}
+
+// requireChecksums fails when checksums are required and some artifacts do
+// not declare one.
+func (p *ImagePredictor) requireChecksums(artifacts []artifact.Artifact) error {
+	if !tensorrt.Config.RequireChecksums {
+		return nil
+	}
+	return artifact.RequireChecksums(p.modelName(), artifacts)
+}
+
+// resolveOffline places the artifacts of the model from the local store
+// instead of downloading them, the caller holds the lock of the work
+// directory.
+func (p *ImagePredictor) resolveOffline(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "resolve_offline")
+	defer span.Finish()
+
+	artifacts := p.artifacts()
+	if err := p.requireChecksums(artifacts); err != nil {
+		return err
+	}
+
+	store := tensorrt.Store()
+	span.LogFields(
+		olog.String("event", "resolve artifacts"),
+		olog.String("store", store.Root),
+	)
+	if err := store.Resolve(p.modelName(), artifacts); err != nil {
+		return err
+	}
+	for _, a := range artifacts {
+		if a.Kind != artifact.Archive {
+			continue
+		}
+		if err := p.extract(a); err != nil {
+			return err
+		}
+		if err := artifact.Verify(a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *ImagePredictor) modelName() string {
+	return p.Model.GetName() + ":" + p.Model.GetVersion()
+}
@@ -0,0 +1,130 @@
+package predictor
+
+import (
+	"context"
+	"sync"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework/framework/options"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// Backend serves an image classification predictor to the standalone
+// servers of the agent.
+type Backend struct {
+	// mu serializes the predictions, go-tensorrt keeps the outputs of the
+	// last one in the predictor
+	mu        sync.Mutex
+	predictor *ImageClassificationPredictor
+	metadata  backend.Metadata
+}
+
+// NewBackend loads the model of m, which must be registered, with an engine
+// running at precision, that of the model when it is empty.
+func NewBackend(ctx context.Context, m *manifest.Manifest, precision string, opts ...options.Option) (*Backend, error) {
+	metadata, err := backend.NewMetadata(m)
+	if err != nil {
+		return nil, err
+	}
+	model, err := tensorrt.FindModel(m.CanonicalName())
+	if err != nil {
+		return nil, errors.Wrapf(err, "model %s is not registered", m.CanonicalName())
+	}
+	pred, err := newImageClassificationPredictor(*model, precision, opts...)
+	if err != nil {
+		return nil, err
+	}
+	p := pred.(*ImageClassificationPredictor)
+
+	metadata.MaxBatchSize = p.BatchSize()
+	labels, err := p.GetLabels()
+	if err != nil {
+		err = errors.Wrapf(err, "cannot read the labels of %s", m.CanonicalName())
+		p.status.Fail(err)
+		p.status = nil
+		p.Close()
+		return nil, err
+	}
+	metadata.Labels = labels
+	usage := p.EstimatedMemory()
+	metadata.EstimatedMemory = &usage
+	metadata.MeasuredMemory, _ = p.MeasuredMemory()
+	if len(labels) != 0 {
+		metadata.Outputs[0].Shape = []int{-1, len(labels)}
+	}
+	return &Backend{predictor: p, metadata: metadata}, nil
+}
+
+// Metadata ...
+func (b *Backend) Metadata() backend.Metadata {
+	return b.metadata
+}
+
+// Predict runs a batch of images. Batches smaller than the batch size of the
+// predictor are padded.
+func (b *Backend) Predict(ctx context.Context, inputs []backend.Tensor) ([]backend.Tensor, error) {
+	outputs, _, err := b.PredictTimed(ctx, inputs)
+	return outputs, err
+}
+
+// PredictTimed is Predict, also returning the timings of the batch.
+func (b *Backend) PredictTimed(ctx context.Context, inputs []backend.Tensor) ([]backend.Tensor, backend.Timings, error) {
+	inputs, batch, err := backend.CheckInputs(b.metadata, inputs)
+	if err != nil {
+		return nil, backend.Timings{}, err
+	}
+	batchSize := b.metadata.MaxBatchSize
+	if batch > batchSize {
+		return nil, backend.Timings{}, errors.Errorf("batch of %d is larger than the batch size %d", batch, batchSize)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	p := b.predictor
+	if err := p.inflight.acquire(); err != nil {
+		return nil, backend.Timings{}, errors.Wrap(backend.ErrUnavailable, err.Error())
+	}
+	defer p.inflight.release()
+
+	data := inputs[0].Data
+	if batch < batchSize {
+		padded := make([]float32, len(data)/batch*batchSize)
+		copy(padded, data)
+		data = padded
+	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "predict")
+	defer span.Finish()
+	defer p.profileLayers(span)()
+	timings, err := p.timePredict(ctx, data)
+	if err != nil {
+		return nil, backend.Timings{}, err
+	}
+	outputs, timings, err := p.timeRead(ctx, timings)
+	if err != nil {
+		return nil, backend.Timings{}, err
+	}
+	tagTimings(span, timings)
+	if len(outputs) == 0 {
+		return nil, backend.Timings{}, errors.New("the predictor returned no outputs")
+	}
+	classes := len(outputs[0]) / batchSize
+	return []backend.Tensor{{
+		Name:  b.metadata.Outputs[0].Name,
+		Shape: []int{batch, classes},
+		Data:  outputs[0][:batch*classes],
+	}}, timings, nil
+}
+
+// Precision returns the precision the engine of the model runs at.
+func (b *Backend) Precision() string {
+	return b.predictor.Precision()
+}
+
+// Close ...
+func (b *Backend) Close() error {
+	return b.predictor.Close()
+}
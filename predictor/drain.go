@@ -0,0 +1,173 @@
+package predictor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/lifecycle"
+)
+
+// ErrDraining is returned by predictions made on a predictor whose model was
+// removed or updated.
+var ErrDraining = errors.New("predictor is draining, its model was removed or updated")
+
+// DrainTimeout bounds how long a draining predictor waits for its in-flight
+// requests. Predictors whose requests are still running then are closed by
+// the last of them.
+var DrainTimeout = time.Minute
+
+// inflight counts the requests running on a predictor.
+type inflight struct {
+	mu       sync.Mutex
+	count    int
+	draining bool
+	// idle is closed when the last request of a draining predictor finishes
+	idle chan struct{}
+	// onIdle runs once no request is running
+	onIdle func()
+}
+
+func (f *inflight) acquire() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.draining {
+		return ErrDraining
+	}
+	f.count++
+	return nil
+}
+
+func (f *inflight) release() {
+	f.mu.Lock()
+	f.count--
+	var onIdle func()
+	if f.count == 0 {
+		if f.idle != nil {
+			close(f.idle)
+			f.idle = nil
+		}
+		onIdle, f.onIdle = f.onIdle, nil
+	}
+	f.mu.Unlock()
+	if onIdle != nil {
+		onIdle()
+	}
+}
+
+// drain rejects new requests and waits for the in-flight ones to finish.
+func (f *inflight) drain(ctx context.Context) error {
+	f.mu.Lock()
+	f.draining = true
+	if f.count == 0 {
+		f.mu.Unlock()
+		return nil
+	}
+	if f.idle == nil {
+		f.idle = make(chan struct{})
+	}
+	idle := f.idle
+	f.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// whenIdle runs fn once no request is running, right away when none is.
+func (f *inflight) whenIdle(fn func()) {
+	f.mu.Lock()
+	if f.count != 0 {
+		f.onIdle = fn
+		f.mu.Unlock()
+		return
+	}
+	f.mu.Unlock()
+	fn()
+}
+
+// livePredictors are the loaded predictors, by model key.
+var livePredictors = struct {
+	sync.Mutex
+	m map[string][]*ImagePredictor
+}{m: map[string][]*ImagePredictor{}}
+
+func track(p *ImagePredictor) {
+	key := p.modelKey()
+	livePredictors.Lock()
+	defer livePredictors.Unlock()
+	livePredictors.m[key] = append(livePredictors.m[key], p)
+}
+
+func untrack(p *ImagePredictor) {
+	key := p.modelKey()
+	livePredictors.Lock()
+	defer livePredictors.Unlock()
+	preds := livePredictors.m[key]
+	for i, pred := range preds {
+		if pred == p {
+			preds = append(preds[:i], preds[i+1:]...)
+			break
+		}
+	}
+	if len(preds) == 0 {
+		delete(livePredictors.m, key)
+		return
+	}
+	livePredictors.m[key] = preds
+}
+
+// Drain stops accepting predictions, waits for the in-flight ones and closes
+// the predictor. Closing frees the engine, so when ctx is done before the
+// predictions finish the predictor stays loaded and is closed by the last of
+// them.
+func (p *ImagePredictor) Drain(ctx context.Context) error {
+	p.status.Set(lifecycle.Draining)
+	if err := p.inflight.drain(ctx); err != nil {
+		p.inflight.whenIdle(func() { p.Close() })
+		return errors.Wrapf(err, "in-flight predictions of %s did not finish", p.modelKey())
+	}
+	p.Close()
+	return nil
+}
+
+// drainModel drains every loaded predictor of the model.
+func drainModel(key string) {
+	livePredictors.Lock()
+	preds := append([]*ImagePredictor(nil), livePredictors.m[key]...)
+	livePredictors.Unlock()
+
+	var wg sync.WaitGroup
+	for _, pred := range preds {
+		wg.Add(1)
+		go func(pred *ImagePredictor) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), DrainTimeout)
+			defer cancel()
+			if err := pred.Drain(ctx); err != nil {
+				log.WithError(err).Warn("the predictor will be closed once its predictions finish")
+				return
+			}
+			log.WithField("model", key).Info("drained predictor")
+		}(pred)
+	}
+	wg.Wait()
+}
+
+func drainReloaded(events []tensorrt.ModelEvent) {
+	for _, ev := range events {
+		if ev.Kind == tensorrt.ModelAdded {
+			continue
+		}
+		drainModel(ev.Key())
+	}
+}
+
+func init() {
+	tensorrt.AddReloadHandler(drainReloaded)
+}
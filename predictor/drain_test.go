@@ -0,0 +1,89 @@
+package predictor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rai-project/tensorrt/profile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInflightDrain(t *testing.T) {
+	var f inflight
+	assert.NoError(t, f.acquire())
+
+	drained := make(chan error, 1)
+	go func() {
+		drained <- f.drain(context.Background())
+	}()
+
+	// new requests are rejected as soon as the drain starts
+	deadline := time.Now().Add(time.Second)
+	for f.acquire() == nil {
+		f.release()
+		if time.Now().After(deadline) {
+			t.Fatal("drain did not start")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	select {
+	case <-drained:
+		t.Fatal("drain returned before the in-flight request finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	f.release()
+	assert.NoError(t, <-drained)
+}
+
+func TestInflightDrainTimeout(t *testing.T) {
+	var f inflight
+	assert.NoError(t, f.acquire())
+	defer f.release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, f.drain(ctx))
+}
+
+func TestInflightWhenIdle(t *testing.T) {
+	var f inflight
+	assert.NoError(t, f.acquire())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, f.drain(ctx))
+
+	closed := 0
+	f.whenIdle(func() { closed++ })
+	assert.Equal(t, 0, closed, "the predictor is closed under a running prediction")
+	f.release()
+	assert.Equal(t, 1, closed)
+
+	f.whenIdle(func() { closed++ })
+	assert.Equal(t, 2, closed)
+}
+
+func TestDrainTimeoutKeepsPredictorLoaded(t *testing.T) {
+	p := &ImagePredictor{layers: profile.NewAggregate()}
+	assert.NoError(t, p.inflight.acquire())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, p.Drain(ctx))
+	assert.Equal(t, ErrDraining, p.inflight.acquire())
+
+	closed := make(chan struct{})
+	p.release = func() { close(closed) }
+	select {
+	case <-closed:
+		t.Fatal("the predictor was closed under a running prediction")
+	default:
+	}
+	p.inflight.release()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("the predictor was not closed after its last prediction")
+	}
+}
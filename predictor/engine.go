@@ -0,0 +1,92 @@
+package predictor
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework/framework/options"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/native"
+	"github.com/rai-project/tensorrt/plan"
+	"github.com/rai-project/tracer"
+)
+
+// EngineMetadataAttribute is the model attribute holding the URL of the
+// metadata of an engine plan, when it is not next to the plan. It is
+// re-exported from the manifest package for the users of the predictor.
+const EngineMetadataAttribute = manifest.EngineMetadataAttribute
+
+// DefaultPrecision is the precision of the engines built by the predictor
+// when none is requested, and of the engine plans whose metadata does not
+// record one.
+const DefaultPrecision = "fp32"
+
+// Precision returns the precision the engine of the model runs at.
+func (p *ImagePredictor) Precision() string {
+	if p.precision == "" {
+		return DefaultPrecision
+	}
+	return p.precision
+}
+
+// precisionOptions returns the go-tensorrt options that build the engine of a
+// model at the requested precision, DefaultPrecision when it is empty. The
+// linked go-tensorrt does not take the precision of the engines it builds and
+// always builds them at DefaultPrecision, so other precisions are refused
+// rather than silently built at fp32. Engine plans run at the precision they
+// were built for, which checkEngine compares to the requested one.
+func precisionOptions(format manifest.Format, precision string) ([]options.Option, error) {
+	if format == manifest.FormatEngine {
+		return nil, nil
+	}
+	switch precision {
+	case "", DefaultPrecision:
+		return nil, nil
+	case "fp16", "int8":
+		return nil, errors.Errorf("the linked go-tensorrt only builds %s engines, it cannot build %s ones", DefaultPrecision, precision)
+	}
+	return nil, errors.Errorf("unknown precision %q", precision)
+}
+
+func (p *ImagePredictor) GetEngineMetadataUrl() string {
+	if url := p.Model.GetAttributes()[EngineMetadataAttribute]; url != "" {
+		return url
+	}
+	return plan.MetadataPath(p.GetGraphUrl())
+}
+
+func (p *ImagePredictor) GetEngineMetadataPath() string {
+	return plan.MetadataPath(p.GetGraphPath())
+}
+
+// checkEngine verifies that the downloaded engine plan was built for the
+// linked TensorRT version, the device it is about to be deserialized on and
+// the requested precision, any when it is empty. Deserializing an
+// incompatible plan either fails with an opaque error or crashes, so this
+// runs before the plan is handed to go-tensorrt.
+func (p *ImagePredictor) checkEngine(ctx context.Context, device int, batchSize int, precision string, bindings []plan.Binding) error {
+	span, _ := tracer.StartSpanFromContext(ctx, tracer.APPLICATION_TRACE, "check_engine")
+	defer span.Finish()
+
+	meta, err := plan.ReadMetadata(p.GetGraphPath())
+	if err != nil {
+		return err
+	}
+	p.precision = meta.Precision
+	if precision != "" && precision != p.Precision() {
+		return errors.Errorf("the engine plan was built for %s, not %s", p.Precision(), precision)
+	}
+
+	computeCapability, err := native.ComputeCapability(device)
+	if err != nil {
+		log.WithError(err).Warn("unable to query the device compute capability, not checking it against the engine plan")
+	}
+
+	return meta.Check(plan.Requirements{
+		TensorRTVersion:   tensorrt.FrameworkManifest.Version,
+		ComputeCapability: computeCapability,
+		BatchSize:         batchSize,
+		Bindings:          bindings,
+	})
+}
@@ -0,0 +1,37 @@
+package predictor
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework"
+	"github.com/rai-project/dlframework/framework/options"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// modelFormat resolves the format of a model from its manifest, falling back to
+// the extension of the graph path when no format is declared.
+func modelFormat(model dlframework.ModelManifest) (manifest.Format, error) {
+	graphPath := ""
+	if m := model.GetModel(); m != nil {
+		graphPath = m.GetGraphPath()
+	}
+	format, err := manifest.ResolveFormat(model.GetAttributes()[manifest.FormatAttribute], graphPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve the format of model %v", model.GetName())
+	}
+	return format, nil
+}
+
+// parserOptions returns the go-tensorrt options that point the Caffe parser at
+// the downloaded model files.
+func parserOptions(format manifest.Format, graphPath, weightsPath string) ([]options.Option, error) {
+	switch format {
+	case manifest.FormatCaffe:
+		return []options.Option{
+			options.Graph([]byte(graphPath)),
+			options.Weights([]byte(weightsPath)),
+		}, nil
+	case manifest.FormatEngine:
+		return nil, errors.New("the linked go-tensorrt cannot deserialize engine plans")
+	}
+	return nil, errors.Errorf("the %v model format is not supported by the predictor", format)
+}
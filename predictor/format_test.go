@@ -0,0 +1,64 @@
+package predictor
+
+import (
+	"testing"
+
+	"github.com/rai-project/dlframework"
+	"github.com/rai-project/dlframework/framework/options"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelFormat(t *testing.T) {
+	model := dlframework.ModelManifest{
+		Name: "ResNet50_Engine",
+		Model: &dlframework.ModelManifest_Model{
+			GraphPath: "http://s3.amazonaws.com/store.carml.org/models/engine/resnet50.plan",
+		},
+	}
+	format, err := modelFormat(model)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest.FormatEngine, format)
+
+	model.Attributes = map[string]string{manifest.FormatAttribute: "caffe"}
+	format, err = modelFormat(model)
+	assert.NoError(t, err)
+	assert.Equal(t, manifest.FormatCaffe, format)
+
+	model.Model.GraphPath = "http://s3.amazonaws.com/store.carml.org/models/onnx/resnet50.onnx"
+	_, err = modelFormat(model)
+	assert.Error(t, err)
+
+	model.Attributes = map[string]string{manifest.FormatAttribute: "tflite"}
+	_, err = modelFormat(model)
+	assert.Error(t, err)
+}
+
+func TestParserOptions(t *testing.T) {
+	opts, err := parserOptions(manifest.FormatCaffe, "/work/deploy.prototxt", "/work/model.caffemodel")
+	assert.NoError(t, err)
+	o := options.New(opts...)
+	assert.Equal(t, "/work/deploy.prototxt", string(o.Graph()))
+	assert.Equal(t, "/work/model.caffemodel", string(o.Weights()))
+
+	opts, err = parserOptions(manifest.FormatEngine, "/work/resnet50.plan", "")
+	assert.Error(t, err)
+	assert.Empty(t, opts)
+
+	_, err = parserOptions(manifest.Format("tflite"), "/work/model.tflite", "")
+	assert.Error(t, err)
+}
+
+func TestPrecisionOptions(t *testing.T) {
+	for _, precision := range []string{"", DefaultPrecision} {
+		_, err := precisionOptions(manifest.FormatCaffe, precision)
+		assert.NoError(t, err, "precision %q", precision)
+	}
+	for _, precision := range []string{"fp16", "int8", "fp8"} {
+		_, err := precisionOptions(manifest.FormatCaffe, precision)
+		assert.Error(t, err, "precision %q", precision)
+	}
+	// engine plans are checked against their metadata
+	_, err := precisionOptions(manifest.FormatEngine, "fp16")
+	assert.NoError(t, err)
+}
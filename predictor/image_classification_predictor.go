@@ -2,8 +2,8 @@ package predictor
 
 import (
 	"context"
-	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rai-project/config"
@@ -14,6 +14,11 @@ import (
 	gotrt "github.com/rai-project/go-tensorrt"
 	nvidiasmi "github.com/rai-project/nvidia-smi"
 	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/metrics"
+	"github.com/rai-project/tensorrt/plan"
 	"github.com/rai-project/tracer"
 	gotensor "gorgonia.org/tensor"
 )
@@ -22,10 +27,22 @@ import (
 type ImageClassificationPredictor struct {
 	*ImagePredictor
 	probabilities interface{}
+	// timings are those of the last prediction, completed when its outputs
+	// are read, as go-tensorrt keeps the outputs of the last prediction
+	timings backend.Timings
+	// precision is the precision requested for the engine, that of the model
+	// when it is empty
+	precision string
 }
 
 // NewImageClassificationPredictor initilizes the ImageClassificationPredictor
 func NewImageClassificationPredictor(model dlframework.ModelManifest, opts ...options.Option) (common.Predictor, error) {
+	return newImageClassificationPredictor(model, "", opts...)
+}
+
+// newImageClassificationPredictor initializes the predictor with an engine
+// running at precision.
+func newImageClassificationPredictor(model dlframework.ModelManifest, precision string, opts ...options.Option) (common.Predictor, error) {
 	ctx := context.Background()
 	span, ctx := tracer.StartSpanFromContext(ctx, tracer.APPLICATION_TRACE, "new_predictor")
 	defer span.Finish()
@@ -39,16 +56,26 @@ func NewImageClassificationPredictor(model dlframework.ModelManifest, opts ...op
 		return nil, errors.New("input type not supported")
 	}
 
-	predictor := new(ImageClassificationPredictor)
+	predictor := &ImageClassificationPredictor{precision: precision}
 
 	return predictor.Load(ctx, model, opts...)
 }
 
-func (self *ImageClassificationPredictor) Load(ctx context.Context, modelManifest dlframework.ModelManifest, opts ...options.Option) (common.Predictor, error) {
+func (self *ImageClassificationPredictor) Load(ctx context.Context, modelManifest dlframework.ModelManifest, opts ...options.Option) (_ common.Predictor, err error) {
 	pred, err := self.ImagePredictor.Load(ctx, modelManifest, opts...)
 	if err != nil {
 		return nil, err
 	}
+	start := time.Now()
+	defer func() {
+		if err != nil {
+			// the failure stays the state of the model, the predictor is
+			// only closed to release its work directory
+			pred.status.Fail(err)
+			pred.status = nil
+			pred.Close()
+		}
+	}()
 
 	if ctx != nil {
 		span, _ := tracer.StartSpanFromContext(ctx, tracer.APPLICATION_TRACE, "load_predictor")
@@ -64,6 +91,7 @@ func (self *ImageClassificationPredictor) Load(ctx context.Context, modelManifes
 		panic("no GPU")
 	}
 	device := options.CUDA_DEVICE
+	deviceID := tensorrt.Config.Device
 
 	batchSize := pred.BatchSize()
 
@@ -102,33 +130,95 @@ func (self *ImageClassificationPredictor) Load(ctx context.Context, modelManifes
 		},
 	}
 
-	trtPredictor, err := gotrt.New(
-		ctx,
-		options.WithOptions(predOptions),
-		options.Device(device, 0),
-		options.Graph([]byte(pred.GetGraphPath())),
-		options.Weights([]byte(pred.GetWeightsPath())),
-		options.BatchSize(batchSize),
-		options.InputNodes(inputNodes),
-		options.OutputNodes(outputNodes),
-	)
+	if pred.format == manifest.FormatEngine {
+		err := pred.checkEngine(ctx, deviceID, batchSize, self.precision, []plan.Binding{
+			{Name: inputName, Shape: inputShape, IsInput: true},
+			{Name: outputName},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parserOpts, err := parserOptions(pred.format, pred.GetGraphPath(), pred.GetWeightsPath())
+	if err != nil {
+		return nil, err
+	}
+
+	precisionOpts, err := precisionOptions(pred.format, self.precision)
+	if err != nil {
+		return nil, err
+	}
+	if pred.format != manifest.FormatEngine {
+		pred.precision = self.precision
+	}
+
+	// the size of the output is only known from the labels before the
+	// engine is built
+	var outputDims []int
+	if labels, err := pred.GetLabels(); err == nil {
+		outputDims = []int{len(labels)}
+	}
+	usage, err := pred.estimateMemory(batchSize, inputName, inputShape, outputName, outputDims)
+	if err != nil {
+		return nil, err
+	}
+	if err := pred.reserveMemory(usage); err != nil {
+		return nil, err
+	}
+
+	var trtPredictor *gotrt.Predictor
+	pred.measuredMemory, pred.memoryMeasured, err = measureBuild(deviceID, func() (err error) {
+		trtPredictor, err = gotrt.New(
+			ctx,
+			append(
+				[]options.Option{
+					options.WithOptions(predOptions),
+					options.Device(device, deviceID),
+					options.BatchSize(batchSize),
+					options.InputNodes(inputNodes),
+					options.OutputNodes(outputNodes),
+				},
+				append(parserOpts, precisionOpts...)...,
+			)...,
+		)
+		return err
+	})
 	if err != nil {
-		panic(fmt.Sprintf("%v", err))
+		return nil, errors.Wrap(err, "failed to create the TensorRT predictor")
 	}
 	pred.predictor = trtPredictor
+	pred.recordMemory()
+	track(pred)
+	pred.status.Set(lifecycle.Ready)
+	pred.modelMetrics().Load(metrics.Build, time.Since(start))
 
 	p := &ImageClassificationPredictor{
 		ImagePredictor: pred,
+		precision:      self.precision,
 	}
 
 	return p, nil
 }
 
 // Predict ...
-func (p *ImageClassificationPredictor) Predict(ctx context.Context, data interface{}, opts ...options.Option) error {
+func (p *ImageClassificationPredictor) Predict(ctx context.Context, data interface{}, opts ...options.Option) (err error) {
 	span, ctx := tracer.StartSpanFromContext(ctx, tracer.APPLICATION_TRACE, "predict")
 	defer span.Finish()
 
+	mm := p.modelMetrics()
+	mm.Request()
+	if err := p.inflight.acquire(); err != nil {
+		mm.Error(errors.Wrap(backend.ErrUnavailable, err.Error()))
+		return err
+	}
+	defer p.inflight.release()
+	defer func() {
+		mm.Error(err)
+	}()
+	done := mm.Enqueue()
+	defer done()
+
 	if data == nil {
 		return errors.New("input data nil")
 	}
@@ -137,6 +227,7 @@ func (p *ImageClassificationPredictor) Predict(ctx context.Context, data interfa
 		return errors.New("input data is not slice of go tensors")
 	}
 
+	start := time.Now()
 	fst := input[0]
 	joined, err := fst.Concat(0, input[1:]...)
 	if err != nil {
@@ -144,11 +235,16 @@ func (p *ImageClassificationPredictor) Predict(ctx context.Context, data interfa
 	}
 	joined.Reshape(append([]int{len(input)}, fst.Shape()...)...)
 	inputFloat := joined.Data().([]float32)
+	start = mm.Since(metrics.Preprocess, start)
+	mm.Batch(len(input))
 
-	err = p.predictor.Predict(ctx, inputFloat)
+	defer p.profileLayers(span)()
+	timings, err := p.timePredict(ctx, inputFloat)
 	if err != nil {
-		return errors.Wrapf(err, "failed to perform Predict")
+		return err
 	}
+	p.timings = timings
+	mm.Since(metrics.Inference, start)
 
 	return nil
 }
@@ -158,17 +254,37 @@ func (p *ImageClassificationPredictor) ReadPredictedFeatures(ctx context.Context
 	span, ctx := tracer.StartSpanFromContext(ctx, tracer.APPLICATION_TRACE, "read_predicted_features")
 	defer span.Finish()
 
-	outputs, err := p.predictor.ReadPredictionOutputs(ctx)
+	if err := p.inflight.acquire(); err != nil {
+		return nil, err
+	}
+	defer p.inflight.release()
+
+	mm := p.modelMetrics()
+	start := time.Now()
+	defer func() {
+		mm.Since(metrics.Postprocess, start)
+	}()
+
+	outputs, timings, err := p.timeRead(ctx, p.timings)
 	if err != nil {
+		mm.Error(err)
 		return nil, err
 	}
+	tagTimings(span, timings)
+	mm.ObserveTimings(timings)
 
 	labels, err := p.GetLabels()
 	if err != nil {
+		mm.Error(err)
 		return nil, err
 	}
 
-	return p.CreateClassificationFeaturesFrom1D(ctx, outputs[0], labels)
+	features, err := p.CreateClassificationFeaturesFrom1D(ctx, outputs[0], labels)
+	if err != nil {
+		return nil, err
+	}
+	annotateFeatures(features, timings)
+	return features, nil
 }
 
 // Modality()
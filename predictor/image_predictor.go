@@ -2,6 +2,9 @@ package predictor
 
 import (
 	"context"
+	"path/filepath"
+	"sync"
+	"time"
 
 	opentracing "github.com/opentracing/opentracing-go"
 	olog "github.com/opentracing/opentracing-go/log"
@@ -9,25 +12,69 @@ import (
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework/options"
 	common "github.com/rai-project/dlframework/framework/predictor"
-	"github.com/rai-project/downloadmanager"
 	gotensorrt "github.com/rai-project/go-tensorrt"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/rai-project/tensorrt/cache"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/memory"
+	"github.com/rai-project/tensorrt/metrics"
+	"github.com/rai-project/tensorrt/profile"
 )
 
 type ImagePredictor struct {
 	common.ImagePredictor
+	format    manifest.Format
 	predictor *gotensorrt.Predictor
+	inflight  inflight
+	closeOnce sync.Once
+	release   func()
+	// status is the lifecycle of the load, nil for predictors that only
+	// download their model
+	status *lifecycle.Model
+	// layers accumulates the layer profiles of the predictions
+	layers *profile.Aggregate
+	// memory is the device memory reserved for the model once it is loaded
+	memory *memory.Reservation
+	// measuredMemory is the device memory the build of the engine took, when
+	// memoryMeasured is set
+	measuredMemory int64
+	memoryMeasured bool
+	// precision is the precision the engine runs at, the one recorded in the
+	// metadata of engine plans or the requested one for the engines built by
+	// the predictor
+	precision string
 }
 
 func (p *ImagePredictor) Close() error {
 	if p == nil {
 		return nil
 	}
-	if p.predictor != nil {
-		p.predictor.Close()
-	}
+	p.closeOnce.Do(func() {
+		untrack(p)
+		p.logLayerTable()
+		if p.release != nil {
+			p.release()
+		}
+		if p.predictor != nil {
+			p.predictor.Close()
+		}
+		p.releaseMemory()
+		p.status.Set(lifecycle.Closed)
+	})
 	return nil
 }
 
+func (p *ImagePredictor) modelKey() string {
+	return tensorrt.ModelKey(p.Model.GetName(), p.Model.GetVersion())
+}
+
+// modelMetrics returns the metrics of the model of the predictor.
+func (p *ImagePredictor) modelMetrics() *metrics.Model {
+	return metrics.Default.Model(p.Model.GetName(), p.Model.GetVersion())
+}
+
 func (p *ImagePredictor) GetOutputLayerName(layer string) (string, error) {
 	model := p.Model
 	modelOutput := model.GetOutput()
@@ -39,7 +86,18 @@ func (p *ImagePredictor) GetOutputLayerName(layer string) (string, error) {
 	return name, nil
 }
 
-func (p *ImagePredictor) Load(ctx context.Context, model dlframework.ModelManifest, opts ...options.Option) (*ImagePredictor, error) {
+// newImagePredictor resolves the framework, work directory and format of
+// the model.
+func newImagePredictor(model dlframework.ModelManifest, opts ...options.Option) (*ImagePredictor, error) {
+	if tensorrt.IsModelRemoved(model.GetName(), model.GetVersion()) {
+		return nil, errors.Errorf("the manifest of model %s:%s was removed", model.GetName(), model.GetVersion())
+	}
+	// dlframework hands over the manifest it registered first, an edited
+	// manifest replaces it
+	if reloaded, ok := tensorrt.ReloadedModel(model.GetName(), model.GetVersion()); ok {
+		model = reloaded
+	}
+
 	framework, err := model.ResolveFramework()
 	if err != nil {
 		return nil, err
@@ -50,7 +108,12 @@ func (p *ImagePredictor) Load(ctx context.Context, model dlframework.ModelManife
 		return nil, err
 	}
 
-	ip := &ImagePredictor{
+	format, err := modelFormat(model)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImagePredictor{
 		ImagePredictor: common.ImagePredictor{
 			Base: common.Base{
 				Framework: framework,
@@ -59,49 +122,51 @@ func (p *ImagePredictor) Load(ctx context.Context, model dlframework.ModelManife
 				Options:   options.New(opts...),
 			},
 		},
-	}
+		format: format,
+		layers: profile.NewAggregate(),
+	}, nil
+}
 
-	if err = ip.download(ctx); err != nil {
+func (p *ImagePredictor) Load(ctx context.Context, model dlframework.ModelManifest, opts ...options.Option) (*ImagePredictor, error) {
+	ip, err := newImagePredictor(model, opts...)
+	if err != nil {
 		return nil, err
 	}
 
-	// if err = ip.loadPredictor(ctx); err != nil {
-	// 	return nil, err
-	// }
+	ip.status = lifecycle.Default.Track(model.GetName(), model.GetVersion())
+	ip.status.Set(lifecycle.Downloading)
+	start := time.Now()
+	// the work directory is marked in use before its lock is released, so
+	// that it cannot be evicted between the download and the load
+	err = ip.download(ctx, func() (err error) {
+		ip.release, err = cache.Acquire(ip.WorkDir)
+		return err
+	})
+	if err != nil {
+		ip.status.Fail(err)
+		return nil, err
+	}
+	ip.modelMetrics().Load(metrics.Download, time.Since(start))
+	tensorrt.SweepCache()
 
+	ip.status.Set(lifecycle.Building)
 	return ip, nil
 }
 
 func (p *ImagePredictor) Download(ctx context.Context, model dlframework.ModelManifest, opts ...options.Option) error {
-	framework, err := model.ResolveFramework()
+	ip, err := newImagePredictor(model, opts...)
 	if err != nil {
 		return err
 	}
 
-	workDir, err := model.WorkDir()
-	if err != nil {
-		return err
-	}
-
-	ip := &ImagePredictor{
-		ImagePredictor: common.ImagePredictor{
-			Base: common.Base{
-				Framework: framework,
-				Model:     model,
-				WorkDir:   workDir,
-				Options:   options.New(opts...),
-			},
-		},
-	}
-
-	if err = ip.download(ctx); err != nil {
-		return err
-	}
-
-	return nil
+	return ip.download(ctx, func() error {
+		return cache.Touch(ip.WorkDir)
+	})
 }
 
-func (p *ImagePredictor) download(ctx context.Context) error {
+// download places the model files in the work directory and calls ready
+// while still holding the lock of the work directory.
+func (p *ImagePredictor) download(ctx context.Context, ready func() error) error {
 	span, ctx := opentracing.StartSpanFromContext(
 		ctx,
 		"download",
@@ -110,63 +175,44 @@ func (p *ImagePredictor) download(ctx context.Context) error {
 			"target_graph_file":   p.GetGraphPath(),
 			"weights_url":         p.GetWeightsUrl(),
 			"target_weights_file": p.GetWeightsPath(),
+			"model_format":        p.format.String(),
 		},
 	)
 	defer span.Finish()
 
-	model := p.Model
-
-	if model.Model.IsArchive {
-		baseURL := model.Model.BaseUrl
-		span.LogFields(
-			olog.String("event", "download model archive"),
-		)
+	unlock, err := artifact.Lock(ctx, filepath.Join(p.WorkDir, artifact.LockFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
-		_, err := downloadmanager.DownloadInto(baseURL, p.WorkDir, downloadmanager.Context(ctx))
-		if err != nil {
-			return errors.Wrapf(err, "failed to download model archive from %v", model.Model.BaseUrl)
-		}
+	if tensorrt.Config.Offline {
+		err = p.resolveOffline(ctx)
 	} else {
-		span.LogFields(
-			olog.String("event", "download model graph"),
-		)
-		_, _, err := downloadmanager.DownloadFile(
-			p.GetGraphUrl(),
-			p.GetGraphPath(),
-			downloadmanager.MD5Sum(p.GetGraphChecksum()),
-		)
-		if err != nil {
-			return err
-		}
+		err = p.fetchArtifacts(ctx, span)
+	}
+	if err != nil {
+		return err
+	}
+	return ready()
+}
 
-		span.LogFields(
-			olog.String("event", "download model weights"),
-		)
-		_, _, err = downloadmanager.DownloadFile(
-			p.GetWeightsUrl(),
-			p.GetWeightsPath(),
-			downloadmanager.MD5Sum(p.GetWeightsChecksum()),
-		)
-		if err != nil {
-			return err
-		}
+// fetchArtifacts downloads the model files missing from the work directory,
+// the caller holds its lock.
+func (p *ImagePredictor) fetchArtifacts(ctx context.Context, span opentracing.Span) error {
+	artifacts := p.artifacts()
+	if err := p.requireChecksums(artifacts); err != nil {
+		return err
 	}
 
-	if p.GetFeaturesUrl() != "" {
+	mirrors := tensorrt.Mirrors()
+	downloader := p.newDownloader(span)
+	return artifact.MaterializeLocked(ctx, artifacts, tensorrt.Config.DownloadParallelism, func(ctx context.Context, a artifact.Artifact) error {
 		span.LogFields(
-			olog.String("event", "download features"),
-		)
-		_, _, err := downloadmanager.DownloadFile(
-			p.GetFeaturesUrl(),
-			p.GetFeaturesPath(),
-			downloadmanager.MD5Sum(p.GetFeaturesChecksum()),
+			olog.String("event", "download "+a.Kind),
 		)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+		return mirrors.Do(ctx, a, p.downloadArtifact(downloader, a))
+	})
 }
 
 // func (p *ImagePredictor) loadPredictor(ctx context.Context) error {
@@ -0,0 +1,109 @@
+package predictor
+
+import (
+	"os"
+	"sync"
+
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/memory"
+	"github.com/rai-project/tensorrt/native"
+)
+
+// estimateMemory estimates the device memory of the model before its engine
+// is built. The engine takes about the size of the graph and weights files,
+// the workspace tensorrt.workspace_size, and the bindings batchSize of their
+// tensors.
+func (p *ImagePredictor) estimateMemory(batchSize int, inputName string, inputDims []int, outputName string, outputDims []int) (memory.Usage, error) {
+	workspace, err := tensorrt.WorkspaceSize()
+	if err != nil {
+		return memory.Usage{}, err
+	}
+	u := memory.Usage{
+		Workspace: workspace,
+		Bindings: []memory.Binding{
+			{Name: inputName, Bytes: memory.TensorBytes(batchSize, inputDims, memory.Float32Size), IsInput: true},
+			{Name: outputName, Bytes: memory.TensorBytes(batchSize, outputDims, memory.Float32Size)},
+		},
+	}
+	for _, path := range []string{p.GetGraphPath(), p.GetWeightsPath()} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			u.Engine += info.Size()
+		}
+	}
+	return u, nil
+}
+
+// reserveMemory reserves the estimated device memory of the model, refusing
+// to load it when the loaded models would exceed tensorrt.memory_budget.
+func (p *ImagePredictor) reserveMemory(u memory.Usage) error {
+	r, err := tensorrt.ReserveMemory(p.modelKey(), u)
+	if err != nil {
+		return err
+	}
+	p.memory = r
+	return nil
+}
+
+// builds serializes the engine builds, so that the free memory of the device
+// only changes with the build being measured.
+var builds sync.Mutex
+
+// memGetInfo is replaced by the tests.
+var memGetInfo = native.MemGetInfo
+
+// measureBuild runs build and returns the device memory it took, measured as
+// the drop of the free memory of the device. measured is false when the
+// free memory cannot be queried, as in builds without the gpu tag. Other
+// processes allocating on the device at the same time skew the measure.
+func measureBuild(device int, build func() error) (used int64, measured bool, err error) {
+	builds.Lock()
+	defer builds.Unlock()
+	before, _, err := memGetInfo(device)
+	if err != nil {
+		return 0, false, build()
+	}
+	if err := build(); err != nil {
+		return 0, false, err
+	}
+	after, _, err := memGetInfo(device)
+	if err != nil {
+		return 0, false, nil
+	}
+	if after > before {
+		return 0, true, nil
+	}
+	return before - after, true, nil
+}
+
+// recordMemory records the estimated device memory of the loaded model, and
+// the measured one when there is one, in the metrics.
+func (p *ImagePredictor) recordMemory() {
+	p.modelMetrics().Memory(p.memory.Usage())
+	if used, ok := p.MeasuredMemory(); ok {
+		p.modelMetrics().MeasuredMemory(used)
+	}
+}
+
+// EstimatedMemory returns the device memory the model is estimated to use
+// before its engine is built. The budget is checked against it.
+func (p *ImagePredictor) EstimatedMemory() memory.Usage {
+	return p.memory.Usage()
+}
+
+// MeasuredMemory returns the device memory the build of the engine took, and
+// false when it was not measured.
+func (p *ImagePredictor) MeasuredMemory() (int64, bool) {
+	return p.measuredMemory, p.memoryMeasured
+}
+
+func (p *ImagePredictor) releaseMemory() {
+	if p.memory == nil {
+		return
+	}
+	p.memory.Release()
+	p.memory = nil
+	p.modelMetrics().ForgetMemory()
+}
@@ -0,0 +1,48 @@
+package predictor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rai-project/tensorrt/native"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeasureBuild(t *testing.T) {
+	defer func(f func(int) (int64, int64, error)) { memGetInfo = f }(memGetInfo)
+
+	free := int64(8 << 30)
+	memGetInfo = func(device int) (int64, int64, error) {
+		assert.Equal(t, 1, device)
+		return free, 16 << 30, nil
+	}
+	used, measured, err := measureBuild(1, func() error {
+		free -= 300 << 20
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, measured)
+	assert.Equal(t, int64(300<<20), used)
+
+	_, _, err = measureBuild(1, func() error { return errors.New("cannot parse the model") })
+	assert.EqualError(t, err, "cannot parse the model")
+
+	// memory freed by others during the build does not count as negative
+	used, measured, err = measureBuild(1, func() error {
+		free += 1 << 30
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, measured)
+	assert.Equal(t, int64(0), used)
+
+	memGetInfo = func(int) (int64, int64, error) { return 0, 0, native.ErrUnavailable }
+	built := false
+	_, measured, err = measureBuild(1, func() error {
+		built = true
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, built)
+	assert.False(t, measured)
+}
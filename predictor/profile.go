@@ -0,0 +1,62 @@
+package predictor
+
+import (
+	"bytes"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/profile"
+)
+
+// profileLayers enables the TensorRT profiler for one prediction when layer
+// profiling is enabled. The returned function reads the layer records back,
+// adds one child span of span per layer and accumulates them in the layer
+// profile of the predictor.
+func (p *ImagePredictor) profileLayers(span opentracing.Span) func() {
+	if !tensorrt.Config.ProfileLayers || p.predictor == nil {
+		return func() {}
+	}
+	if err := p.predictor.StartProfiling("predict", p.modelKey()); err != nil {
+		log.WithError(err).Warn("cannot profile the layers")
+		return func() {}
+	}
+	return func() {
+		records, err := p.readLayerProfile()
+		if err != nil {
+			log.WithError(err).Warn("cannot read the layer profile")
+			return
+		}
+		profile.Spans(span, records)
+		p.layers.Add(records)
+	}
+}
+
+func (p *ImagePredictor) readLayerProfile() ([]profile.Record, error) {
+	defer p.predictor.DisableProfiling()
+	if err := p.predictor.EndProfiling(); err != nil {
+		return nil, errors.Wrap(err, "cannot stop the profiler")
+	}
+	data, err := p.predictor.ReadProfile()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the profile")
+	}
+	return profile.Parse([]byte(data))
+}
+
+// LayerProfile returns the per-layer stats of the predictions profiled so
+// far, the most expensive layers first.
+func (p *ImagePredictor) LayerProfile() []profile.LayerStats {
+	return p.layers.Layers()
+}
+
+// logLayerTable logs the layer profile of the predictor as a table.
+func (p *ImagePredictor) logLayerTable() {
+	layers := p.LayerProfile()
+	if len(layers) == 0 {
+		return
+	}
+	var buf bytes.Buffer
+	profile.WriteTable(&buf, layers)
+	log.WithField("model", p.modelKey()).Info("layer profile\n" + buf.String())
+}
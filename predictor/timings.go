@@ -0,0 +1,65 @@
+package predictor
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework"
+	"github.com/rai-project/tensorrt/backend"
+)
+
+// timePredict runs the prediction of data and returns its timings so far.
+// go-tensorrt copies the inputs to the device within Predict and cannot time
+// the copy apart, so it is part of the compute time.
+func (p *ImagePredictor) timePredict(ctx context.Context, data []float32) (backend.Timings, error) {
+	start := time.Now()
+	if err := p.predictor.Predict(ctx, data); err != nil {
+		return backend.Timings{}, errors.Wrap(err, "failed to perform Predict")
+	}
+	return backend.Timings{Compute: time.Since(start)}, nil
+}
+
+// timeRead reads the outputs of the last prediction back to the host and
+// completes t with the time it took.
+func (p *ImagePredictor) timeRead(ctx context.Context, t backend.Timings) ([][]float32, backend.Timings, error) {
+	start := time.Now()
+	outputs, err := p.predictor.ReadPredictionOutputs(ctx)
+	if err != nil {
+		return nil, t, err
+	}
+	t.DeviceToHost = time.Since(start)
+	return outputs, t, nil
+}
+
+// timingsMetadata are the timings as they are attached to features.
+func timingsMetadata(t backend.Timings) map[string]string {
+	return map[string]string{
+		"compute_ns":        strconv.FormatInt(int64(t.Compute), 10),
+		"device_to_host_ns": strconv.FormatInt(int64(t.DeviceToHost), 10),
+	}
+}
+
+// tagTimings tags span with the timings of a prediction, in nanoseconds.
+func tagTimings(span opentracing.Span, t backend.Timings) {
+	span.SetTag("compute_ns", int64(t.Compute))
+	span.SetTag("device_to_host_ns", int64(t.DeviceToHost))
+}
+
+// annotateFeatures adds the timings of the prediction to the metadata of its
+// features.
+func annotateFeatures(features []dlframework.Features, t backend.Timings) {
+	metadata := timingsMetadata(t)
+	for _, fs := range features {
+		for _, f := range fs {
+			if f.Metadata == nil {
+				f.Metadata = map[string]string{}
+			}
+			for k, v := range metadata {
+				f.Metadata[k] = v
+			}
+		}
+	}
+}
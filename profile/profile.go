@@ -0,0 +1,182 @@
+// Package profile turns the layer timings the TensorRT profiler records
+// during a prediction into tracing spans, and aggregates them into a
+// per-layer table.
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+// Record is the execution of a layer during a prediction.
+type Record struct {
+	Name string
+	// Type is the type of the layer, empty when the profiler does not say
+	Type     string
+	Index    int
+	Start    time.Time
+	Duration time.Duration
+}
+
+// End ...
+func (r Record) End() time.Time {
+	return r.Start.Add(r.Duration)
+}
+
+// trace is the JSON profile go-tensorrt reads back from its IProfiler. Times
+// are in nanoseconds since the epoch, the metadata of the layers is their
+// type.
+type trace struct {
+	Name     string `json:"name"`
+	Start    int64  `json:"start"`
+	End      int64  `json:"end"`
+	Elements []struct {
+		Name     string `json:"name"`
+		Metadata string `json:"metadata"`
+		Start    int64  `json:"start"`
+		End      int64  `json:"end"`
+		Index    int    `json:"layer_sequence_index"`
+	} `json:"elements"`
+}
+
+// Parse reads the layer records of a profile. The TensorRT profiler only
+// reports layer durations, layers without a start time are laid out one
+// after the other from the start of the profile.
+func Parse(data []byte) ([]Record, error) {
+	var t trace
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, errors.Wrap(err, "cannot parse the layer profile")
+	}
+	records := make([]Record, 0, len(t.Elements))
+	next := time.Unix(0, t.Start)
+	for _, e := range t.Elements {
+		if e.End < e.Start {
+			return nil, errors.Errorf("layer %s of the profile ends before it starts", e.Name)
+		}
+		r := Record{Name: e.Name, Type: e.Metadata, Index: e.Index, Duration: time.Duration(e.End - e.Start)}
+		if e.Start != 0 {
+			r.Start = time.Unix(0, e.Start)
+		} else {
+			r.Start = next
+		}
+		next = r.End()
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// Spans starts and finishes a child span of parent per record, named after
+// the layer and tagged with its name, type, index and duration.
+func Spans(parent opentracing.Span, records []Record) {
+	tracer := parent.Tracer()
+	for _, r := range records {
+		span := tracer.StartSpan(
+			r.Name,
+			opentracing.ChildOf(parent.Context()),
+			opentracing.StartTime(r.Start),
+			opentracing.Tags{
+				"layer_name":     r.Name,
+				"layer_type":     r.Type,
+				"layer_index":    r.Index,
+				"layer_duration": r.Duration.String(),
+			},
+		)
+		span.FinishWithOptions(opentracing.FinishOptions{FinishTime: r.End()})
+	}
+}
+
+// LayerStats are the aggregated executions of a layer.
+type LayerStats struct {
+	Name  string
+	Type  string
+	Index int
+	Count int
+	Total time.Duration
+	Min   time.Duration
+	Max   time.Duration
+}
+
+// Mean ...
+func (s LayerStats) Mean() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Count)
+}
+
+// Aggregate accumulates the records of many predictions per layer. It is
+// safe for concurrent use.
+type Aggregate struct {
+	mu     sync.Mutex
+	layers map[string]*LayerStats
+}
+
+// NewAggregate ...
+func NewAggregate() *Aggregate {
+	return &Aggregate{layers: map[string]*LayerStats{}}
+}
+
+// Add accumulates the records of a prediction.
+func (a *Aggregate) Add(records []Record) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for _, r := range records {
+		s, ok := a.layers[r.Name]
+		if !ok {
+			s = &LayerStats{Name: r.Name, Type: r.Type, Index: r.Index, Min: r.Duration}
+			a.layers[r.Name] = s
+		}
+		s.Count++
+		s.Total += r.Duration
+		if r.Duration < s.Min {
+			s.Min = r.Duration
+		}
+		if r.Duration > s.Max {
+			s.Max = r.Duration
+		}
+	}
+}
+
+// Layers returns the stats of the layers, the most expensive first.
+func (a *Aggregate) Layers() []LayerStats {
+	a.mu.Lock()
+	layers := make([]LayerStats, 0, len(a.layers))
+	for _, s := range a.layers {
+		layers = append(layers, *s)
+	}
+	a.mu.Unlock()
+	sort.Slice(layers, func(i, j int) bool {
+		if layers[i].Total != layers[j].Total {
+			return layers[i].Total > layers[j].Total
+		}
+		return layers[i].Index < layers[j].Index
+	})
+	return layers
+}
+
+// WriteTable writes the stats of the layers as a table, the most expensive
+// layers first, with their share of the total time.
+func WriteTable(w io.Writer, layers []LayerStats) error {
+	var total time.Duration
+	for _, s := range layers {
+		total += s.Total
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "LAYER\tTYPE\tCALLS\tTOTAL\tMEAN\tMIN\tMAX\tPERCENT")
+	for _, s := range layers {
+		percent := 0.0
+		if total > 0 {
+			percent = 100 * float64(s.Total) / float64(total)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%v\t%v\t%v\t%v\t%.1f%%\n", s.Name, s.Type, s.Count, s.Total, s.Mean(), s.Min, s.Max, percent)
+	}
+	return tw.Flush()
+}
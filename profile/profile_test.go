@@ -0,0 +1,107 @@
+package profile
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/stretchr/testify/assert"
+)
+
+// start of the synthetic profiles, in nanoseconds since the epoch
+const start = int64(1561939200000000000)
+
+var syntheticProfile = `{
+	"name": "predict",
+	"start": 1561939200000000000,
+	"end": 1561939200004000000,
+	"elements": [
+		{"name": "conv1", "metadata": "Convolution", "layer_sequence_index": 0, "start": 1561939200000000000, "end": 1561939200002500000},
+		{"name": "relu1", "metadata": "Activation", "layer_sequence_index": 1, "start": 1561939200002500000, "end": 1561939200003000000},
+		{"name": "fc1", "metadata": "FullyConnected", "layer_sequence_index": 2, "start": 1561939200003000000, "end": 1561939200004000000}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	records, err := Parse([]byte(syntheticProfile))
+	assert.NoError(t, err)
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, Record{Name: "conv1", Type: "Convolution", Start: time.Unix(0, start), Duration: 2500 * time.Microsecond}, records[0])
+		assert.Equal(t, 2, records[2].Index)
+		assert.Equal(t, time.Unix(0, start+4000000), records[2].End())
+	}
+
+	// durations only, as IProfiler::reportLayerTime reports them
+	records, err = Parse([]byte(`{"start": 1561939200000000000, "elements": [
+		{"name": "conv1", "start": 0, "end": 2000000},
+		{"name": "fc1", "start": 0, "end": 1000000}
+	]}`))
+	assert.NoError(t, err)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, time.Unix(0, start), records[0].Start)
+		assert.Equal(t, records[0].End(), records[1].Start)
+		assert.Equal(t, time.Millisecond, records[1].Duration)
+	}
+
+	_, err = Parse([]byte(`{"elements": [{"name": "conv1", "start": 10, "end": 5}]}`))
+	assert.Error(t, err)
+	_, err = Parse([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestSpans(t *testing.T) {
+	tracer := mocktracer.New()
+	parent := tracer.StartSpan("predict")
+	records, err := Parse([]byte(syntheticProfile))
+	assert.NoError(t, err)
+	Spans(parent, records)
+	parent.Finish()
+
+	spans := tracer.FinishedSpans()
+	if !assert.Len(t, spans, 4) {
+		return
+	}
+	predict := spans[3]
+	assert.Equal(t, "predict", predict.OperationName)
+	for ii, name := range []string{"conv1", "relu1", "fc1"} {
+		span := spans[ii]
+		assert.Equal(t, name, span.OperationName)
+		assert.Equal(t, predict.SpanContext.SpanID, span.ParentID)
+		assert.Equal(t, name, span.Tag("layer_name"))
+		assert.Equal(t, ii, span.Tag("layer_index"))
+		assert.Equal(t, records[ii].Start, span.StartTime)
+		assert.Equal(t, records[ii].End(), span.FinishTime)
+	}
+	assert.Equal(t, "Convolution", spans[0].Tag("layer_type"))
+	assert.Equal(t, "2.5ms", spans[0].Tag("layer_duration"))
+}
+
+func TestAggregate(t *testing.T) {
+	records, err := Parse([]byte(syntheticProfile))
+	assert.NoError(t, err)
+	a := NewAggregate()
+	a.Add(records)
+	records[1].Duration = 3 * time.Millisecond
+	a.Add(records)
+
+	layers := a.Layers()
+	if assert.Len(t, layers, 3) {
+		assert.Equal(t, []string{"conv1", "relu1", "fc1"}, []string{layers[0].Name, layers[1].Name, layers[2].Name})
+		relu := layers[1]
+		assert.Equal(t, 2, relu.Count)
+		assert.Equal(t, 3500*time.Microsecond, relu.Total)
+		assert.Equal(t, 500*time.Microsecond, relu.Min)
+		assert.Equal(t, 3*time.Millisecond, relu.Max)
+		assert.Equal(t, 1750*time.Microsecond, relu.Mean())
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteTable(&buf, layers))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if assert.Len(t, lines, 4) {
+		assert.Equal(t, []string{"LAYER", "TYPE", "CALLS", "TOTAL", "MEAN", "MIN", "MAX", "PERCENT"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"conv1", "Convolution", "2", "5ms", "2.5ms", "2.5ms", "2.5ms", "47.6%"}, strings.Fields(lines[1]))
+	}
+}
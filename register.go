@@ -1,42 +1,65 @@
 package tensorrt
 
 import (
-	"os"
-
-	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework"
 )
 
 // FrameworkManifest ...
 var FrameworkManifest = dlframework.FrameworkManifest{
-	Name:    "TensorRT",
-	Version: "7.0.0",
-	Container: map[string]*dlframework.ContainerHardware{
-		"amd64": {
-			Cpu: "raiproject/carml-tensorrt:amd64-cpu",
-			Gpu: "raiproject/carml-tensorrt:amd64-gpu",
-		},
-		"ppc64le": {
-			Cpu: "raiproject/carml-tensorrt:ppc64le-gpu",
-			Gpu: "raiproject/carml-tensorrt:ppc64le-gpu",
-		},
-	},
+	Name:      "TensorRT",
+	Version:   CompiledVersion,
+	Container: platformContainers(Platforms),
 }
 
-func assetFS() *assetfs.AssetFS {
-	assetInfo := func(path string) (os.FileInfo, error) {
-		return os.Stat(path)
-	}
-	for k := range _bintree.Children {
-		return &assetfs.AssetFS{Asset: Asset, AssetDir: AssetDir, AssetInfo: assetInfo, Prefix: k}
+// Register registers the framework and the builtin models whose framework
+// version constraint matches the linked TensorRT version.
+func Register() {
+	if err := register(); err != nil {
+		log.WithError(err).Error("Failed to register server")
+		return
 	}
-	panic("unreachable")
+	log.WithFields(metadataFields()).Info("registered the TensorRT framework")
 }
 
-func Register() {
-	err := framework.Register(FrameworkManifest, assetFS())
+func register() error {
+	if _, err := CurrentPlatform(); err != nil {
+		return err
+	}
+
+	files, err := collectManifests()
 	if err != nil {
-		log.WithError(err).Error("Failed to register server")
+		return err
 	}
+
+	files = filterModels(files)
+	if err := framework.Register(FrameworkManifest, newAssetFS(files)); err != nil {
+		return err
+	}
+
+	if err := watchModelsDirs(files); err != nil {
+		log.WithError(err).Error("not reloading the models directories")
+	}
+	return nil
+}
+
+// filterModels drops the models whose framework version constraint does not
+// match FrameworkManifest, unless Config.IgnoreVersionConstraints is set.
+func filterModels(files []manifestFile) []manifestFile {
+	ignore := Config.IgnoreVersionConstraints
+	kept, mismatched := checkVersionConstraints(files, FrameworkManifest.Version, ignore)
+
+	skipped := []string{}
+	for _, m := range mismatched {
+		entry := log.WithField("file", m.File).WithField("model", m.Model)
+		if ignore {
+			entry.Warn(m.Reason + ", registering it anyway")
+			continue
+		}
+		entry.Warn(m.Reason + ", not registering it")
+		skipped = append(skipped, m.File)
+	}
+	setRegistration(ignore, skipped)
+
+	return kept
 }
@@ -0,0 +1,136 @@
+package tensorrt
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	assetfs "github.com/elazarl/go-bindata-assetfs"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// manifestFile is a model manifest waiting to be registered.
+type manifestFile struct {
+	Name string
+	Data []byte
+}
+
+// skippedModel is a model left out of registration and the reason why.
+type skippedModel struct {
+	File   string
+	Model  string
+	Reason string
+}
+
+func builtinManifests() ([]manifestFile, error) {
+	files, err := readManifestsFS(BuiltinModels, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the builtin manifests")
+	}
+	return files, nil
+}
+
+// readManifestsFS reads the manifests in fsys, in lexical order. The files
+// are named after their path within fsys, joined to root.
+func readManifestsFS(fsys fs.FS, root string) ([]manifestFile, error) {
+	paths, err := manifest.FindFS(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]manifestFile, 0, len(paths))
+	for _, path := range paths {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read manifest %s", path)
+		}
+		files = append(files, manifestFile{Name: filepath.Join(root, filepath.FromSlash(path)), Data: data})
+	}
+	return files, nil
+}
+
+// checkVersionConstraints splits the manifests into those whose framework
+// version constraint is satisfied by version and those that are not. When
+// ignore is set every manifest is kept, but the mismatches are still
+// reported.
+func checkVersionConstraints(files []manifestFile, version string, ignore bool) ([]manifestFile, []skippedModel) {
+	var kept []manifestFile
+	var mismatched []skippedModel
+	for _, file := range files {
+		m, err := manifest.Parse(file.Data)
+		if err != nil {
+			mismatched = append(mismatched, skippedModel{File: file.Name, Reason: err.Error()})
+			if ignore {
+				kept = append(kept, file)
+			}
+			continue
+		}
+		ok, err := manifest.ConstraintMatches(m.Framework.Version, version)
+		if err == nil && !ok {
+			err = errors.Errorf("framework version constraint %q does not match TensorRT %s", m.Framework.Version, version)
+		}
+		if err != nil {
+			mismatched = append(mismatched, skippedModel{File: file.Name, Model: m.CanonicalName(), Reason: err.Error()})
+			if !ignore {
+				continue
+			}
+		}
+		kept = append(kept, file)
+	}
+	return kept, mismatched
+}
+
+// newAssetFS exposes the manifests through the assetfs interface
+// framework.Register expects.
+func newAssetFS(files []manifestFile) *assetfs.AssetFS {
+	data := make(map[string][]byte, len(files))
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		name := strings.TrimPrefix(file.Name, "/")
+		if _, ok := data[name]; !ok {
+			names = append(names, name)
+		}
+		data[name] = file.Data
+	}
+	sort.Strings(names)
+
+	lookup := func(name string) ([]byte, error) {
+		bts, ok := data[strings.TrimPrefix(name, "/")]
+		if !ok {
+			return nil, errors.Errorf("manifest %s not found", name)
+		}
+		return bts, nil
+	}
+	return &assetfs.AssetFS{
+		Asset: lookup,
+		AssetDir: func(name string) ([]string, error) {
+			if strings.Trim(name, "/") != "" {
+				return nil, errors.Errorf("manifest directory %s not found", name)
+			}
+			return append([]string(nil), names...), nil
+		},
+		AssetInfo: func(name string) (os.FileInfo, error) {
+			bts, err := lookup(name)
+			if err != nil {
+				return nil, err
+			}
+			return manifestFileInfo{name: name, size: int64(len(bts))}, nil
+		},
+	}
+}
+
+type manifestFileInfo struct {
+	name string
+	size int64
+}
+
+func (fi manifestFileInfo) Name() string       { return fi.name }
+func (fi manifestFileInfo) Size() int64        { return fi.size }
+func (fi manifestFileInfo) Mode() os.FileMode  { return 0444 }
+func (fi manifestFileInfo) ModTime() time.Time { return time.Time{} }
+func (fi manifestFileInfo) IsDir() bool        { return false }
+func (fi manifestFileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,108 @@
+package tensorrt
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testManifest(name, constraint string) manifestFile {
+	return manifestFile{
+		Name: name + ".yml",
+		Data: []byte(`
+name: ` + name + `
+framework:
+  name: TensorRT
+  version: '` + constraint + `'
+version: 1.0
+`),
+	}
+}
+
+func TestCheckVersionConstraints(t *testing.T) {
+	files := []manifestFile{
+		testManifest("ResNet50_v1", "7.0.0"),
+		testManifest("BVLC-AlexNet", "6.0.1"),
+		testManifest("VGG16", ">=6.0.1"),
+		testManifest("NIN", "not a version"),
+	}
+
+	kept, mismatched := checkVersionConstraints(files, "7.0.0", false)
+	assert.Len(t, kept, 2)
+	assert.Equal(t, "ResNet50_v1.yml", kept[0].Name)
+	assert.Equal(t, "VGG16.yml", kept[1].Name)
+	assert.Len(t, mismatched, 2)
+	assert.Equal(t, "BVLC-AlexNet:1.0", mismatched[0].Model)
+	assert.Contains(t, mismatched[0].Reason, `"6.0.1"`)
+	assert.Equal(t, "NIN.yml", mismatched[1].File)
+
+	kept, mismatched = checkVersionConstraints(files, "7.0.0", true)
+	assert.Len(t, kept, 4)
+	assert.Len(t, mismatched, 2)
+}
+
+func TestBuiltinModelsMatchFramework(t *testing.T) {
+	files, err := builtinManifests()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	_, mismatched := checkVersionConstraints(files, FrameworkManifest.Version, false)
+	assert.Empty(t, mismatched)
+}
+
+func TestNewAssetFS(t *testing.T) {
+	private := testManifest("Private", "7.0.0")
+	private.Name = "/models/private.yml"
+	fs := newAssetFS([]manifestFile{
+		testManifest("VGG16", "7.0.0"),
+		testManifest("ResNet50_v1", "7.0.0"),
+		private,
+	})
+
+	names, err := fs.AssetDir("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ResNet50_v1.yml", "VGG16.yml", "models/private.yml"}, names)
+
+	data, err := fs.Asset("/models/private.yml")
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "name: Private")
+
+	data, err = fs.Asset("VGG16.yml")
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "name: VGG16")
+
+	info, err := fs.AssetInfo("VGG16.yml")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(data)), info.Size())
+
+	_, err = fs.Asset("NIN.yml")
+	assert.Error(t, err)
+	_, err = fs.AssetDir("models")
+	assert.Error(t, err)
+}
+
+func TestBuiltinManifests(t *testing.T) {
+	defer func(fsys fs.FS) { BuiltinModels = fsys }(BuiltinModels)
+
+	files, err := builtinManifests()
+	assert.NoError(t, err)
+	embedded, err := filepath.Glob(filepath.Join("builtin_models", "*.yml"))
+	assert.NoError(t, err)
+	assert.Len(t, files, len(embedded))
+
+	BuiltinModels = fstest.MapFS{
+		"b.yml":         {Data: testManifest("Private-B", "7.0.0").Data},
+		"nested/a.yaml": {Data: testManifest("Private-A", "7.0.0").Data},
+		"README.md":     {Data: []byte("# models")},
+	}
+	files, err = builtinManifests()
+	assert.NoError(t, err)
+	if assert.Len(t, files, 2) {
+		assert.Equal(t, "b.yml", files[0].Name)
+		assert.Equal(t, filepath.Join("nested", "a.yaml"), files[1].Name)
+		assert.Equal(t, testManifest("Private-A", "7.0.0").Data, files[1].Data)
+	}
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/metrics"
+	"github.com/rai-project/tensorrt/server/pb"
+	"google.golang.org/grpc"
+)
+
+// StreamQueueSize bounds the requests of a stream received but not run yet.
+var StreamQueueSize = 64
+
+// RegisterGRPC adds the streaming prediction service to g.
+func (s *Server) RegisterGRPC(g *grpc.Server) {
+	pb.RegisterPredictorServer(g, &predictorService{s: s})
+}
+
+type predictorService struct {
+	s *Server
+}
+
+// streamRequest is a request of a stream, resolved to its backend and
+// inputs.
+type streamRequest struct {
+	req     *pb.PredictRequest
+	backend backend.Backend
+	inputs  []backend.Tensor
+	batch   int
+	err     error
+	metrics *metrics.Model
+}
+
+// PredictStream receives requests while the previous ones run. The requests
+// queued for the same model when a batch starts are run with it, up to the
+// maximum batch size of the model, and the responses are sent in the order
+// of the requests.
+func (p *predictorService) PredictStream(stream pb.Predictor_PredictStreamServer) error {
+	ctx := stream.Context()
+	queue := make(chan *streamRequest, StreamQueueSize)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(queue)
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				recvErr <- err
+				return
+			}
+			select {
+			case queue <- p.resolve(req):
+			case <-ctx.Done():
+				recvErr <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	var next *streamRequest
+	for {
+		first := next
+		if first == nil {
+			var ok bool
+			if first, ok = <-queue; !ok {
+				return <-recvErr
+			}
+		}
+		batch := []*streamRequest{first}
+		next = nil
+		if first.err == nil {
+			batch, next = fillBatch(batch, queue)
+		}
+		for _, resp := range run(ctx, batch) {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (p *predictorService) resolve(req *pb.PredictRequest) *streamRequest {
+	r := &streamRequest{req: req}
+	r.backend, r.err = p.s.Lookup(req.ModelName, req.ModelVersion)
+	if r.err != nil {
+		return r
+	}
+	m := r.backend.Metadata()
+	r.metrics = p.s.Metrics.Model(m.Name, m.Version)
+	r.metrics.Request()
+	start := time.Now()
+	defer func() {
+		if r.err != nil {
+			r.metrics.Error(r.err)
+			return
+		}
+		r.metrics.Since(metrics.Preprocess, start)
+	}()
+	if len(req.Images) != 0 {
+		if len(req.Inputs) != 0 {
+			r.err = &backend.InputError{Message: "the request has both images and inputs"}
+			return r
+		}
+		r.inputs, r.err = imageInputs(m, req.Images)
+	} else {
+		r.inputs = fromPB(req.Inputs)
+	}
+	if r.err == nil {
+		r.inputs, r.batch, r.err = backend.CheckInputs(m, r.inputs)
+	}
+	return r
+}
+
+// fillBatch adds the queued requests for the model of the first request of
+// batch, until the maximum batch size of the model. The request that does
+// not fit is returned.
+func fillBatch(batch []*streamRequest, queue <-chan *streamRequest) ([]*streamRequest, *streamRequest) {
+	first := batch[0]
+	max := first.backend.Metadata().MaxBatchSize
+	size := first.batch
+	for max <= 0 || size < max {
+		select {
+		case r, ok := <-queue:
+			if !ok {
+				return batch, nil
+			}
+			if r.err != nil || r.backend != first.backend || (max > 0 && size+r.batch > max) {
+				return batch, r
+			}
+			batch = append(batch, r)
+			size += r.batch
+		default:
+			return batch, nil
+		}
+	}
+	return batch, nil
+}
+
+// run predicts the requests of batch together and splits the outputs into
+// their responses.
+func run(ctx context.Context, batch []*streamRequest) []*pb.PredictResponse {
+	responses := make([]*pb.PredictResponse, len(batch))
+	for ii, r := range batch {
+		responses[ii] = &pb.PredictResponse{Id: r.req.Id, ModelName: r.req.ModelName, ModelVersion: r.req.ModelVersion}
+		if r.backend != nil {
+			m := r.backend.Metadata()
+			responses[ii].ModelName, responses[ii].ModelVersion = m.Name, m.Version
+		}
+	}
+	if batch[0].err != nil {
+		responses[0].Error = batch[0].err.Error()
+		return responses
+	}
+
+	inputs := make([]backend.Tensor, len(batch[0].inputs))
+	for ii := range inputs {
+		parts := make([]backend.Tensor, len(batch))
+		for jj, r := range batch {
+			parts[jj] = r.inputs[ii]
+		}
+		inputs[ii] = backend.Concat(parts...)
+	}
+	outputs, timings, err := predict(ctx, batch[0].metrics, batch[0].backend, inputs)
+	if err != nil {
+		for ii, resp := range responses {
+			batch[ii].metrics.Error(err)
+			resp.Error = err.Error()
+		}
+		return responses
+	}
+
+	offset := 0
+	for ii, r := range batch {
+		start := time.Now()
+		for _, o := range outputs {
+			responses[ii].Outputs = append(responses[ii].Outputs, toPB(backend.Slice(o, offset, offset+r.batch)))
+		}
+		offset += r.batch
+		if !timings.IsZero() {
+			responses[ii].Timings = &pb.Timings{
+				ComputeNs:      int64(timings.Compute),
+				DeviceToHostNs: int64(timings.DeviceToHost),
+			}
+		}
+		r.metrics.Since(metrics.Postprocess, start)
+	}
+	return responses
+}
+
+func fromPB(tensors []*pb.Tensor) []backend.Tensor {
+	out := make([]backend.Tensor, len(tensors))
+	for ii, t := range tensors {
+		shape := make([]int, len(t.Shape))
+		for d, dim := range t.Shape {
+			shape[d] = int(dim)
+		}
+		out[ii] = backend.Tensor{Name: t.Name, Shape: shape, Data: t.Data}
+	}
+	return out
+}
+
+func toPB(t backend.Tensor) *pb.Tensor {
+	shape := make([]int64, len(t.Shape))
+	for d, dim := range t.Shape {
+		shape[d] = int64(dim)
+	}
+	return &pb.Tensor{Name: t.Name, Shape: shape, Data: t.Data}
+}
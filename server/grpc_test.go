@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image/color"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/server/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newGRPCClient(t *testing.T, s *Server) (pb.PredictorClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	g := grpc.NewServer()
+	s.RegisterGRPC(g)
+	go g.Serve(lis)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	assert.NoError(t, err)
+	return pb.NewPredictorClient(conn), func() {
+		conn.Close()
+		g.Stop()
+	}
+}
+
+// frame returns a request whose batch element is classified as class.
+func frame(id string, class int) *pb.PredictRequest {
+	data := make([]float32, 12)
+	data[0] = float32(class)
+	return &pb.PredictRequest{
+		Id:        id,
+		ModelName: "ResNet50_v1",
+		Inputs:    []*pb.Tensor{{Name: "data", Shape: []int64{1, 3, 2, 2}, Data: data}},
+	}
+}
+
+func TestPredictStream(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	ts.Close()
+	defer s.Close()
+	latest.Delay = 20 * time.Millisecond
+	latest.Timings = backend.Timings{Compute: 4 * time.Millisecond, DeviceToHost: 2 * time.Millisecond}
+	client, stop := newGRPCClient(t, s)
+	defer stop()
+
+	stream, err := client.PredictStream(context.Background())
+	assert.NoError(t, err)
+
+	const frames = 9
+	for ii := 0; ii < frames; ii++ {
+		assert.NoError(t, stream.Send(frame(fmt.Sprint(ii), ii%4)))
+	}
+	assert.NoError(t, stream.CloseSend())
+
+	for ii := 0; ii < frames; ii++ {
+		resp, err := stream.Recv()
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, resp.Error)
+		assert.Equal(t, fmt.Sprint(ii), resp.Id, "responses are in order")
+		assert.Equal(t, "2.0", resp.ModelVersion)
+		assert.Equal(t, &pb.Timings{ComputeNs: 4e6, DeviceToHostNs: 2e6}, resp.Timings)
+		if assert.Len(t, resp.Outputs, 1) {
+			out := resp.Outputs[0]
+			assert.Equal(t, []int64{1, 4}, out.Shape)
+			assert.InDelta(t, 0.9, out.Data[ii%4], 1e-6)
+		}
+	}
+	_, err = stream.Recv()
+	assert.Error(t, err)
+
+	// frames queued while a batch runs are batched together
+	batches := latest.Batches()
+	assert.True(t, len(batches) < frames, "%v", batches)
+	total := 0
+	for _, b := range batches {
+		assert.True(t, b <= 2, "%v", batches)
+		total += b
+	}
+	assert.Equal(t, frames, total)
+}
+
+func TestPredictStreamErrors(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	ts.Close()
+	defer s.Close()
+	client, stop := newGRPCClient(t, s)
+	defer stop()
+
+	stream, err := client.PredictStream(context.Background())
+	assert.NoError(t, err)
+
+	unknown := frame("unknown", 0)
+	unknown.ModelName = "AlexNet"
+	invalid := frame("invalid", 0)
+	invalid.Inputs[0].Shape = []int64{1, 3}
+	overflow := frame("overflow", 0)
+	overflow.Inputs[0].Shape = []int64{1 << 62, 3, 2, 2}
+	overflow.Inputs[0].Data = nil
+	png, err := base64.StdEncoding.DecodeString(encodePNG(t, color.White))
+	assert.NoError(t, err)
+	image := &pb.PredictRequest{Id: "image", ModelName: "ResNet50_v1", ModelVersion: "1.0", Images: [][]byte{png}}
+
+	for _, req := range []*pb.PredictRequest{frame("0", 1), unknown, invalid, overflow, image} {
+		assert.NoError(t, stream.Send(req))
+	}
+	assert.NoError(t, stream.CloseSend())
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "0", resp.Id)
+	assert.Empty(t, resp.Error)
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "unknown", resp.Id)
+	assert.Contains(t, resp.Error, "AlexNet")
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "invalid", resp.Id)
+	assert.Contains(t, resp.Error, "shape")
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "overflow", resp.Id)
+	assert.Contains(t, resp.Error, "values")
+
+	resp, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "image", resp.Id)
+	assert.Equal(t, "1.0", resp.ModelVersion)
+	if assert.Empty(t, resp.Error) && assert.Len(t, resp.Outputs, 1) {
+		assert.InDelta(t, 0.9, resp.Outputs[0].Data[0], 1e-6)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func scrape(t *testing.T, reg *prometheus.Registry) string {
+	ts := httptest.NewServer(promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+	defer ts.Close()
+	resp, err := http.Get(ts.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+	reg := prometheus.NewRegistry()
+	s.Metrics = metrics.New(reg)
+	latest.Timings = backend.Timings{Compute: 4 * time.Millisecond, DeviceToHost: 2 * time.Millisecond}
+
+	input := backend.Tensor{Name: "data", Shape: []int{2, 3, 2, 2}, Data: make([]float32, 24)}
+	var resp PredictResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, ts.URL+"/v1/models/ResNet50_v1/predict", PredictRequest{Inputs: []backend.Tensor{input}}, &resp))
+	if assert.NotNil(t, resp.Timings) {
+		assert.Equal(t, latest.Timings, *resp.Timings)
+	}
+	assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, ts.URL+"/v1/models/ResNet50_v1/predict", "{", nil))
+	v2 := V2InferRequest{Inputs: []V2Tensor{{Name: "data", Shape: []int{1, 3, 2, 2}, Datatype: backend.FP32, Data: make([]float32, 12)}}}
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, ts.URL+"/v2/models/ResNet50_v1/versions/1.0/infer", v2, nil))
+	// requests for models that are not served are not recorded
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodPost, ts.URL+"/v1/models/AlexNet/predict", "{}", nil))
+
+	body := scrape(t, reg)
+	for _, line := range []string{
+		`tensorrt_requests_total{model="ResNet50_v1",version="2.0"} 2`,
+		`tensorrt_requests_total{model="ResNet50_v1",version="1.0"} 1`,
+		`tensorrt_errors_total{model="ResNet50_v1",type="input",version="2.0"} 1`,
+		`tensorrt_latency_seconds_count{model="ResNet50_v1",stage="preprocess",version="2.0"} 1`,
+		`tensorrt_latency_seconds_count{model="ResNet50_v1",stage="inference",version="2.0"} 1`,
+		`tensorrt_latency_seconds_count{model="ResNet50_v1",stage="postprocess",version="2.0"} 1`,
+		`tensorrt_latency_seconds_count{model="ResNet50_v1",stage="postprocess",version="1.0"} 1`,
+		`tensorrt_batch_size_bucket{model="ResNet50_v1",version="2.0",le="1"} 0`,
+		`tensorrt_batch_size_bucket{model="ResNet50_v1",version="2.0",le="2"} 1`,
+		`tensorrt_batch_size_bucket{model="ResNet50_v1",version="1.0",le="1"} 1`,
+		`tensorrt_queue_depth{model="ResNet50_v1",version="2.0"} 0`,
+		`tensorrt_inference_stage_seconds_sum{model="ResNet50_v1",stage="compute",version="2.0"} 0.004`,
+		`tensorrt_inference_stage_seconds_sum{model="ResNet50_v1",stage="device_to_host",version="2.0"} 0.002`,
+	} {
+		assert.Contains(t, body, line)
+	}
+	assert.NotContains(t, body, "AlexNet")
+	// version 1.0 does not time its predictions
+	assert.NotContains(t, body, `tensorrt_inference_stage_seconds_count{model="ResNet50_v1",stage="compute",version="1.0"}`)
+}
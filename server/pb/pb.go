@@ -0,0 +1,5 @@
+// Package pb holds the protocol buffers of the gRPC streaming prediction
+// service.
+package pb
+
+//go:generate protoc --go_out=plugins=grpc:. predict.proto
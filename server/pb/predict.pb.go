@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: predict.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Tensor is a batch of float32 values, the first dimension of shape is the
+// batch size.
+type Tensor struct {
+	Name                 string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Shape                []int64   `protobuf:"varint,2,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	Data                 []float32 `protobuf:"fixed32,3,rep,packed,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Tensor) Reset()         { *m = Tensor{} }
+func (m *Tensor) String() string { return proto.CompactTextString(m) }
+func (*Tensor) ProtoMessage()    {}
+func (*Tensor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7497d3e8004dd709, []int{0}
+}
+
+func (m *Tensor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Tensor.Unmarshal(m, b)
+}
+func (m *Tensor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Tensor.Marshal(b, m, deterministic)
+}
+func (m *Tensor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tensor.Merge(m, src)
+}
+func (m *Tensor) XXX_Size() int {
+	return xxx_messageInfo_Tensor.Size(m)
+}
+func (m *Tensor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tensor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tensor proto.InternalMessageInfo
+
+func (m *Tensor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Tensor) GetShape() []int64 {
+	if m != nil {
+		return m.Shape
+	}
+	return nil
+}
+
+func (m *Tensor) GetData() []float32 {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Timings break the inference of a batch down into the execution of the
+// engine, including the copy of its inputs, and the copy of the outputs back
+// to the host, in nanoseconds.
+type Timings struct {
+	ComputeNs            int64    `protobuf:"varint,2,opt,name=compute_ns,json=computeNs,proto3" json:"compute_ns,omitempty"`
+	DeviceToHostNs       int64    `protobuf:"varint,3,opt,name=device_to_host_ns,json=deviceToHostNs,proto3" json:"device_to_host_ns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Timings) Reset()         { *m = Timings{} }
+func (m *Timings) String() string { return proto.CompactTextString(m) }
+func (*Timings) ProtoMessage()    {}
+func (*Timings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7497d3e8004dd709, []int{1}
+}
+
+func (m *Timings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Timings.Unmarshal(m, b)
+}
+func (m *Timings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Timings.Marshal(b, m, deterministic)
+}
+func (m *Timings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timings.Merge(m, src)
+}
+func (m *Timings) XXX_Size() int {
+	return xxx_messageInfo_Timings.Size(m)
+}
+func (m *Timings) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timings proto.InternalMessageInfo
+
+func (m *Timings) GetComputeNs() int64 {
+	if m != nil {
+		return m.ComputeNs
+	}
+	return 0
+}
+
+func (m *Timings) GetDeviceToHostNs() int64 {
+	if m != nil {
+		return m.DeviceToHostNs
+	}
+	return 0
+}
+
+type PredictRequest struct {
+	// id is returned with the response of the request
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelName string `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	// model_version is the latest served version when empty
+	ModelVersion string `protobuf:"bytes,3,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	// inputs are the input tensors, or images the encoded jpeg, png or gif
+	// images of a batch
+	Inputs               []*Tensor `protobuf:"bytes,4,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Images               [][]byte  `protobuf:"bytes,5,rep,name=images,proto3" json:"images,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PredictRequest) Reset()         { *m = PredictRequest{} }
+func (m *PredictRequest) String() string { return proto.CompactTextString(m) }
+func (*PredictRequest) ProtoMessage()    {}
+func (*PredictRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7497d3e8004dd709, []int{2}
+}
+
+func (m *PredictRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictRequest.Unmarshal(m, b)
+}
+func (m *PredictRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictRequest.Marshal(b, m, deterministic)
+}
+func (m *PredictRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictRequest.Merge(m, src)
+}
+func (m *PredictRequest) XXX_Size() int {
+	return xxx_messageInfo_PredictRequest.Size(m)
+}
+func (m *PredictRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictRequest proto.InternalMessageInfo
+
+func (m *PredictRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PredictRequest) GetModelName() string {
+	if m != nil {
+		return m.ModelName
+	}
+	return ""
+}
+
+func (m *PredictRequest) GetModelVersion() string {
+	if m != nil {
+		return m.ModelVersion
+	}
+	return ""
+}
+
+func (m *PredictRequest) GetInputs() []*Tensor {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *PredictRequest) GetImages() [][]byte {
+	if m != nil {
+		return m.Images
+	}
+	return nil
+}
+
+type PredictResponse struct {
+	Id           string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModelName    string    `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	ModelVersion string    `protobuf:"bytes,3,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	Outputs      []*Tensor `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// error is set when the request failed, the stream goes on
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// timings are those of the batch the request ran in, unset when the
+	// backend does not time the stages of its inferences
+	Timings              *Timings `protobuf:"bytes,6,opt,name=timings,proto3" json:"timings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PredictResponse) Reset()         { *m = PredictResponse{} }
+func (m *PredictResponse) String() string { return proto.CompactTextString(m) }
+func (*PredictResponse) ProtoMessage()    {}
+func (*PredictResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7497d3e8004dd709, []int{3}
+}
+
+func (m *PredictResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PredictResponse.Unmarshal(m, b)
+}
+func (m *PredictResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PredictResponse.Marshal(b, m, deterministic)
+}
+func (m *PredictResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredictResponse.Merge(m, src)
+}
+func (m *PredictResponse) XXX_Size() int {
+	return xxx_messageInfo_PredictResponse.Size(m)
+}
+func (m *PredictResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredictResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredictResponse proto.InternalMessageInfo
+
+func (m *PredictResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PredictResponse) GetModelName() string {
+	if m != nil {
+		return m.ModelName
+	}
+	return ""
+}
+
+func (m *PredictResponse) GetModelVersion() string {
+	if m != nil {
+		return m.ModelVersion
+	}
+	return ""
+}
+
+func (m *PredictResponse) GetOutputs() []*Tensor {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *PredictResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PredictResponse) GetTimings() *Timings {
+	if m != nil {
+		return m.Timings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Tensor)(nil), "tensorrt.Tensor")
+	proto.RegisterType((*Timings)(nil), "tensorrt.Timings")
+	proto.RegisterType((*PredictRequest)(nil), "tensorrt.PredictRequest")
+	proto.RegisterType((*PredictResponse)(nil), "tensorrt.PredictResponse")
+}
+
+func init() { proto.RegisterFile("predict.proto", fileDescriptor_7497d3e8004dd709) }
+
+var fileDescriptor_7497d3e8004dd709 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x4d, 0xab, 0x13, 0x31,
+	0x14, 0x25, 0xc9, 0x74, 0xda, 0xb9, 0xfd, 0xb0, 0x0d, 0x22, 0x51, 0x10, 0x86, 0x71, 0x13, 0x15,
+	0x8a, 0xd4, 0x7f, 0xe0, 0x42, 0x8a, 0x8b, 0x22, 0xb1, 0xba, 0x70, 0x33, 0x4c, 0x3b, 0xa1, 0x0d,
+	0x74, 0x92, 0x31, 0xc9, 0xf4, 0x27, 0xf9, 0x8b, 0xfc, 0x41, 0x32, 0xc9, 0x54, 0xdf, 0x83, 0xc7,
+	0xdb, 0xbd, 0xdd, 0xbd, 0xe7, 0x9c, 0x5c, 0xce, 0xb9, 0x37, 0x30, 0x6f, 0xad, 0xac, 0xd5, 0xd1,
+	0xaf, 0x5b, 0x6b, 0xbc, 0xa1, 0x13, 0x2f, 0xb5, 0x33, 0xd6, 0xfa, 0xe2, 0x33, 0xa4, 0xfb, 0x50,
+	0x53, 0x0a, 0x89, 0xae, 0x1a, 0xc9, 0x50, 0x8e, 0x78, 0x26, 0x42, 0x4d, 0x9f, 0xc3, 0xc8, 0x9d,
+	0xab, 0x56, 0x32, 0x9c, 0x13, 0x4e, 0x44, 0x6c, 0x7a, 0x65, 0x5d, 0xf9, 0x8a, 0x91, 0x9c, 0x70,
+	0x2c, 0x42, 0x5d, 0x5c, 0x60, 0xbc, 0x57, 0x8d, 0xd2, 0x27, 0x47, 0x5f, 0x03, 0x1c, 0x4d, 0xd3,
+	0x76, 0x5e, 0x96, 0xda, 0x31, 0x9c, 0x23, 0x4e, 0x44, 0x36, 0x20, 0x3b, 0x47, 0xdf, 0xc2, 0xaa,
+	0x96, 0x57, 0x75, 0x94, 0xa5, 0x37, 0xe5, 0xd9, 0x38, 0xdf, 0xab, 0x48, 0x50, 0x2d, 0x22, 0xb1,
+	0x37, 0x5b, 0xe3, 0xfc, 0xce, 0x7d, 0x49, 0x26, 0x68, 0x89, 0xc5, 0x2a, 0x88, 0xbc, 0x29, 0x87,
+	0x67, 0xda, 0x15, 0xbf, 0x11, 0x2c, 0xbe, 0xc6, 0x44, 0x42, 0xfe, 0xea, 0xa4, 0xf3, 0x74, 0x01,
+	0x58, 0xd5, 0x83, 0x79, 0xac, 0xea, 0xde, 0x45, 0x63, 0x6a, 0x79, 0x29, 0x43, 0x28, 0x1c, 0xf0,
+	0x2c, 0x20, 0xbb, 0x3e, 0xd9, 0x1b, 0x98, 0x47, 0xfa, 0x2a, 0xad, 0x53, 0x46, 0x07, 0x07, 0x99,
+	0x98, 0x05, 0xf0, 0x47, 0xc4, 0x28, 0x87, 0x54, 0xe9, 0xb6, 0xf3, 0x8e, 0x25, 0x39, 0xe1, 0xd3,
+	0xcd, 0x72, 0x7d, 0xdb, 0xdb, 0x3a, 0x2e, 0x4d, 0x0c, 0x3c, 0x7d, 0x01, 0xa9, 0x6a, 0xaa, 0x93,
+	0x74, 0x6c, 0x94, 0x13, 0x3e, 0x13, 0x43, 0x57, 0xfc, 0x41, 0xf0, 0xec, 0x9f, 0x51, 0xd7, 0x1a,
+	0xed, 0xe4, 0x93, 0x38, 0x7d, 0x07, 0x63, 0xd3, 0xf9, 0x47, 0xad, 0xde, 0x04, 0xfd, 0x51, 0xa5,
+	0xb5, 0xc6, 0xb2, 0x51, 0x18, 0x14, 0x1b, 0xfa, 0x1e, 0xc6, 0x3e, 0x1e, 0x90, 0xa5, 0x39, 0xe2,
+	0xd3, 0xcd, 0xea, 0xce, 0x84, 0x48, 0x88, 0x9b, 0x62, 0xf3, 0x1d, 0xb2, 0x21, 0x95, 0xb1, 0x74,
+	0x0b, 0xf3, 0xa1, 0xf9, 0xe6, 0xad, 0xac, 0x1a, 0xca, 0xfe, 0xbf, 0xbc, 0x7f, 0xa4, 0x57, 0x2f,
+	0x1f, 0x60, 0xe2, 0x56, 0x38, 0xfa, 0x80, 0x3e, 0x25, 0x3f, 0x71, 0x7b, 0x38, 0xa4, 0xe1, 0x8f,
+	0x7e, 0xfc, 0x3b, 0x00, 0x2c, 0x84, 0xc6, 0xff, 0xb4, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PredictorClient is the client API for Predictor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PredictorClient interface {
+	// PredictStream runs the requests of the stream and returns their responses
+	// in order. Requests queued while a batch runs are batched together.
+	PredictStream(ctx context.Context, opts ...grpc.CallOption) (Predictor_PredictStreamClient, error)
+}
+
+type predictorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPredictorClient(cc *grpc.ClientConn) PredictorClient {
+	return &predictorClient{cc}
+}
+
+func (c *predictorClient) PredictStream(ctx context.Context, opts ...grpc.CallOption) (Predictor_PredictStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Predictor_serviceDesc.Streams[0], "/tensorrt.Predictor/PredictStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &predictorPredictStreamClient{stream}
+	return x, nil
+}
+
+type Predictor_PredictStreamClient interface {
+	Send(*PredictRequest) error
+	Recv() (*PredictResponse, error)
+	grpc.ClientStream
+}
+
+type predictorPredictStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *predictorPredictStreamClient) Send(m *PredictRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *predictorPredictStreamClient) Recv() (*PredictResponse, error) {
+	m := new(PredictResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PredictorServer is the server API for Predictor service.
+type PredictorServer interface {
+	// PredictStream runs the requests of the stream and returns their responses
+	// in order. Requests queued while a batch runs are batched together.
+	PredictStream(Predictor_PredictStreamServer) error
+}
+
+// UnimplementedPredictorServer can be embedded to have forward compatible implementations.
+type UnimplementedPredictorServer struct {
+}
+
+func (*UnimplementedPredictorServer) PredictStream(srv Predictor_PredictStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PredictStream not implemented")
+}
+
+func RegisterPredictorServer(s *grpc.Server, srv PredictorServer) {
+	s.RegisterService(&_Predictor_serviceDesc, srv)
+}
+
+func _Predictor_PredictStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PredictorServer).PredictStream(&predictorPredictStreamServer{stream})
+}
+
+type Predictor_PredictStreamServer interface {
+	Send(*PredictResponse) error
+	Recv() (*PredictRequest, error)
+	grpc.ServerStream
+}
+
+type predictorPredictStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *predictorPredictStreamServer) Send(m *PredictResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *predictorPredictStreamServer) Recv() (*PredictRequest, error) {
+	m := new(PredictRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Predictor_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tensorrt.Predictor",
+	HandlerType: (*PredictorServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PredictStream",
+			Handler:       _Predictor_PredictStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "predict.proto",
+}
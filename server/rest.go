@@ -0,0 +1,248 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/metrics"
+)
+
+// DefaultTopK is the number of classes returned per image when the request
+// does not say.
+const DefaultTopK = 5
+
+// PredictRequest is the body of the REST predict endpoint. Either Images, as
+// base64 encoded jpeg, png or gif files, or the raw input tensors are given.
+type PredictRequest struct {
+	Images []string         `json:"images,omitempty"`
+	Inputs []backend.Tensor `json:"inputs,omitempty"`
+	// TopK is the number of classes returned per batch element by
+	// classification models, DefaultTopK when 0
+	TopK int `json:"top_k,omitempty"`
+}
+
+// PredictResponse is returned by the REST predict endpoint.
+type PredictResponse struct {
+	ModelName    string           `json:"model_name"`
+	ModelVersion string           `json:"model_version"`
+	Outputs      []backend.Tensor `json:"outputs"`
+	// Predictions are the most probable classes of each batch element, for
+	// models with labels
+	Predictions [][]Prediction `json:"predictions,omitempty"`
+	// Timings break the inference down into its stages, when the backend
+	// times them
+	Timings *backend.Timings `json:"timings,omitempty"`
+}
+
+// Prediction is a class of a batch element.
+type Prediction struct {
+	Index       int     `json:"index"`
+	Label       string  `json:"label,omitempty"`
+	Probability float32 `json:"probability"`
+}
+
+// routeREST adds the REST endpoints:
+//
+//	GET  /v1/status
+//	GET  /v1/models
+//	GET  /v1/models/{name}[/versions/{version}]
+//	GET  /v1/models/{name}[/versions/{version}]/status
+//	POST /v1/models/{name}[/versions/{version}]/predict
+func (s *Server) routeREST() {
+	s.mux.HandleFunc("/v1/status", s.serveStatus)
+	s.mux.HandleFunc("/v1/models", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"models": s.Models()})
+	})
+	s.mux.HandleFunc("/v1/models/", s.serveModel)
+}
+
+func (s *Server) serveModel(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/models/"), "/"), "/")
+	name, version, action := parts[0], "", ""
+	rest := parts[1:]
+	if len(rest) >= 2 && rest[0] == "versions" {
+		version, rest = rest[1], rest[2:]
+	}
+	if len(rest) == 1 {
+		action, rest = rest[0], nil
+	}
+	if name == "" || len(rest) != 0 || (action != "" && action != "predict" && action != "status") {
+		http.NotFound(w, r)
+		return
+	}
+	if action == "status" {
+		s.serveModelStatus(w, r, name, version)
+		return
+	}
+
+	b, err := s.Lookup(name, version)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if action == "" {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		writeJSON(w, http.StatusOK, b.Metadata())
+		return
+	}
+	if r.Method != http.MethodPost {
+		methodNotAllowed(w, http.MethodPost)
+		return
+	}
+	s.predict(w, r, b)
+}
+
+func (s *Server) predict(w http.ResponseWriter, r *http.Request, b backend.Backend) {
+	m := b.Metadata()
+	mm := s.Metrics.Model(m.Name, m.Version)
+	mm.Request()
+	fail := func(err error) {
+		mm.Error(err)
+		writeError(w, err)
+	}
+
+	start := time.Now()
+	var req PredictRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxRequestSize)).Decode(&req); err != nil {
+		fail(&backend.InputError{Message: "invalid request: " + err.Error()})
+		return
+	}
+	inputs, err := requestInputs(m, req)
+	if err != nil {
+		fail(err)
+		return
+	}
+	mm.Since(metrics.Preprocess, start)
+
+	outputs, timings, err := predict(r.Context(), mm, b, inputs)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	start = time.Now()
+	topK := req.TopK
+	if topK <= 0 {
+		topK = DefaultTopK
+	}
+	resp := PredictResponse{
+		ModelName:    m.Name,
+		ModelVersion: m.Version,
+		Outputs:      outputs,
+		Predictions:  classify(m, outputs, topK),
+	}
+	if !timings.IsZero() {
+		resp.Timings = &timings
+	}
+	writeJSON(w, http.StatusOK, resp)
+	mm.Since(metrics.Postprocess, start)
+}
+
+// requestInputs returns the input tensors of the request, decoding and
+// preprocessing the images it holds.
+func requestInputs(m backend.Metadata, req PredictRequest) ([]backend.Tensor, error) {
+	switch {
+	case len(req.Images) != 0 && len(req.Inputs) != 0:
+		return nil, &backend.InputError{Message: "the request has both images and inputs"}
+	case len(req.Inputs) != 0:
+		return req.Inputs, nil
+	case len(req.Images) == 0:
+		return nil, &backend.InputError{Message: "the request has no images or inputs"}
+	}
+	images := make([][]byte, len(req.Images))
+	for ii, encoded := range req.Images {
+		data, err := decodeBase64(encoded)
+		if err != nil {
+			return nil, &backend.InputError{Message: errors.Wrapf(err, "image %d", ii).Error()}
+		}
+		images[ii] = data
+	}
+	return imageInputs(m, images)
+}
+
+// imageInputs decodes the images and turns them into the input tensor of
+// the model.
+func imageInputs(m backend.Metadata, encoded [][]byte) ([]backend.Tensor, error) {
+	if m.Image == nil || len(m.Inputs) != 1 {
+		return nil, &backend.InputError{Message: "model " + m.Name + " does not take images"}
+	}
+	images := make([]image.Image, len(encoded))
+	for ii, data := range encoded {
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, &backend.InputError{Message: errors.Wrapf(err, "cannot decode image %d", ii).Error()}
+		}
+		images[ii] = img
+	}
+	return []backend.Tensor{m.Image.Tensor(m.Inputs[0].Name, images)}, nil
+}
+
+// decodeBase64 decodes a base64 encoded file, optionally as a data URL.
+func decodeBase64(encoded string) ([]byte, error) {
+	if strings.HasPrefix(encoded, "data:") {
+		idx := strings.Index(encoded, ",")
+		if idx < 0 {
+			return nil, errors.New("invalid data url")
+		}
+		encoded = encoded[idx+1:]
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		if data, err = base64.RawStdEncoding.DecodeString(encoded); err != nil {
+			return nil, errors.Wrap(err, "invalid base64")
+		}
+	}
+	return data, nil
+}
+
+// classify returns the topK classes of each batch element for models whose
+// first output holds one probability per label.
+func classify(m backend.Metadata, outputs []backend.Tensor, topK int) [][]Prediction {
+	if len(m.Labels) == 0 || len(outputs) == 0 {
+		return nil
+	}
+	out := outputs[0]
+	if len(out.Shape) != 2 || out.Shape[1] != len(m.Labels) {
+		return nil
+	}
+	classes := out.Shape[1]
+	if topK > classes {
+		topK = classes
+	}
+	predictions := make([][]Prediction, out.Shape[0])
+	for e := range predictions {
+		probs := out.Data[e*classes : (e+1)*classes]
+		ranked := make([]Prediction, classes)
+		for c, p := range probs {
+			ranked[c] = Prediction{Index: c, Label: m.Labels[c], Probability: p}
+		}
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return ranked[i].Probability > ranked[j].Probability
+		})
+		predictions[e] = ranked[:topK]
+	}
+	return predictions
+}
+
+func methodNotAllowed(w http.ResponseWriter, allowed string) {
+	w.Header().Set("Allow", allowed)
+	writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"error": "method not allowed"})
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/backend/fake"
+	"github.com/rai-project/tensorrt/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+var labels = []string{"tench", "goldfish", "great white shark", "tiger shark"}
+
+func imageMetadata(version string) backend.Metadata {
+	return backend.Metadata{
+		Name:         "ResNet50_v1",
+		Version:      version,
+		Inputs:       []backend.TensorInfo{{Name: "data", Datatype: backend.FP32, Shape: []int{-1, 3, 2, 2}}},
+		Outputs:      []backend.TensorInfo{{Name: "prob", Datatype: backend.FP32, Shape: []int{-1, -1}}},
+		MaxBatchSize: 2,
+		Labels:       labels,
+		Image: &backend.ImageInput{
+			Channels: 3, Height: 2, Width: 2,
+			Mean: []float32{0, 0, 0}, Scale: []float32{255, 255, 255},
+			ColorMode: backend.RGB, Layout: backend.CHW,
+		},
+	}
+}
+
+func newTestServer(t *testing.T) (*Server, *httptest.Server, *fake.Backend) {
+	s := New()
+	latest := fake.New(imageMetadata("2.0"))
+	assert.NoError(t, s.Add(fake.New(imageMetadata("1.0"))))
+	assert.NoError(t, s.Add(latest))
+	return s, httptest.NewServer(s.Handler()), latest
+}
+
+func do(t *testing.T, method, url string, body interface{}, out interface{}) int {
+	var r *bytes.Reader
+	if s, ok := body.(string); ok {
+		r = bytes.NewReader([]byte(s))
+	} else {
+		data, err := json.Marshal(body)
+		assert.NoError(t, err)
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequest(method, url, r)
+	assert.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	if out != nil {
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+	}
+	return resp.StatusCode
+}
+
+func encodePNG(t *testing.T, c color.Color) string {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func TestRESTModels(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	var list struct{ Models []backend.Metadata }
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models", nil, &list))
+	if assert.Len(t, list.Models, 2) {
+		assert.Equal(t, "1.0", list.Models[0].Version)
+		assert.Equal(t, "2.0", list.Models[1].Version)
+	}
+
+	var md backend.Metadata
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models/resnet50_v1", nil, &md))
+	assert.Equal(t, "2.0", md.Version, "the latest version is served without a version")
+	assert.Equal(t, []int{-1, 4}, md.Outputs[0].Shape)
+	assert.Equal(t, 2, md.MaxBatchSize)
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models/ResNet50_v1/versions/1.0", nil, &md))
+	assert.Equal(t, "1.0", md.Version)
+
+	var e struct{ Error string }
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v1/models/AlexNet", nil, &e))
+	assert.Contains(t, e.Error, "AlexNet")
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v1/models/ResNet50_v1/versions/3.0", nil, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodPost, ts.URL+"/v1/models", nil, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodGet, ts.URL+"/v1/models/ResNet50_v1/predict", nil, nil))
+}
+
+func TestRESTModelMemory(t *testing.T) {
+	s := New()
+	defer s.Close()
+	m := imageMetadata("1.0")
+	m.EstimatedMemory = &memory.Usage{Engine: 100 << 20, Workspace: 1 << 30, Bindings: []memory.Binding{{Name: "data", Bytes: 96, IsInput: true}, {Name: "prob", Bytes: 32}}}
+	assert.NoError(t, s.Add(fake.New(m)))
+	ts := httptest.NewServer(s.Handler())
+	defer ts.Close()
+
+	var md backend.Metadata
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models/ResNet50_v1", nil, &md))
+	if assert.NotNil(t, md.EstimatedMemory) {
+		assert.Equal(t, *m.EstimatedMemory, *md.EstimatedMemory)
+		assert.Equal(t, int64(100<<20+1<<30+128), md.EstimatedMemory.Total())
+	}
+}
+
+func TestRESTPredictImages(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	// white images sum to 12 once scaled, black ones to 0
+	req := PredictRequest{
+		Images: []string{encodePNG(t, color.White), encodePNG(t, color.Black), "data:image/png;base64," + encodePNG(t, color.White)},
+		TopK:   2,
+	}
+	var resp PredictResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, ts.URL+"/v1/models/ResNet50_v1/predict", req, &resp))
+	assert.Equal(t, "2.0", resp.ModelVersion)
+	assert.Equal(t, []int{2, 1}, latest.Batches(), "batches are split at the maximum batch size")
+	if assert.Len(t, resp.Outputs, 1) {
+		assert.Equal(t, "prob", resp.Outputs[0].Name)
+		assert.Equal(t, []int{3, 4}, resp.Outputs[0].Shape)
+	}
+	if assert.Len(t, resp.Predictions, 3) {
+		for ii, class := range []int{12 % 4, 0, 12 % 4} {
+			if assert.Len(t, resp.Predictions[ii], 2) {
+				assert.Equal(t, class, resp.Predictions[ii][0].Index)
+				assert.Equal(t, labels[class], resp.Predictions[ii][0].Label)
+				assert.InDelta(t, 0.9, resp.Predictions[ii][0].Probability, 1e-6)
+			}
+		}
+	}
+}
+
+func TestRESTPredictTensors(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	data := make([]float32, 12)
+	data[0] = 3
+	req := PredictRequest{Inputs: []backend.Tensor{{Name: "data", Shape: []int{1, 3, 2, 2}, Data: data}}}
+	var resp PredictResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, ts.URL+"/v1/models/ResNet50_v1/versions/1.0/predict", req, &resp))
+	assert.Equal(t, "1.0", resp.ModelVersion)
+	assert.Nil(t, resp.Timings, "the backend does not time its predictions")
+	if assert.Len(t, resp.Predictions, 1) {
+		assert.Len(t, resp.Predictions[0], DefaultTopK-1, "top_k is bounded by the number of classes")
+		assert.Equal(t, "tiger shark", resp.Predictions[0][0].Label)
+	}
+}
+
+func TestRESTPredictErrors(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+	url := ts.URL + "/v1/models/ResNet50_v1/predict"
+
+	for _, body := range []interface{}{
+		"{not json",
+		PredictRequest{},
+		PredictRequest{Images: []string{"not base64!"}},
+		PredictRequest{Images: []string{base64.StdEncoding.EncodeToString([]byte("not an image"))}},
+		PredictRequest{Inputs: []backend.Tensor{{Name: "data", Shape: []int{1, 3}, Data: []float32{1, 2, 3}}}},
+	} {
+		var e struct{ Error string }
+		assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, body, &e), "%v", body)
+		assert.NotEmpty(t, e.Error)
+	}
+
+	latest.Err = backend.ErrUnavailable
+	assert.Equal(t, http.StatusServiceUnavailable, do(t, http.MethodPost, url, PredictRequest{Images: []string{encodePNG(t, color.White)}}, nil))
+}
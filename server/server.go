@@ -0,0 +1,220 @@
+// Package server serves the predictions of loaded models over HTTP, without
+// the MLModelScope registry, broker or tracing services.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/metrics"
+)
+
+// MaxRequestSize bounds the size of prediction requests, in bytes. The tensors
+// of the requests are bounded by backend.MaxSize, its float32 values.
+var MaxRequestSize int64 = 64 << 20
+
+// DefaultName ...
+const DefaultName = "tensorrt-agent"
+
+// NotFoundError is returned for models that are not served.
+type NotFoundError struct {
+	Name    string
+	Version string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Version == "" {
+		return fmt.Sprintf("model %s is not served", e.Name)
+	}
+	return fmt.Sprintf("model %s:%s is not served", e.Name, e.Version)
+}
+
+// Server holds the models it serves.
+type Server struct {
+	// Name and Version are advertised by the KServe v2 server metadata
+	Name    string
+	Version string
+	// Lifecycle tracks the models, including the ones still loading. Models
+	// are marked ready when they are added.
+	Lifecycle *lifecycle.Registry
+	// Metrics records the requests of the served models, metrics.Default
+	// by default
+	Metrics *metrics.Metrics
+
+	mu     sync.RWMutex
+	models map[string]backend.Backend
+	mux    *http.ServeMux
+}
+
+// New returns a server without models.
+func New() *Server {
+	s := &Server{
+		Name:      DefaultName,
+		Lifecycle: lifecycle.NewRegistry(),
+		Metrics:   metrics.Default,
+		models:    map[string]backend.Backend{},
+		mux:       http.NewServeMux(),
+	}
+	s.routeREST()
+	s.routeV2()
+	return s
+}
+
+// Add serves the model of b and marks it ready.
+func (s *Server) Add(b backend.Backend) error {
+	m := b.Metadata()
+	key := m.Key()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.models[key]; ok {
+		return errors.Errorf("model %s is already served", key)
+	}
+	s.models[key] = b
+	if st, ok := s.Lifecycle.Lookup(m.Name, m.Version); !ok || st.State() != lifecycle.Ready {
+		s.Lifecycle.Track(m.Name, m.Version).Set(lifecycle.Ready)
+	}
+	return nil
+}
+
+// Models returns the metadata of the served models, ordered by name and
+// version.
+func (s *Server) Models() []backend.Metadata {
+	s.mu.RLock()
+	models := make([]backend.Metadata, 0, len(s.models))
+	for _, b := range s.models {
+		models = append(models, b.Metadata())
+	}
+	s.mu.RUnlock()
+	sort.Slice(models, func(i, j int) bool {
+		if !strings.EqualFold(models[i].Name, models[j].Name) {
+			return strings.ToLower(models[i].Name) < strings.ToLower(models[j].Name)
+		}
+		return versionLess(models[i].Version, models[j].Version)
+	})
+	return models
+}
+
+// Lookup returns the backend of a model. Without a version, the latest
+// version of the model is returned. Models that are tracked but not served,
+// because they are still loading or failed to, are unavailable.
+func (s *Server) Lookup(name, version string) (backend.Backend, error) {
+	b, err := s.lookup(name, version)
+	if _, ok := err.(*NotFoundError); ok {
+		if status, serr := s.Status(name, version); serr == nil {
+			return nil, errors.Wrapf(backend.ErrUnavailable, "model %s:%s is %s", status.Name, status.Version, status.State)
+		}
+	}
+	return b, err
+}
+
+func (s *Server) lookup(name, version string) (backend.Backend, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if version != "" {
+		if b, ok := s.models[backend.Key(name, version)]; ok {
+			return b, nil
+		}
+		return nil, &NotFoundError{Name: name, Version: version}
+	}
+	var latest backend.Backend
+	for _, b := range s.models {
+		m := b.Metadata()
+		if !strings.EqualFold(m.Name, name) {
+			continue
+		}
+		if latest == nil || versionLess(latest.Metadata().Version, m.Version) {
+			latest = b
+		}
+	}
+	if latest == nil {
+		return nil, &NotFoundError{Name: name}
+	}
+	return latest, nil
+}
+
+func versionLess(a, b string) bool {
+	va, erra := semver.NewVersion(a)
+	vb, errb := semver.NewVersion(b)
+	if erra != nil || errb != nil {
+		return a < b
+	}
+	return va.LessThan(vb)
+}
+
+// Handler returns the HTTP handler of the server.
+func (s *Server) Handler() http.Handler {
+	return s.mux
+}
+
+// Close closes the backends of every model.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []string
+	for key, b := range s.models {
+		if err := b.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", key, err))
+		}
+		m := b.Metadata()
+		if st, ok := s.Lifecycle.Lookup(m.Name, m.Version); ok {
+			st.Set(lifecycle.Closed)
+		}
+		delete(s.models, key)
+	}
+	if len(errs) != 0 {
+		return errors.Errorf("failed to close models: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// predict runs inputs on b, recording the inference latency, the timings of
+// its stages, batch size and queue depth of the model in mm.
+func predict(ctx context.Context, mm *metrics.Model, b backend.Backend, inputs []backend.Tensor) ([]backend.Tensor, backend.Timings, error) {
+	if len(inputs) != 0 && len(inputs[0].Shape) != 0 {
+		mm.Batch(inputs[0].Shape[0])
+	}
+	done := mm.Enqueue()
+	defer done()
+	start := time.Now()
+	outputs, timings, err := backend.PredictWithTimings(ctx, b, inputs)
+	mm.Observe(metrics.Inference, time.Since(start))
+	if err == nil {
+		mm.ObserveTimings(timings)
+	}
+	return outputs, timings, err
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// errorStatus maps errors to HTTP status codes.
+func errorStatus(err error) int {
+	switch cause := errors.Cause(err); {
+	case backend.IsInputError(err):
+		return http.StatusBadRequest
+	case cause == backend.ErrUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		if _, ok := cause.(*NotFoundError); ok {
+			return http.StatusNotFound
+		}
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, errorStatus(err), map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/rai-project/tensorrt/lifecycle"
+)
+
+// StatusResponse is returned by the REST status endpoint.
+type StatusResponse = lifecycle.Report
+
+// Ready returns whether the server has a ready model and every model that
+// did not fail is ready.
+func (s *Server) Ready() bool {
+	return s.Lifecycle.Ready()
+}
+
+// Status returns the lifecycle status of a model. Without a version, the
+// latest version of the model that is not closed is returned.
+func (s *Server) Status(name, version string) (lifecycle.Status, error) {
+	if version != "" {
+		if m, ok := s.Lifecycle.Lookup(name, version); ok {
+			return m.Status(), nil
+		}
+		return lifecycle.Status{}, &NotFoundError{Name: name, Version: version}
+	}
+	var latest *lifecycle.Status
+	for _, status := range s.Lifecycle.List() {
+		if !strings.EqualFold(status.Name, name) || status.State == lifecycle.Closed {
+			continue
+		}
+		if latest == nil || versionLess(latest.Version, status.Version) {
+			status := status
+			latest = &status
+		}
+	}
+	if latest == nil {
+		return lifecycle.Status{}, &NotFoundError{Name: name}
+	}
+	return *latest, nil
+}
+
+// serveStatus serves GET /v1/status.
+func (s *Server) serveStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.Lifecycle.Report())
+}
+
+// serveModelStatus serves GET /v1/models/{name}[/versions/{version}]/status.
+func (s *Server) serveModelStatus(w http.ResponseWriter, r *http.Request, name, version string) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w, http.MethodGet)
+		return
+	}
+	status, err := s.Status(name, version)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+// serveReady answers readiness probes: 200 when ready, 503 otherwise.
+func serveReady(w http.ResponseWriter, ready bool) {
+	if ready {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.WriteHeader(http.StatusServiceUnavailable)
+}
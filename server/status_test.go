@@ -0,0 +1,93 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/backend/fake"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	var resp StatusResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/status", nil, &resp))
+	assert.True(t, resp.Ready)
+	if assert.Len(t, resp.Models, 2) {
+		assert.Equal(t, "1.0", resp.Models[0].Version)
+		assert.Equal(t, lifecycle.Ready, resp.Models[0].State)
+	}
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/ready", nil, nil))
+
+	var status lifecycle.Status
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models/ResNet50_v1/status", nil, &status))
+	assert.Equal(t, "2.0", status.Version)
+	assert.Equal(t, lifecycle.Ready, status.State)
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v1/models/AlexNet/status", nil, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodPost, ts.URL+"/v1/status", nil, nil))
+}
+
+func TestStatusLoading(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	alexnet := imageMetadata("1.0")
+	alexnet.Name = "AlexNet"
+	loading := s.Lifecycle.Track(alexnet.Name, alexnet.Version)
+	assert.NoError(t, loading.Set(lifecycle.Downloading))
+
+	// the server is not ready until every model is
+	assert.Equal(t, http.StatusServiceUnavailable, do(t, http.MethodGet, ts.URL+"/v2/health/ready", nil, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, do(t, http.MethodGet, ts.URL+"/v2/models/AlexNet/ready", nil, nil))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/ready", nil, nil))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/live", nil, nil))
+
+	var e struct{ Error string }
+	assert.Equal(t, http.StatusServiceUnavailable, do(t, http.MethodGet, ts.URL+"/v1/models/AlexNet", nil, &e))
+	assert.Contains(t, e.Error, "AlexNet:1.0 is downloading")
+	assert.Equal(t, http.StatusServiceUnavailable, do(t, http.MethodPost, ts.URL+"/v2/models/AlexNet/versions/1.0/infer", "{}", nil))
+
+	assert.NoError(t, loading.Fail(errors.New("out of memory")))
+	var status lifecycle.Status
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/models/AlexNet/versions/1.0/status", nil, &status))
+	assert.Equal(t, lifecycle.Failed, status.State)
+	assert.Equal(t, "out of memory", status.LastError)
+	assert.Len(t, status.History, 3)
+	// the failure is reported, and the other models keep serving
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/ready", nil, nil))
+	var resp StatusResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v1/status", nil, &resp))
+	assert.True(t, resp.Ready)
+	assert.Equal(t, []string{"alexnet:1.0"}, resp.Failed)
+
+	// a successful reload makes it ready
+	assert.NoError(t, s.Add(fake.New(alexnet)))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/models/AlexNet/ready", nil, nil))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/ready", nil, nil))
+}
+
+func TestStatusAnnounced(t *testing.T) {
+	s := New()
+	defer s.Close()
+	assert.False(t, s.Ready(), "a server without models is not ready")
+
+	m := imageMetadata("1.0")
+	announced := s.Lifecycle.Track(m.Name, m.Version)
+	_, err := s.Lookup(m.Name, "")
+	assert.Equal(t, backend.ErrUnavailable, errors.Cause(err))
+
+	assert.NoError(t, s.Add(fake.New(m)))
+	assert.Equal(t, lifecycle.Ready, announced.State())
+	assert.True(t, s.Ready())
+
+	assert.NoError(t, s.Close())
+	assert.Equal(t, lifecycle.Closed, announced.State())
+	assert.False(t, s.Ready())
+}
@@ -0,0 +1,396 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/metrics"
+)
+
+// InferenceHeaderContentLength is the header of the binary tensor extension
+// of the KServe v2 protocol. It holds the length of the JSON part of bodies
+// followed by binary tensor data.
+const InferenceHeaderContentLength = "Inference-Header-Content-Length"
+
+// Platform is the platform models are advertised with in the KServe v2
+// model metadata.
+const Platform = "tensorrt"
+
+// v2Extensions are the extensions of the KServe v2 protocol the server
+// implements.
+var v2Extensions = []string{"binary_tensor_data"}
+
+// V2Tensor is a tensor of a KServe v2 inference request or response.
+type V2Tensor struct {
+	Name       string                 `json:"name"`
+	Shape      []int                  `json:"shape"`
+	Datatype   string                 `json:"datatype"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Data       interface{}            `json:"data,omitempty"`
+}
+
+// V2RequestedOutput selects an output of a KServe v2 inference request.
+type V2RequestedOutput struct {
+	Name       string                 `json:"name"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// V2InferRequest is the body of the KServe v2 infer endpoint.
+type V2InferRequest struct {
+	ID         string                 `json:"id,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Inputs     []V2Tensor             `json:"inputs"`
+	Outputs    []V2RequestedOutput    `json:"outputs,omitempty"`
+}
+
+// V2InferResponse is returned by the KServe v2 infer endpoint.
+type V2InferResponse struct {
+	ModelName    string                 `json:"model_name"`
+	ModelVersion string                 `json:"model_version,omitempty"`
+	ID           string                 `json:"id,omitempty"`
+	Parameters   map[string]interface{} `json:"parameters,omitempty"`
+	Outputs      []V2Tensor             `json:"outputs"`
+}
+
+// V2ModelMetadata is returned by the KServe v2 model metadata endpoint.
+type V2ModelMetadata struct {
+	Name     string               `json:"name"`
+	Versions []string             `json:"versions,omitempty"`
+	Platform string               `json:"platform"`
+	Inputs   []backend.TensorInfo `json:"inputs"`
+	Outputs  []backend.TensorInfo `json:"outputs"`
+}
+
+// V2ServerMetadata is returned by the KServe v2 server metadata endpoint.
+type V2ServerMetadata struct {
+	Name       string   `json:"name"`
+	Version    string   `json:"version"`
+	Extensions []string `json:"extensions"`
+}
+
+// routeV2 adds the endpoints of the KServe v2 inference protocol:
+//
+//	GET  /v2
+//	GET  /v2/health/live
+//	GET  /v2/health/ready
+//	GET  /v2/models/{name}[/versions/{version}]
+//	GET  /v2/models/{name}[/versions/{version}]/ready
+//	POST /v2/models/{name}[/versions/{version}]/infer
+func (s *Server) routeV2() {
+	s.mux.HandleFunc("/v2", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		writeJSON(w, http.StatusOK, V2ServerMetadata{Name: s.Name, Version: s.Version, Extensions: v2Extensions})
+	})
+	s.mux.HandleFunc("/v2/health/live", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	s.mux.HandleFunc("/v2/health/ready", func(w http.ResponseWriter, r *http.Request) {
+		serveReady(w, s.Ready())
+	})
+	s.mux.HandleFunc("/v2/models/", s.serveV2Model)
+}
+
+func (s *Server) serveV2Model(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v2/models/"), "/"), "/")
+	name, version, action := parts[0], "", ""
+	rest := parts[1:]
+	if len(rest) >= 2 && rest[0] == "versions" {
+		version, rest = rest[1], rest[2:]
+	}
+	if len(rest) == 1 {
+		action, rest = rest[0], nil
+	}
+	if name == "" || len(rest) != 0 || (action != "" && action != "ready" && action != "infer") {
+		http.NotFound(w, r)
+		return
+	}
+
+	if action == "ready" {
+		status, err := s.Status(name, version)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		serveReady(w, status.State == lifecycle.Ready)
+		return
+	}
+
+	b, err := s.Lookup(name, version)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	switch action {
+	case "":
+		if r.Method != http.MethodGet {
+			methodNotAllowed(w, http.MethodGet)
+			return
+		}
+		m := b.Metadata()
+		writeJSON(w, http.StatusOK, V2ModelMetadata{
+			Name:     m.Name,
+			Versions: s.versions(m.Name),
+			Platform: Platform,
+			Inputs:   m.Inputs,
+			Outputs:  m.Outputs,
+		})
+	case "infer":
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w, http.MethodPost)
+			return
+		}
+		s.infer(w, r, b)
+	}
+}
+
+// versions returns the served versions of a model.
+func (s *Server) versions(name string) []string {
+	var versions []string
+	for _, m := range s.Models() {
+		if strings.EqualFold(m.Name, name) {
+			versions = append(versions, m.Version)
+		}
+	}
+	return versions
+}
+
+func (s *Server) infer(w http.ResponseWriter, r *http.Request, b backend.Backend) {
+	m := b.Metadata()
+	mm := s.Metrics.Model(m.Name, m.Version)
+	mm.Request()
+	fail := func(err error) {
+		mm.Error(err)
+		writeError(w, err)
+	}
+
+	start := time.Now()
+	req, binaryData, err := readV2Request(http.MaxBytesReader(w, r.Body, MaxRequestSize), r.Header.Get(InferenceHeaderContentLength))
+	if err != nil {
+		fail(err)
+		return
+	}
+	inputs, err := v2Inputs(req.Inputs, binaryData)
+	if err != nil {
+		fail(err)
+		return
+	}
+	if err := checkRequestedOutputs(req, m); err != nil {
+		fail(err)
+		return
+	}
+	mm.Since(metrics.Preprocess, start)
+
+	outputs, timings, err := predict(r.Context(), mm, b, inputs)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	defer mm.Since(metrics.Postprocess, time.Now())
+	resp := V2InferResponse{ModelName: m.Name, ModelVersion: m.Version, ID: req.ID}
+	if !timings.IsZero() {
+		resp.Parameters = map[string]interface{}{
+			"compute_ns":        int64(timings.Compute),
+			"device_to_host_ns": int64(timings.DeviceToHost),
+		}
+	}
+	var binaryOut bytes.Buffer
+	requested, err := requestedOutputs(req, outputs)
+	if err != nil {
+		fail(err)
+		return
+	}
+	for _, o := range requested {
+		tensor := V2Tensor{Name: o.tensor.Name, Shape: o.tensor.Shape, Datatype: backend.FP32}
+		if o.binary {
+			size := binaryOut.Len()
+			binary.Write(&binaryOut, binary.LittleEndian, o.tensor.Data)
+			tensor.Parameters = map[string]interface{}{"binary_data_size": binaryOut.Len() - size}
+		} else {
+			tensor.Data = o.tensor.Data
+		}
+		resp.Outputs = append(resp.Outputs, tensor)
+	}
+
+	if binaryOut.Len() == 0 {
+		writeJSON(w, http.StatusOK, resp)
+		return
+	}
+	header, err := json.Marshal(resp)
+	if err != nil {
+		fail(err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set(InferenceHeaderContentLength, strconv.Itoa(len(header)))
+	w.Header().Set("Content-Length", strconv.Itoa(len(header)+binaryOut.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(header)
+	w.Write(binaryOut.Bytes())
+}
+
+// readV2Request decodes the JSON part of the body and returns the binary
+// tensor data following it, if any.
+func readV2Request(body io.Reader, headerLength string) (*V2InferRequest, []byte, error) {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, nil, &backend.InputError{Message: "cannot read the request: " + err.Error()}
+	}
+	jsonPart, binaryPart := data, []byte(nil)
+	if headerLength != "" {
+		n, err := strconv.Atoi(headerLength)
+		if err != nil || n < 0 || n > len(data) {
+			return nil, nil, &backend.InputError{Message: fmt.Sprintf("invalid %s %q", InferenceHeaderContentLength, headerLength)}
+		}
+		jsonPart, binaryPart = data[:n], data[n:]
+	}
+	req := new(V2InferRequest)
+	dec := json.NewDecoder(bytes.NewReader(jsonPart))
+	dec.UseNumber()
+	if err := dec.Decode(req); err != nil {
+		return nil, nil, &backend.InputError{Message: "invalid request: " + err.Error()}
+	}
+	return req, binaryPart, nil
+}
+
+// v2Inputs converts the inputs of a request, reading the binary ones from
+// binaryData in order.
+func v2Inputs(tensors []V2Tensor, binaryData []byte) ([]backend.Tensor, error) {
+	inputs := make([]backend.Tensor, len(tensors))
+	for ii, t := range tensors {
+		if t.Datatype != backend.FP32 {
+			return nil, &backend.InputError{Message: fmt.Sprintf("input %s has datatype %s, only %s is supported", t.Name, t.Datatype, backend.FP32)}
+		}
+		input := backend.Tensor{Name: t.Name, Shape: t.Shape}
+		if v, ok := t.Parameters["binary_data_size"]; ok {
+			size, err := intParameter(v)
+			if err != nil || size < 0 || size > len(binaryData) || size%4 != 0 {
+				return nil, &backend.InputError{Message: fmt.Sprintf("input %s has an invalid binary_data_size %v", t.Name, v)}
+			}
+			input.Data = make([]float32, size/4)
+			for jj := range input.Data {
+				input.Data[jj] = math.Float32frombits(binary.LittleEndian.Uint32(binaryData[4*jj:]))
+			}
+			binaryData = binaryData[size:]
+		} else if t.Data == nil {
+			return nil, &backend.InputError{Message: fmt.Sprintf("input %s has no data", t.Name)}
+		} else {
+			var err error
+			if input.Data, err = flatten(t.Data, nil); err != nil {
+				return nil, &backend.InputError{Message: fmt.Sprintf("input %s: %v", t.Name, err)}
+			}
+		}
+		inputs[ii] = input
+	}
+	if len(binaryData) != 0 {
+		return nil, &backend.InputError{Message: fmt.Sprintf("%d bytes of binary data are not used by any input", len(binaryData))}
+	}
+	return inputs, nil
+}
+
+// flatten appends the numbers of a, possibly nested, JSON array to data.
+func flatten(v interface{}, data []float32) ([]float32, error) {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, e := range v {
+			var err error
+			if data, err = flatten(e, data); err != nil {
+				return nil, err
+			}
+		}
+		return data, nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, errors.Errorf("%v is not a number", v)
+		}
+		return append(data, float32(f)), nil
+	}
+	return nil, errors.Errorf("%v is not a number", v)
+}
+
+func intParameter(v interface{}) (int, error) {
+	switch v := v.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err
+	case float64:
+		return int(v), nil
+	}
+	return 0, errors.Errorf("%v is not an integer", v)
+}
+
+func boolParameter(params map[string]interface{}, name string) bool {
+	b, _ := params[name].(bool)
+	return b
+}
+
+type requestedOutput struct {
+	tensor backend.Tensor
+	binary bool
+}
+
+// checkRequestedOutputs makes sure the model has the outputs the request asks
+// for, before it is run.
+func checkRequestedOutputs(req *V2InferRequest, m backend.Metadata) error {
+	for _, r := range req.Outputs {
+		found := false
+		for _, o := range m.Outputs {
+			if o.Name == r.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &backend.InputError{Message: fmt.Sprintf("the model has no output %s", r.Name)}
+		}
+	}
+	return nil
+}
+
+// requestedOutputs returns the outputs the request asks for, every output
+// when it does not say.
+func requestedOutputs(req *V2InferRequest, outputs []backend.Tensor) ([]requestedOutput, error) {
+	binaryDefault := boolParameter(req.Parameters, "binary_data_output")
+	if len(req.Outputs) == 0 {
+		requested := make([]requestedOutput, len(outputs))
+		for ii, o := range outputs {
+			requested[ii] = requestedOutput{tensor: o, binary: binaryDefault}
+		}
+		return requested, nil
+	}
+	var requested []requestedOutput
+	for _, r := range req.Outputs {
+		found := false
+		for _, o := range outputs {
+			if o.Name == r.Name {
+				binary := binaryDefault
+				if v, ok := r.Parameters["binary_data"].(bool); ok {
+					binary = v
+				}
+				requested = append(requested, requestedOutput{tensor: o, binary: binary})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, &backend.InputError{Message: fmt.Sprintf("the model has no output %s", r.Name)}
+		}
+	}
+	return requested, nil
+}
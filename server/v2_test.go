@@ -0,0 +1,174 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestV2Health(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+	s.Version = "7.0.0"
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/live", nil, nil))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/health/ready", nil, nil))
+
+	var md V2ServerMetadata
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2", nil, &md))
+	assert.Equal(t, V2ServerMetadata{Name: DefaultName, Version: "7.0.0", Extensions: []string{"binary_tensor_data"}}, md)
+}
+
+func TestV2ModelMetadata(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	var md V2ModelMetadata
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1", nil, &md))
+	assert.Equal(t, V2ModelMetadata{
+		Name:     "ResNet50_v1",
+		Versions: []string{"1.0", "2.0"},
+		Platform: Platform,
+		Inputs:   []backend.TensorInfo{{Name: "data", Datatype: "FP32", Shape: []int{-1, 3, 2, 2}}},
+		Outputs:  []backend.TensorInfo{{Name: "prob", Datatype: "FP32", Shape: []int{-1, 4}}},
+	}, md)
+
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/versions/1.0/ready", nil, nil))
+	assert.Equal(t, http.StatusOK, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/ready", nil, nil))
+
+	var e struct{ Error string }
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v2/models/AlexNet/ready", nil, &e))
+	assert.NotEmpty(t, e.Error)
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/versions/3.0", nil, nil))
+	assert.Equal(t, http.StatusNotFound, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/unknown", nil, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(t, http.MethodGet, ts.URL+"/v2/models/ResNet50_v1/infer", nil, nil))
+}
+
+func TestV2InferJSON(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+	latest.Timings = backend.Timings{Compute: 4 * time.Millisecond, DeviceToHost: 2 * time.Millisecond}
+
+	// nested and flat data are both accepted
+	body := `{
+		"id": "42",
+		"inputs": [{
+			"name": "data",
+			"shape": [3, 3, 2, 2],
+			"datatype": "FP32",
+			"data": [[[[1, 0], [0, 0]], [[0, 0], [0, 0]], [[0, 0], [0, 0]]], 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0.5, 0.5, 0.5, 0.5, 0.5, 0.5, 0, 0, 0, 0, 0, 0]
+		}]
+	}`
+	var resp V2InferResponse
+	assert.Equal(t, http.StatusOK, do(t, http.MethodPost, ts.URL+"/v2/models/ResNet50_v1/infer", body, &resp))
+	assert.Equal(t, "ResNet50_v1", resp.ModelName)
+	assert.Equal(t, "2.0", resp.ModelVersion)
+	assert.Equal(t, "42", resp.ID)
+	assert.Equal(t, []int{2, 1}, latest.Batches())
+	// the timings of the two batches add up
+	assert.Equal(t, map[string]interface{}{
+		"compute_ns":        float64(8e6),
+		"device_to_host_ns": float64(4e6),
+	}, resp.Parameters)
+	if assert.Len(t, resp.Outputs, 1) {
+		out := resp.Outputs[0]
+		assert.Equal(t, "prob", out.Name)
+		assert.Equal(t, "FP32", out.Datatype)
+		assert.Equal(t, []int{3, 4}, out.Shape)
+		data := out.Data.([]interface{})
+		if assert.Len(t, data, 12) {
+			// the classes of the batch elements are 1, 2 and 3
+			for e, class := range []int{1, 2, 3} {
+				assert.InDelta(t, 0.9, data[e*4+class], 1e-6)
+			}
+		}
+	}
+}
+
+func TestV2InferBinary(t *testing.T) {
+	s, ts, _ := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+
+	input := make([]float32, 12)
+	input[5] = 2
+	var raw bytes.Buffer
+	assert.NoError(t, binary.Write(&raw, binary.LittleEndian, input))
+
+	header, err := json.Marshal(V2InferRequest{
+		Inputs: []V2Tensor{{
+			Name:       "data",
+			Shape:      []int{1, 3, 2, 2},
+			Datatype:   "FP32",
+			Parameters: map[string]interface{}{"binary_data_size": raw.Len()},
+		}},
+		Outputs: []V2RequestedOutput{{Name: "prob", Parameters: map[string]interface{}{"binary_data": true}}},
+	})
+	assert.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/v2/models/ResNet50_v1/versions/1.0/infer", bytes.NewReader(append(header, raw.Bytes()...)))
+	assert.NoError(t, err)
+	req.Header.Set(InferenceHeaderContentLength, strconv.Itoa(len(header)))
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	n, err := strconv.Atoi(resp.Header.Get(InferenceHeaderContentLength))
+	assert.NoError(t, err)
+	var infer V2InferResponse
+	assert.NoError(t, json.Unmarshal(body[:n], &infer))
+	if assert.Len(t, infer.Outputs, 1) {
+		out := infer.Outputs[0]
+		assert.Nil(t, out.Data)
+		assert.EqualValues(t, 16, out.Parameters["binary_data_size"])
+		probs := body[n:]
+		if assert.Len(t, probs, 16) {
+			assert.InDelta(t, 0.9, math.Float32frombits(binary.LittleEndian.Uint32(probs[2*4:])), 1e-6)
+		}
+	}
+}
+
+func TestV2InferErrors(t *testing.T) {
+	s, ts, latest := newTestServer(t)
+	defer ts.Close()
+	defer s.Close()
+	url := ts.URL + "/v2/models/ResNet50_v1/infer"
+
+	for _, body := range []string{
+		`{"inputs": [`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "INT8", "data": [0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]}]}`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "FP32", "data": [0, 0]}]}`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "FP32", "data": ["a"]}]}`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "FP32"}]}`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "FP32", "parameters": {"binary_data_size": 48}}]}`,
+		`{"inputs": [{"name": "data", "shape": [1, 3, 2, 2], "datatype": "FP32", "data": [0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0]}], "outputs": [{"name": "logits"}]}`,
+	} {
+		var e struct{ Error string }
+		assert.Equal(t, http.StatusBadRequest, do(t, http.MethodPost, url, body, &e), body)
+		assert.NotEmpty(t, e.Error)
+	}
+	assert.Empty(t, latest.Batches(), "invalid requests are not run")
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte(`{"inputs": []}`)))
+	assert.NoError(t, err)
+	req.Header.Set(InferenceHeaderContentLength, "1000")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,65 @@
+package tensorrt
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/rai-project/tensorrt/manifest"
+)
+
+// StoreDir returns the directory of the local model store, which defaults to
+// ~/.carml/tensorrt/store.
+func StoreDir() string {
+	if Config.StoreDir != "" {
+		return Config.StoreDir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home = os.TempDir()
+	}
+	return filepath.Join(home, ".carml", "tensorrt", "store")
+}
+
+// Store returns the local model store artifacts are resolved from in offline
+// mode.
+func Store() *artifact.Store {
+	store := artifact.NewStore(StoreDir())
+	store.Mirrors = Mirrors()
+	return store
+}
+
+// Mirrors returns the mirror rules of the configuration, in order. Invalid
+// rules are logged and ignored.
+func Mirrors() artifact.Mirrors {
+	var mirrors artifact.Mirrors
+	for _, s := range Config.Mirrors {
+		rule, err := artifact.ParseMirrorRule(s)
+		if err != nil {
+			log.WithError(err).Error("ignoring mirror rule")
+			continue
+		}
+		mirrors = append(mirrors, rule)
+	}
+	return mirrors
+}
+
+// Manifests returns the manifests of the models the agent registers.
+func Manifests() ([]*manifest.Manifest, error) {
+	files, err := collectManifests()
+	if err != nil {
+		return nil, err
+	}
+	files = filterModels(files)
+
+	manifests := make([]*manifest.Manifest, 0, len(files))
+	for _, file := range files {
+		m, err := manifest.Parse(file.Data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse %s", file.Name)
+		}
+		manifests = append(manifests, m)
+	}
+	return manifests, nil
+}
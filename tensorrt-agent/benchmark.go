@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework/framework/options"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/backend/fake"
+	"github.com/rai-project/tensorrt/benchmark"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/predictor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchBatchSizes  []int
+	benchConcurrency []int
+	benchWarmup      int
+	benchIterations  int
+	benchImages      []string
+	benchSeed        int64
+	benchJSON        string
+	benchCSV         string
+	benchFakeBackend bool
+)
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark name[:version]",
+	Short: "Measure the latency and throughput of a model",
+	Long: `Load the model once per batch size, warm it up and measure it at every
+concurrency level. Each result reports the p50, p90 and p99 latencies, the
+throughput in images per second and the device memory of the model.
+
+The models are measured at fp32, the only precision the linked go-tensorrt
+builds engines at.
+
+The inputs are synthetic unless --images are given. Results are printed as a
+table, and written as JSON to --json and as CSV to --csv, - for the standard
+output.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		applyFlags()
+		manifests, err := tensorrt.Manifests()
+		if err != nil {
+			return err
+		}
+		manifests, err = selectModels(manifests, args)
+		if err != nil {
+			return err
+		}
+		if len(manifests) != 1 {
+			return errors.Errorf("%s matches %d models, select a version", args[0], len(manifests))
+		}
+		if !benchFakeBackend {
+			tensorrt.Register()
+		}
+
+		var inputs benchmark.Source = benchmark.Synthetic{Seed: benchSeed}
+		if len(benchImages) != 0 {
+			images, err := readImages(benchImages)
+			if err != nil {
+				return err
+			}
+			inputs = images
+		}
+
+		w := tabwriter.NewWriter(os.Stderr, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "PRECISION\tBATCH\tCONCURRENCY\tP50\tP90\tP99\tTHROUGHPUT\tMEMORY\tERROR")
+		report, err := benchmark.Run(context.Background(), benchmark.Config{
+			Precisions:  []string{predictor.DefaultPrecision},
+			BatchSizes:  benchBatchSizes,
+			Concurrency: benchConcurrency,
+			Warmup:      benchWarmup,
+			Iterations:  benchIterations,
+			Inputs:      inputs,
+			Progress: func(r benchmark.Result) {
+				fmt.Fprintf(w, "%s\t%d\t%d\t%.3fms\t%.3fms\t%.3fms\t%.1f/s\t%s\t%s\n",
+					r.Precision, r.BatchSize, r.Concurrency, r.LatencyP50, r.LatencyP90, r.LatencyP99,
+					r.Throughput, formatMemory(r), r.Error)
+				w.Flush()
+			},
+		}, benchmarkLoader(manifests[0]))
+		if err != nil {
+			return err
+		}
+
+		if err := writeReport(benchJSON, report.WriteJSON); err != nil {
+			return err
+		}
+		return writeReport(benchCSV, report.WriteCSV)
+	},
+}
+
+// benchmarkLoader loads the model of m for the benchmark, with an engine built
+// at the requested precision.
+func benchmarkLoader(m *manifest.Manifest) benchmark.Loader {
+	return func(ctx context.Context, precision string, batchSize int) (backend.Backend, error) {
+		if benchFakeBackend {
+			metadata, err := backend.NewMetadata(m)
+			if err != nil {
+				return nil, err
+			}
+			metadata.MaxBatchSize = batchSize
+			return fake.New(metadata), nil
+		}
+		platform, err := tensorrt.CurrentPlatform()
+		if err != nil {
+			return nil, err
+		}
+		if !platform.SupportsPrecision(precision) {
+			return nil, errors.Errorf("tensorrt does not support %s on %s/%s", precision, platform.OS, platform.Arch)
+		}
+		b, err := predictor.NewBackend(ctx, m, precision, options.BatchSize(batchSize))
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+}
+
+// formatMemory formats the measured device memory of a result, or the
+// estimated one, marked as such, when it was not measured.
+func formatMemory(r benchmark.Result) string {
+	if r.MeasuredMemoryBytes != 0 {
+		return humanize.IBytes(uint64(r.MeasuredMemoryBytes))
+	}
+	return humanize.IBytes(uint64(r.EstimatedMemoryBytes)) + " (estimated)"
+}
+
+func readImages(paths []string) (benchmark.Images, error) {
+	images := make(benchmark.Images, len(paths))
+	for ii, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read image")
+		}
+		img, _, err := image.Decode(f)
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot decode image %s", path)
+		}
+		images[ii] = img
+	}
+	return images, nil
+}
+
+// writeReport writes a report to path, to the standard output for -, and
+// not at all when path is empty.
+func writeReport(path string, write func(io.Writer) error) error {
+	switch path {
+	case "":
+		return nil
+	case "-":
+		return write(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "cannot write the report")
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "cannot write the report to %s", path)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "cannot write the report to %s", path)
+	}
+	log.WithField("path", path).Info("wrote the benchmark report")
+	return nil
+}
+
+func init() {
+	benchmarkCmd.Flags().IntSliceVar(&benchBatchSizes, "batch-sizes", []int{1}, "batch sizes to sweep")
+	benchmarkCmd.Flags().IntSliceVar(&benchConcurrency, "concurrency", []int{1}, "numbers of concurrent predictions to sweep")
+	benchmarkCmd.Flags().IntVar(&benchWarmup, "warmup", 10, "predictions run after each load before measuring")
+	benchmarkCmd.Flags().IntVar(&benchIterations, "iterations", 100, "predictions measured per result")
+	benchmarkCmd.Flags().StringSliceVar(&benchImages, "images", nil, "images to predict instead of synthetic inputs, may be repeated")
+	benchmarkCmd.Flags().Int64Var(&benchSeed, "seed", 1, "seed of the synthetic inputs")
+	benchmarkCmd.Flags().StringVar(&benchJSON, "json", "", "file to write the JSON report to, - for the standard output")
+	benchmarkCmd.Flags().StringVar(&benchCSV, "csv", "", "file to write the CSV report to, - for the standard output")
+	benchmarkCmd.Flags().BoolVar(&benchFakeBackend, "fake-backend", false,
+		"benchmark synthetic predictions instead of loading the model, to test the benchmark without a GPU")
+}
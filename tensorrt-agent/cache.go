@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/cache"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pruneQuota string
+	pruneAll   bool
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the disk space used by the model work directories",
+}
+
+var cacheLsCmd = &cobra.Command{
+	Use:   "ls",
+	Short: "List the model work directories, least recently used first",
+	RunE: func(c *cobra.Command, args []string) error {
+		m, err := tensorrt.CacheManager()
+		if err != nil {
+			return err
+		}
+		entries, err := m.List()
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "DIRECTORY\tSIZE\tLAST USED\tIN USE")
+		for _, e := range entries {
+			inUse := "no"
+			if e.InUse {
+				inUse = "yes"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Dir, humanize.Bytes(uint64(e.Size)), e.LastUsed.Format(time.RFC3339), inUse)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		quota := "none"
+		if m.Quota > 0 {
+			quota = humanize.Bytes(uint64(m.Quota))
+		}
+		fmt.Printf("\n%d model(s) using %s in %s, quota %s\n", len(entries), humanize.Bytes(uint64(cache.Usage(entries))), m.Root, quota)
+		return nil
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:   "prune",
+	Short: "Evict the least recently used model work directories",
+	Long: `Evict the least recently used model work directories until they fit in
+the quota, tensorrt.cache_quota unless --quota is given. Work directories of
+models loaded by a running agent are never evicted.`,
+	RunE: func(c *cobra.Command, args []string) error {
+		m, err := tensorrt.CacheManager()
+		if err != nil {
+			return err
+		}
+
+		quota := m.Quota
+		switch {
+		case pruneAll:
+			quota = -1
+		case pruneQuota != "":
+			q, err := humanize.ParseBytes(pruneQuota)
+			if err != nil {
+				return errors.Wrapf(err, "invalid quota %q", pruneQuota)
+			}
+			quota = int64(q)
+		case quota <= 0:
+			return errors.New("no quota to prune to, set tensorrt.cache_quota or pass --quota or --all")
+		}
+
+		evicted, err := m.Prune(context.Background(), quota)
+		var freed int64
+		for _, e := range evicted {
+			fmt.Printf("evicted %s (%s)\n", e.Dir, humanize.Bytes(uint64(e.Size)))
+			freed += e.Size
+		}
+		fmt.Printf("freed %s\n", humanize.Bytes(uint64(freed)))
+		return err
+	},
+}
+
+func init() {
+	cachePruneCmd.Flags().StringVar(&pruneQuota, "quota", "", "disk space to prune down to, e.g. 20GB, overrides tensorrt.cache_quota")
+	cachePruneCmd.Flags().BoolVar(&pruneAll, "all", false, "evict every model work directory that is not in use")
+	cacheCmd.AddCommand(cacheLsCmd)
+	cacheCmd.AddCommand(cachePruneCmd)
+}
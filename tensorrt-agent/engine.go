@@ -0,0 +1,106 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/native"
+	"github.com/rai-project/tensorrt/plan"
+	"github.com/rai-project/tensorrt/predictor"
+	"github.com/spf13/cobra"
+)
+
+var (
+	engineTensorRTVersion   string
+	engineComputeCapability string
+	enginePrecision         string
+	engineMaxBatchSize      int
+)
+
+var engineCmd = &cobra.Command{
+	Use:   "engine",
+	Short: "Manage prebuilt engine plans",
+}
+
+var engineMetadataCmd = &cobra.Command{
+	Use:   "metadata plan name[:version]",
+	Short: "Write the metadata an engine plan is loaded with",
+	Long: `Write the metadata file (plan.json) that engine plans must be published
+with. trtexec does not produce it, run this on the machine the plan was
+built on: the TensorRT version and the compute capability of tensorrt.device
+are detected unless given, and the bindings are those of the manifest of the
+model.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(c *cobra.Command, args []string) error {
+		applyFlags()
+		manifests, err := tensorrt.Manifests()
+		if err != nil {
+			return err
+		}
+		manifests, err = selectModels(manifests, args[1:])
+		if err != nil {
+			return err
+		}
+		if len(manifests) != 1 {
+			return errors.Errorf("%s matches %d models, select a version", args[1], len(manifests))
+		}
+
+		version := engineTensorRTVersion
+		if version == "" {
+			versions, err := native.Detect()
+			if err != nil {
+				return errors.Wrap(err, "cannot detect the TensorRT version, pass --tensorrt-version")
+			}
+			version = versions.TensorRT
+		}
+		computeCapability := engineComputeCapability
+		if computeCapability == "" {
+			computeCapability, err = native.ComputeCapability(tensorrt.Config.Device)
+			if err != nil {
+				return errors.Wrap(err, "cannot detect the compute capability, pass --compute-capability")
+			}
+		}
+
+		meta, err := engineMetadata(manifests[0], version, computeCapability)
+		if err != nil {
+			return err
+		}
+		if err := plan.WriteMetadata(args[0], meta); err != nil {
+			return err
+		}
+		log.WithField("path", plan.MetadataPath(args[0])).Info("wrote the engine plan metadata")
+		return nil
+	},
+}
+
+// engineMetadata describes a plan built for the model of m, with the bindings
+// the predictor checks the plan against.
+func engineMetadata(m *manifest.Manifest, version, computeCapability string) (plan.Metadata, error) {
+	md, err := backend.NewMetadata(m)
+	if err != nil {
+		return plan.Metadata{}, err
+	}
+	meta := plan.Metadata{
+		TensorRTVersion:   version,
+		ComputeCapability: computeCapability,
+		Precision:         enginePrecision,
+		MaxBatchSize:      engineMaxBatchSize,
+	}
+	for _, input := range md.Inputs {
+		// the leading dimension of the metadata inputs is the batch
+		meta.Bindings = append(meta.Bindings, plan.Binding{Name: input.Name, Shape: input.Shape[1:], IsInput: true})
+	}
+	for _, output := range md.Outputs {
+		meta.Bindings = append(meta.Bindings, plan.Binding{Name: output.Name})
+	}
+	return meta, nil
+}
+
+func init() {
+	engineMetadataCmd.Flags().StringVar(&engineTensorRTVersion, "tensorrt-version", "", "TensorRT version the plan was built with, detected when empty")
+	engineMetadataCmd.Flags().StringVar(&engineComputeCapability, "compute-capability", "", "compute capability the plan was built for, detected from tensorrt.device when empty")
+	engineMetadataCmd.Flags().StringVar(&enginePrecision, "precision", predictor.DefaultPrecision, "precision the plan was built at")
+	engineMetadataCmd.Flags().IntVar(&engineMaxBatchSize, "max-batch-size", 0, "largest batch the plan was built for, 0 when unknown")
+	engineCmd.AddCommand(engineMetadataCmd)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineMetadata(t *testing.T) {
+	m, err := manifest.Parse([]byte(`
+name: ResNet50_Engine
+framework: {name: TensorRT, version: 7.0.0}
+version: 1.0
+inputs: [{type: image, parameters: {input_layer: data, dimensions: [3, 224, 224]}}]
+output: {type: classification, parameters: {probabilities_layer: prob}}
+model: {graph_path: resnet50.plan}
+`))
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	meta, err := engineMetadata(m, "7.0.0", "7.5")
+	assert.NoError(t, err)
+	assert.NoError(t, meta.Validate())
+	assert.Equal(t, []plan.Binding{
+		{Name: "data", Shape: []int{3, 224, 224}, IsInput: true},
+		{Name: "prob"},
+	}, meta.Bindings)
+	assert.NoError(t, meta.Check(plan.Requirements{
+		TensorRTVersion:   "7.0.0",
+		ComputeCapability: "75",
+		BatchSize:         8,
+		Bindings:          meta.Bindings,
+	}))
+}
@@ -8,26 +8,107 @@ import (
 	cmd "github.com/rai-project/dlframework/framework/cmd/server"
 	"github.com/rai-project/logger"
 	"github.com/rai-project/tensorrt"
-	_ "github.com/rai-project/tensorrt/predictor"
 	"github.com/rai-project/tracer"
 	"github.com/sirupsen/logrus"
 )
 
 var (
-	modelName    string
-	modelVersion string
-	hostName, _  = os.Hostname()
-	framework    = tensorrt.FrameworkManifest
-	log          *logrus.Entry
+	modelName                string
+	modelVersion             string
+	ignoreVersionConstraints bool
+	offline                  bool
+	requireChecksums         bool
+	modelsDirs               []string
+	mirrors                  []string
+	metricsAddress           string
+	profileLayers            bool
+	memoryBudget             string
+	hostName, _              = os.Hostname()
+	framework                = tensorrt.FrameworkManifest
+	log                      *logrus.Entry
 )
 
+// applyFlags copies the persistent flags into the TensorRT configuration.
+func applyFlags() {
+	if ignoreVersionConstraints {
+		tensorrt.Config.IgnoreVersionConstraints = true
+	}
+	if offline {
+		tensorrt.Config.Offline = true
+	}
+	if requireChecksums {
+		tensorrt.Config.RequireChecksums = true
+	}
+	// applyFlags can run several times in a command, merging the lists must
+	// not repeat the flags
+	tensorrt.Config.ModelsDirs = unique(tensorrt.Config.ModelsDirs, modelsDirs)
+	tensorrt.Config.Mirrors = unique(mirrors, tensorrt.Config.Mirrors)
+	if profileLayers {
+		tensorrt.Config.ProfileLayers = true
+	}
+	if metricsAddress != "" {
+		tensorrt.Config.MetricsAddress = metricsAddress
+	}
+	if memoryBudget != "" {
+		tensorrt.Config.MemoryBudget = memoryBudget
+	}
+}
+
+// unique concatenates lists without repeating their elements, in the order
+// they first appear.
+func unique(lists ...[]string) []string {
+	var res []string
+	seen := map[string]bool{}
+	for _, list := range lists {
+		for _, s := range list {
+			if seen[s] {
+				continue
+			}
+			seen[s] = true
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func register() {
+	applyFlags()
+	tensorrt.Register()
+	if _, err := tensorrt.ServeMetrics(); err != nil {
+		log.WithError(err).Error("not serving metrics")
+	}
+}
+
 func main() {
-	rootCmd, err := cmd.NewRootCommand(tensorrt.Register, framework)
+	rootCmd, err := cmd.NewRootCommand(register, framework)
 	if err != nil {
 		fmt.Println(err)
 		os.Exit(-1)
 	}
 
+	rootCmd.PersistentFlags().BoolVar(&ignoreVersionConstraints, "ignore-version-constraints", false,
+		"register models whose framework version constraint does not match the linked TensorRT version")
+	rootCmd.PersistentFlags().StringSliceVar(&modelsDirs, "models-dir", nil,
+		"directory of additional model manifests, may be repeated")
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false,
+		"resolve model artifacts from the local store instead of downloading them")
+	rootCmd.PersistentFlags().BoolVar(&requireChecksums, "require-checksums", false,
+		"refuse to load model artifacts whose manifest does not declare a checksum")
+	rootCmd.PersistentFlags().StringSliceVar(&mirrors, "mirror", nil,
+		"mirror rule prefix=replacement for model artifact urls, may be repeated and is tried before the configured rules")
+	rootCmd.PersistentFlags().StringVar(&metricsAddress, "metrics-address", "",
+		"address to serve the prometheus metrics on, overrides tensorrt.metrics_address")
+	rootCmd.PersistentFlags().BoolVar(&profileLayers, "profile-layers", false,
+		"profile the TensorRT layers of every prediction and trace one span per layer")
+	rootCmd.PersistentFlags().StringVar(&memoryBudget, "memory-budget", "",
+		"device memory the loaded models may use, e.g. 8GiB, overrides tensorrt.memory_budget")
+	rootCmd.AddCommand(manifestCmd)
+	rootCmd.AddCommand(modelsCmd)
+	rootCmd.AddCommand(cacheCmd)
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(benchmarkCmd)
+	rootCmd.AddCommand(engineCmd)
+
 	defer tracer.Close()
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/rai-project/tensorrt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyFlagsIdempotent(t *testing.T) {
+	config := *tensorrt.Config
+	defer func() {
+		tensorrt.Config.ModelsDirs = config.ModelsDirs
+		tensorrt.Config.Mirrors = config.Mirrors
+		modelsDirs, mirrors = nil, nil
+	}()
+	tensorrt.Config.ModelsDirs = []string{"/etc/models"}
+	tensorrt.Config.Mirrors = []string{"http://a/=http://config/"}
+	modelsDirs = []string{"/work/models"}
+	mirrors = []string{"http://a/=http://flag/"}
+
+	for ii := 0; ii < 3; ii++ {
+		applyFlags()
+		assert.Equal(t, []string{"/etc/models", "/work/models"}, tensorrt.Config.ModelsDirs)
+		assert.Equal(t, []string{"http://a/=http://flag/", "http://a/=http://config/"}, tensorrt.Config.Mirrors)
+	}
+}
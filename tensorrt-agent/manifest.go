@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lintStrict bool
+)
+
+var manifestCmd = &cobra.Command{
+	Use:   "manifest",
+	Short: "Inspect model manifests",
+}
+
+var manifestLintCmd = &cobra.Command{
+	Use:   "lint [paths...]",
+	Short: "Lint model manifests",
+	Long: `Lint model manifests against the manifest schema and the TensorRT
+framework manifest. Without arguments the builtin models are linted,
+otherwise the given files and directories are.`,
+	RunE: func(c *cobra.Command, args []string) error {
+		var issues []manifest.Issue
+		var err error
+		if len(args) == 0 {
+			issues, err = tensorrt.LintBuiltinModels()
+		} else {
+			issues, err = tensorrt.NewLinter().LintFiles(args...)
+		}
+		if err != nil {
+			return err
+		}
+
+		errs, warnings := 0, 0
+		for _, issue := range issues {
+			fmt.Println(issue)
+			if issue.Severity == manifest.SeverityError {
+				errs++
+			} else {
+				warnings++
+			}
+		}
+		fmt.Printf("%d errors, %d warnings\n", errs, warnings)
+
+		if errs != 0 || (lintStrict && warnings != 0) {
+			return errors.New("manifest lint failed")
+		}
+		return nil
+	},
+}
+
+func init() {
+	manifestLintCmd.Flags().BoolVar(&lintStrict, "strict", false, "fail on warnings as well as errors")
+	manifestCmd.AddCommand(manifestLintCmd)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/artifact"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/spf13/cobra"
+)
+
+var (
+	storeDir string
+)
+
+var modelsCmd = &cobra.Command{
+	Use:   "models",
+	Short: "Manage the model artifacts of the agent",
+}
+
+var modelsFetchCmd = &cobra.Command{
+	Use:   "fetch [name[:version]...]",
+	Short: "Download model artifacts into the local store",
+	Long: `Download the graph, weights and feature files of the models into the
+local content addressed store, for agents running with tensorrt.offline set.
+Without arguments the artifacts of every registered model are fetched.`,
+	RunE: func(c *cobra.Command, args []string) error {
+		applyFlags()
+		if storeDir != "" {
+			tensorrt.Config.StoreDir = storeDir
+		}
+
+		manifests, err := tensorrt.Manifests()
+		if err != nil {
+			return err
+		}
+		manifests, err = selectModels(manifests, args)
+		if err != nil {
+			return err
+		}
+
+		store := tensorrt.Store()
+		ctx := context.Background()
+		failed := 0
+		for _, m := range manifests {
+			artifacts, err := m.Artifacts()
+			if err != nil {
+				fmt.Printf("%s: %v\n", m.CanonicalName(), err)
+				failed++
+				continue
+			}
+			if tensorrt.Config.RequireChecksums {
+				if err := artifact.RequireChecksums(m.CanonicalName(), artifacts); err != nil {
+					fmt.Println(err)
+					failed++
+					continue
+				}
+			}
+			for _, a := range artifacts {
+				if err := store.Fetch(ctx, a); err != nil {
+					fmt.Printf("%s: %v\n", m.CanonicalName(), err)
+					failed++
+					continue
+				}
+				fmt.Printf("%s: %s -> %s\n", m.CanonicalName(), a.Kind, store.Path(a))
+			}
+		}
+		if failed != 0 {
+			return errors.Errorf("failed to fetch %d artifact(s) into %s", failed, store.Root)
+		}
+		return nil
+	},
+}
+
+// selectModels returns the manifests matching the name or name:version
+// arguments, ignoring case. No arguments select every manifest.
+func selectModels(manifests []*manifest.Manifest, args []string) ([]*manifest.Manifest, error) {
+	if len(args) == 0 {
+		return manifests, nil
+	}
+	var selected []*manifest.Manifest
+	for _, arg := range args {
+		found := false
+		for _, m := range manifests {
+			if strings.EqualFold(arg, m.Name) || strings.EqualFold(arg, m.CanonicalName()) {
+				selected = append(selected, m)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("model %s is not registered", arg)
+		}
+	}
+	return selected, nil
+}
+
+func init() {
+	modelsFetchCmd.Flags().StringVar(&storeDir, "store-dir", "", "directory of the local model store, overrides tensorrt.store_dir")
+	modelsCmd.AddCommand(modelsFetchCmd)
+}
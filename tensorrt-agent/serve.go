@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/tensorrt"
+	"github.com/rai-project/tensorrt/backend"
+	"github.com/rai-project/tensorrt/backend/fake"
+	"github.com/rai-project/tensorrt/lifecycle"
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/predictor"
+	"github.com/rai-project/tensorrt/server"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+var (
+	serveAddress     string
+	serveGRPCAddress string
+	serveFakeBackend bool
+)
+
+// shutdownTimeout bounds how long the server waits for the requests in
+// flight when it is stopped.
+const shutdownTimeout = 30 * time.Second
+
+var serveCmd = &cobra.Command{
+	Use:   "serve name[:version]...",
+	Short: "Serve models over HTTP without the MLModelScope services",
+	Long: `Load the models and serve them over a REST API, without the registry,
+broker and tracing services the agent otherwise depends on:
+
+  GET  /v1/models                                     list the models
+  GET  /v1/models/{name}[/versions/{version}]         model metadata
+  POST /v1/models/{name}[/versions/{version}]/predict predict base64 images or tensors
+
+The models are also served over the KServe v2 inference protocol, under /v2,
+and by the grpc streaming prediction service tensorrt.Predictor.
+
+The server listens while the models load. GET /v1/status reports the state of
+every model and GET /v2/health/ready succeeds once they are all ready.
+
+Prometheus metrics are served at /metrics on --metrics-address.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		applyFlags()
+		manifests, err := tensorrt.Manifests()
+		if err != nil {
+			return err
+		}
+		manifests, err = selectModels(manifests, args)
+		if err != nil {
+			return err
+		}
+		if !serveFakeBackend {
+			tensorrt.Register()
+		}
+
+		srv := server.New()
+		srv.Version = tensorrt.FrameworkManifest.Version
+		srv.Lifecycle = lifecycle.Default
+		defer srv.Close()
+		ctx := context.Background()
+		for _, m := range manifests {
+			srv.Lifecycle.Track(m.Name, m.Version)
+		}
+		go loadModels(ctx, srv, manifests)
+
+		httpServer := &http.Server{Addr: serveAddress, Handler: srv.Handler()}
+		errs := make(chan error, 2)
+		go func() {
+			errs <- httpServer.ListenAndServe()
+		}()
+		log.WithField("address", serveAddress).Info("listening")
+
+		metricsServer, err := tensorrt.ServeMetrics()
+		if err != nil {
+			return err
+		}
+		if metricsServer != nil {
+			defer metricsServer.Close()
+		}
+
+		grpcServer := grpc.NewServer()
+		srv.RegisterGRPC(grpcServer)
+		if serveGRPCAddress != "" {
+			lis, err := net.Listen("tcp", serveGRPCAddress)
+			if err != nil {
+				return errors.Wrap(err, "cannot listen for grpc")
+			}
+			go func() {
+				errs <- grpcServer.Serve(lis)
+			}()
+			log.WithField("address", serveGRPCAddress).Info("listening for grpc")
+		}
+
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		select {
+		case err := <-errs:
+			return err
+		case <-signals:
+		}
+		ctx, cancel := context.WithTimeout(ctx, shutdownTimeout)
+		defer cancel()
+		go func() {
+			<-ctx.Done()
+			grpcServer.Stop()
+		}()
+		grpcServer.GracefulStop()
+		return httpServer.Shutdown(ctx)
+	},
+}
+
+// loadModels loads the models one after the other and serves them. Failures
+// are recorded in the lifecycle of the models, which keeps the server from
+// reporting it is ready.
+func loadModels(ctx context.Context, srv *server.Server, manifests []*manifest.Manifest) {
+	for _, m := range manifests {
+		status := srv.Lifecycle.Track(m.Name, m.Version)
+		var (
+			b   backend.Backend
+			err error
+		)
+		if serveFakeBackend {
+			b, err = fake.NewFromManifest(m)
+		} else {
+			b, err = predictor.NewBackend(ctx, m, "")
+		}
+		if err == nil {
+			if err = srv.Add(b); err != nil {
+				b.Close()
+			}
+		}
+		if err != nil {
+			status.Fail(err)
+			log.WithError(err).WithField("model", m.CanonicalName()).Error("cannot load model")
+			continue
+		}
+		log.WithField("model", m.CanonicalName()).Info("serving model")
+	}
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveAddress, "address", ":8080", "address to listen on")
+	serveCmd.Flags().StringVar(&serveGRPCAddress, "grpc-address", ":8081", "address to serve the grpc streaming prediction service on, empty to disable it")
+	serveCmd.Flags().BoolVar(&serveFakeBackend, "fake-backend", false,
+		"serve synthetic predictions instead of loading the models, to test clients without a GPU")
+}
@@ -0,0 +1,61 @@
+package tensorrt
+
+import (
+	"github.com/rai-project/tensorrt/manifest"
+	"github.com/rai-project/tensorrt/native"
+)
+
+// CompiledVersion is the TensorRT version the agent and its builtin models
+// were built and validated against. FrameworkManifest reports the version
+// detected at runtime when it is available.
+const CompiledVersion = "7.0.0"
+
+var (
+	// LinkedVersions are the versions of the NVIDIA libraries found at
+	// runtime. It is empty when they could not be queried.
+	LinkedVersions native.Versions
+	detectErr      error
+)
+
+// frameworkVersion picks the TensorRT version to advertise: the detected one
+// when detection succeeded, the compiled-in one otherwise.
+func frameworkVersion(compiled string, detected native.Versions, err error) string {
+	if err != nil || detected.TensorRT == "" {
+		return compiled
+	}
+	return detected.TensorRT
+}
+
+// versionMismatch reports whether the detected TensorRT version differs from
+// the compiled-in one. Only the components the compiled-in version specifies
+// are compared, so "7.0" matches "7.0.0".
+func versionMismatch(compiled string, detected native.Versions) bool {
+	if detected.TensorRT == "" {
+		return false
+	}
+	ok, err := manifest.ConstraintMatches("~"+compiled, detected.TensorRT)
+	return err != nil || !ok
+}
+
+func logVersions() {
+	if detectErr != nil {
+		log.WithError(detectErr).WithField("tensorrt_version", CompiledVersion).
+			Warn("unable to detect the linked TensorRT version, using the compiled-in version")
+		return
+	}
+	entry := log.WithField("tensorrt_version", LinkedVersions.TensorRT).
+		WithField("cuda_version", LinkedVersions.CUDA).
+		WithField("cuda_driver_version", LinkedVersions.CUDADriver).
+		WithField("cudnn_version", LinkedVersions.CUDNN)
+	if versionMismatch(CompiledVersion, LinkedVersions) {
+		entry.WithField("compiled_tensorrt_version", CompiledVersion).
+			Warn("the linked TensorRT version differs from the version the agent was compiled against")
+		return
+	}
+	entry.Info("detected the linked NVIDIA libraries")
+}
+
+func init() {
+	LinkedVersions, detectErr = native.Detect()
+	FrameworkManifest.Version = frameworkVersion(CompiledVersion, LinkedVersions, detectErr)
+}
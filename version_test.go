@@ -0,0 +1,24 @@
+package tensorrt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rai-project/tensorrt/native"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrameworkVersion(t *testing.T) {
+	detected := native.Versions{TensorRT: "7.1.3", CUDA: "11.0", CUDNN: "8.0.4"}
+	assert.Equal(t, "7.1.3", frameworkVersion("7.0.0", detected, nil))
+	assert.Equal(t, "7.0.0", frameworkVersion("7.0.0", native.Versions{}, native.ErrUnavailable))
+	assert.Equal(t, "7.0.0", frameworkVersion("7.0.0", detected, errors.New("cuda error")))
+}
+
+func TestVersionMismatch(t *testing.T) {
+	assert.False(t, versionMismatch("7.0.0", native.Versions{TensorRT: "7.0.0"}))
+	assert.False(t, versionMismatch("7.0", native.Versions{TensorRT: "7.0.0"}))
+	assert.True(t, versionMismatch("7.0.0", native.Versions{TensorRT: "7.1.3"}))
+	assert.True(t, versionMismatch("7.0.0", native.Versions{TensorRT: "6.0.1"}))
+	assert.False(t, versionMismatch("7.0.0", native.Versions{}))
+}
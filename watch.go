@@ -0,0 +1,488 @@
+package tensorrt
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework"
+	"github.com/rai-project/dlframework/framework"
+	"github.com/rai-project/tensorrt/manifest"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ModelEventKind ...
+type ModelEventKind int
+
+const (
+	// ModelAdded is emitted for a model that was not registered before
+	ModelAdded ModelEventKind = iota
+	// ModelUpdated is emitted when the manifest of a registered model changed
+	ModelUpdated
+	// ModelRemoved is emitted when the manifest of a registered model is gone
+	ModelRemoved
+)
+
+func (k ModelEventKind) String() string {
+	switch k {
+	case ModelAdded:
+		return "added"
+	case ModelUpdated:
+		return "updated"
+	case ModelRemoved:
+		return "removed"
+	}
+	return "unknown"
+}
+
+// ModelEvent describes a change to the registered models found while
+// reloading the models directories.
+type ModelEvent struct {
+	Kind ModelEventKind
+	// Model is the model name and version, name:version
+	Model string
+	// File is the manifest the model is now read from, or was read from for
+	// removed models
+	File string
+	Data []byte
+}
+
+// ModelKey identifies a model by name and version, ignoring case.
+func ModelKey(name, version string) string {
+	return strings.ToLower(name + ":" + version)
+}
+
+// Key ...
+func (e ModelEvent) Key() string {
+	return strings.ToLower(e.Model)
+}
+
+// ReloadHandler is called with the model events of every reload.
+type ReloadHandler func([]ModelEvent)
+
+var reloadHandlers struct {
+	sync.Mutex
+	handlers []ReloadHandler
+}
+
+// AddReloadHandler registers h to be called after the models directories are
+// reloaded. The predictors use it to drain the models that went away.
+func AddReloadHandler(h ReloadHandler) {
+	reloadHandlers.Lock()
+	defer reloadHandlers.Unlock()
+	reloadHandlers.handlers = append(reloadHandlers.handlers, h)
+}
+
+func notifyReloadHandlers(events []ModelEvent) {
+	reloadHandlers.Lock()
+	handlers := append([]ReloadHandler(nil), reloadHandlers.handlers...)
+	reloadHandlers.Unlock()
+	for _, h := range handlers {
+		h(events)
+	}
+}
+
+var removedModels struct {
+	sync.RWMutex
+	keys map[string]bool
+}
+
+// IsModelRemoved reports whether the manifest of the model was removed from
+// the models directories since it was registered.
+func IsModelRemoved(name, version string) bool {
+	removedModels.RLock()
+	defer removedModels.RUnlock()
+	return removedModels.keys[ModelKey(name, version)]
+}
+
+func setModelRemoved(key string, removed bool) {
+	removedModels.Lock()
+	defer removedModels.Unlock()
+	if removedModels.keys == nil {
+		removedModels.keys = map[string]bool{}
+	}
+	if removed {
+		removedModels.keys[key] = true
+	} else {
+		delete(removedModels.keys, key)
+	}
+}
+
+// ModelRegistry is where the agent publishes its models. Reloads re-register
+// the models whose manifest was edited and unregister the removed ones.
+type ModelRegistry interface {
+	Register(model dlframework.ModelManifest) error
+	Unregister(model dlframework.ModelManifest) error
+}
+
+// dlframeworkRegistry is the dlframework model registry, which the agent
+// advertises the models it serves from.
+type dlframeworkRegistry struct{}
+
+func (dlframeworkRegistry) Register(model dlframework.ModelManifest) error {
+	return model.Register()
+}
+
+func (dlframeworkRegistry) Unregister(model dlframework.ModelManifest) error {
+	return model.Unregister()
+}
+
+// modelRegistry is replaced by the tests.
+var modelRegistry ModelRegistry = dlframeworkRegistry{}
+
+// reregisterModel replaces the registered manifest of a model with model.
+func reregisterModel(key string, model *dlframework.ModelManifest) error {
+	if old, err := FindModel(key); err == nil && old != nil {
+		if err := modelRegistry.Unregister(*old); err != nil {
+			return errors.Wrapf(err, "cannot unregister the previous manifest of %s", key)
+		}
+	}
+	return modelRegistry.Register(*model)
+}
+
+// unregisterModel removes a model from the registry.
+func unregisterModel(key string) error {
+	model, err := FindModel(key)
+	if err != nil || model == nil {
+		return nil
+	}
+	return modelRegistry.Unregister(*model)
+}
+
+// reloadedModels are the models added or edited in the models directories
+// while the agent runs. They take precedence over the manifests read at
+// startup in FindModel.
+var reloadedModels struct {
+	sync.RWMutex
+	models map[string]dlframework.ModelManifest
+}
+
+// ReloadedModel returns the manifest of a model added or edited in the
+// models directories since the agent started.
+func ReloadedModel(name, version string) (dlframework.ModelManifest, bool) {
+	reloadedModels.RLock()
+	defer reloadedModels.RUnlock()
+	model, ok := reloadedModels.models[ModelKey(name, version)]
+	return model, ok
+}
+
+func setReloadedModel(key string, model *dlframework.ModelManifest) {
+	reloadedModels.Lock()
+	defer reloadedModels.Unlock()
+	if reloadedModels.models == nil {
+		reloadedModels.models = map[string]dlframework.ModelManifest{}
+	}
+	if model != nil {
+		reloadedModels.models[key] = *model
+	} else {
+		delete(reloadedModels.models, key)
+	}
+}
+
+// FindModel returns the current manifest of a registered model, given as
+// name:version. Models removed from the models directories are not found,
+// and edited models are returned as they were last read.
+func FindModel(name string) (*dlframework.ModelManifest, error) {
+	key := strings.ToLower(name)
+	removedModels.RLock()
+	removed := removedModels.keys[key]
+	removedModels.RUnlock()
+	if removed {
+		return nil, errors.Errorf("the manifest of model %s was removed", name)
+	}
+	reloadedModels.RLock()
+	model, ok := reloadedModels.models[key]
+	reloadedModels.RUnlock()
+	if ok {
+		return &model, nil
+	}
+	return FrameworkManifest.FindModel(name)
+}
+
+// parseModelManifest reads a manifest the way framework.Register does.
+func parseModelManifest(data []byte) (*dlframework.ModelManifest, error) {
+	model := new(dlframework.ModelManifest)
+	if err := yaml.Unmarshal(data, model); err != nil {
+		return nil, errors.Wrap(err, "cannot parse the model manifest")
+	}
+	return model, nil
+}
+
+// diffModels compares two sets of registered manifests and returns the
+// events that turn prev into next. Events are ordered as next, followed by
+// the removed models in the order of prev.
+func diffModels(prev, next []manifestFile) []ModelEvent {
+	type entry struct {
+		name string
+		file manifestFile
+	}
+	index := func(files []manifestFile) ([]string, map[string]entry) {
+		keys := make([]string, 0, len(files))
+		entries := make(map[string]entry, len(files))
+		for _, file := range files {
+			m, err := manifest.Parse(file.Data)
+			if err != nil {
+				continue
+			}
+			key := strings.ToLower(m.CanonicalName())
+			if _, ok := entries[key]; ok {
+				continue
+			}
+			keys = append(keys, key)
+			entries[key] = entry{name: m.CanonicalName(), file: file}
+		}
+		return keys, entries
+	}
+
+	prevKeys, prevEntries := index(prev)
+	nextKeys, nextEntries := index(next)
+
+	var events []ModelEvent
+	for _, key := range nextKeys {
+		e := nextEntries[key]
+		old, ok := prevEntries[key]
+		switch {
+		case !ok:
+			events = append(events, ModelEvent{Kind: ModelAdded, Model: e.name, File: e.file.Name, Data: e.file.Data})
+		case old.file.Name != e.file.Name || !bytes.Equal(old.file.Data, e.file.Data):
+			events = append(events, ModelEvent{Kind: ModelUpdated, Model: e.name, File: e.file.Name, Data: e.file.Data})
+		}
+	}
+	for _, key := range prevKeys {
+		if _, ok := nextEntries[key]; ok {
+			continue
+		}
+		e := prevEntries[key]
+		events = append(events, ModelEvent{Kind: ModelRemoved, Model: e.name, File: e.file.Name, Data: e.file.Data})
+	}
+	return events
+}
+
+// ModelWatcher watches the models directories and reloads the registered
+// models when their manifests change.
+type ModelWatcher struct {
+	dirs     []string
+	debounce time.Duration
+	collect  func() ([]manifestFile, error)
+	handler  ReloadHandler
+
+	watcher *fsnotify.Watcher
+	mu      sync.Mutex
+	current []manifestFile
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// DefaultWatchDebounce is how long the watcher waits for the file events of
+// an edit to settle before reloading.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// newModelWatcher creates a watcher over dirs. current is the set of
+// manifests registered so far, collect computes the new set on reload and
+// handler is called with the differences.
+func newModelWatcher(dirs []string, current []manifestFile, collect func() ([]manifestFile, error), handler ReloadHandler) (*ModelWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create the models directories watcher")
+	}
+	w := &ModelWatcher{
+		dirs:     dirs,
+		debounce: DefaultWatchDebounce,
+		collect:  collect,
+		handler:  handler,
+		watcher:  watcher,
+		current:  current,
+		done:     make(chan struct{}),
+	}
+	for _, dir := range dirs {
+		if err := w.addDir(dir); err != nil {
+			log.WithError(err).Error("not watching the models directory " + dir)
+		}
+	}
+	return w, nil
+}
+
+// addDir watches dir and its subdirectories, fsnotify is not recursive.
+func (w *ModelWatcher) addDir(dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		return w.watcher.Add(path)
+	})
+}
+
+// Start processes the file events in the background until Close is called.
+func (w *ModelWatcher) Start() {
+	w.wg.Add(1)
+	go w.run()
+}
+
+func (w *ModelWatcher) run() {
+	defer w.wg.Done()
+
+	var timer *time.Timer
+	var fire <-chan time.Time
+	for {
+		select {
+		case <-w.done:
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case ev, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if ev.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					if err := w.addDir(ev.Name); err != nil {
+						log.WithError(err).Error("not watching the models directory " + ev.Name)
+					}
+				}
+			}
+			if ev.Op == fsnotify.Chmod {
+				continue
+			}
+			if timer == nil {
+				timer = time.NewTimer(w.debounce)
+			} else {
+				timer.Reset(w.debounce)
+			}
+			fire = timer.C
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.WithError(err).Error("models directories watcher failed")
+		case <-fire:
+			fire = nil
+			w.Reload()
+		}
+	}
+}
+
+// Reload recomputes the registered manifests and hands the differences to
+// the handler. It returns the events of the reload.
+func (w *ModelWatcher) Reload() []ModelEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	next, err := w.collect()
+	if err != nil {
+		log.WithError(err).Error("failed to reload the models directories")
+		return nil
+	}
+	events := diffModels(w.current, next)
+	w.current = next
+	if len(events) != 0 && w.handler != nil {
+		w.handler(events)
+	}
+	return events
+}
+
+// Close stops watching the models directories.
+func (w *ModelWatcher) Close() error {
+	select {
+	case <-w.done:
+		return nil
+	default:
+	}
+	close(w.done)
+	err := w.watcher.Close()
+	w.wg.Wait()
+	return err
+}
+
+// applyModelEvents registers the added models, re-registers the updated
+// ones, unregisters the removed ones and notifies the reload handlers.
+func applyModelEvents(events []ModelEvent) {
+	var added []manifestFile
+	for _, ev := range events {
+		log.WithField("model", ev.Model).WithField("file", ev.File).Info("model " + ev.Kind.String())
+		switch ev.Kind {
+		case ModelAdded, ModelUpdated:
+			model, err := parseModelManifest(ev.Data)
+			if err != nil {
+				log.WithError(err).WithField("file", ev.File).Error("failed to register the reloaded model")
+				continue
+			}
+			if ev.Kind == ModelUpdated {
+				if err := reregisterModel(ev.Key(), model); err != nil {
+					log.WithError(err).WithField("model", ev.Model).Error("failed to re-register the updated model")
+				}
+			} else {
+				added = append(added, manifestFile{Name: ev.File, Data: ev.Data})
+			}
+			setModelRemoved(ev.Key(), false)
+			setReloadedModel(ev.Key(), model)
+		case ModelRemoved:
+			if err := unregisterModel(ev.Key()); err != nil {
+				log.WithError(err).WithField("model", ev.Model).Error("failed to unregister the removed model")
+			}
+			setModelRemoved(ev.Key(), true)
+			setReloadedModel(ev.Key(), nil)
+		}
+	}
+	if len(added) != 0 {
+		if err := framework.Register(FrameworkManifest, newAssetFS(added)); err != nil {
+			log.WithError(err).Error("failed to register the reloaded models")
+		}
+	}
+	notifyReloadHandlers(events)
+}
+
+// reloadManifests is the collect function of the models directories watcher.
+func reloadManifests() ([]manifestFile, error) {
+	files, err := collectManifests()
+	if err != nil {
+		return nil, err
+	}
+	return filterModels(files), nil
+}
+
+var (
+	modelWatcher   *ModelWatcher
+	modelWatcherMu sync.Mutex
+)
+
+// watchModelsDirs starts watching the configured models directories, current
+// being the manifests registered at startup.
+func watchModelsDirs(current []manifestFile) error {
+	if !Config.WatchModelsDirs || len(Config.ModelsDirs) == 0 {
+		return nil
+	}
+	modelWatcherMu.Lock()
+	defer modelWatcherMu.Unlock()
+	if modelWatcher != nil {
+		return nil
+	}
+	w, err := newModelWatcher(Config.ModelsDirs, current, reloadManifests, applyModelEvents)
+	if err != nil {
+		return err
+	}
+	w.Start()
+	modelWatcher = w
+	log.WithField("dirs", strings.Join(Config.ModelsDirs, ",")).Info("watching the models directories")
+	return nil
+}
+
+// StopWatching stops reloading the models directories.
+func StopWatching() error {
+	modelWatcherMu.Lock()
+	defer modelWatcherMu.Unlock()
+	if modelWatcher == nil {
+		return nil
+	}
+	err := modelWatcher.Close()
+	modelWatcher = nil
+	return err
+}
@@ -0,0 +1,163 @@
+package tensorrt
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rai-project/dlframework"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffModels(t *testing.T) {
+	resnet := testManifest("ResNet50_v1", "7.0.0")
+	vgg := testManifest("VGG16", "7.0.0")
+	private := testManifest("Private", "7.0.0")
+	updated := testManifest("vgg16", ">=7.0.0")
+
+	events := diffModels([]manifestFile{resnet, vgg}, []manifestFile{updated, private})
+	assert.Len(t, events, 3)
+
+	assert.Equal(t, ModelUpdated, events[0].Kind)
+	assert.Equal(t, "vgg16:1.0", events[0].Model)
+	assert.Equal(t, updated.Data, events[0].Data)
+	assert.Equal(t, ModelAdded, events[1].Kind)
+	assert.Equal(t, "Private:1.0", events[1].Model)
+	assert.Equal(t, ModelRemoved, events[2].Kind)
+	assert.Equal(t, "ResNet50_v1:1.0", events[2].Model)
+	assert.Equal(t, "resnet50_v1:1.0", events[2].Key())
+
+	assert.Empty(t, diffModels([]manifestFile{resnet, vgg}, []manifestFile{resnet, vgg}))
+}
+
+func TestModelWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "models-watch")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	collect := func() ([]manifestFile, error) {
+		return readModelsDir(dir)
+	}
+	reloads := make(chan []ModelEvent, 10)
+	w, err := newModelWatcher([]string{dir}, nil, collect, func(events []ModelEvent) {
+		reloads <- events
+	})
+	assert.NoError(t, err)
+	w.debounce = 20 * time.Millisecond
+	w.Start()
+	defer w.Close()
+
+	next := func() []ModelEvent {
+		select {
+		case events := <-reloads:
+			return events
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the models to reload")
+		}
+		return nil
+	}
+
+	path := writeManifest(t, dir, "private.yml", testManifest("Private", "7.0.0"))
+	events := next()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, ModelAdded, events[0].Kind)
+		assert.Equal(t, "Private:1.0", events[0].Model)
+		assert.Equal(t, path, events[0].File)
+	}
+
+	// directories created after the watcher started are watched as well
+	nested := writeManifest(t, dir, "nested/other.yml", testManifest("Other", "7.0.0"))
+	events = next()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, ModelAdded, events[0].Kind)
+		assert.Equal(t, nested, events[0].File)
+	}
+
+	writeManifest(t, dir, "private.yml", testManifest("Private", ">=7.0.0"))
+	events = next()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, ModelUpdated, events[0].Kind)
+		assert.Equal(t, "Private:1.0", events[0].Model)
+	}
+
+	assert.NoError(t, os.Remove(path))
+	events = next()
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, ModelRemoved, events[0].Kind)
+		assert.Equal(t, "Private:1.0", events[0].Model)
+	}
+
+	assert.NoError(t, w.Close())
+	assert.NoError(t, w.Close())
+}
+
+type recordingRegistry struct {
+	calls []string
+}
+
+func (r *recordingRegistry) Register(model dlframework.ModelManifest) error {
+	r.calls = append(r.calls, "register "+model.GetFramework().Version)
+	return nil
+}
+
+func (r *recordingRegistry) Unregister(model dlframework.ModelManifest) error {
+	r.calls = append(r.calls, "unregister "+model.GetFramework().Version)
+	return nil
+}
+
+func TestApplyModelEvents(t *testing.T) {
+	registry := &recordingRegistry{}
+	defer func(r ModelRegistry) { modelRegistry = r }(modelRegistry)
+	modelRegistry = registry
+
+	reloadHandlers.Lock()
+	handlers := reloadHandlers.handlers
+	reloadHandlers.Unlock()
+	defer func() {
+		reloadHandlers.Lock()
+		reloadHandlers.handlers = handlers
+		reloadHandlers.Unlock()
+		setModelRemoved("private:1.0", false)
+		setReloadedModel("private:1.0", nil)
+	}()
+
+	var notified []ModelEvent
+	AddReloadHandler(func(events []ModelEvent) {
+		notified = append(notified, events...)
+	})
+
+	added := ModelEvent{Kind: ModelAdded, Model: "Private:1.0", File: filepath.Join("models", "private.yml")}
+	added.Data = testManifest("Private", "7.0.0").Data
+	applyModelEvents([]ModelEvent{added})
+	model, ok := ReloadedModel("Private", "1.0")
+	assert.True(t, ok)
+	assert.Equal(t, "7.0.0", model.GetFramework().Version)
+
+	updated := added
+	updated.Kind = ModelUpdated
+	updated.Data = testManifest("Private", ">=7.0.0").Data
+	applyModelEvents([]ModelEvent{updated})
+	found, err := FindModel("Private:1.0")
+	assert.NoError(t, err)
+	if assert.NotNil(t, found) {
+		assert.Equal(t, "Private", found.GetName())
+	}
+	model, ok = ReloadedModel("private", "1.0")
+	assert.True(t, ok)
+	assert.Equal(t, ">=7.0.0", model.GetFramework().Version)
+
+	removed := ModelEvent{Kind: ModelRemoved, Model: "Private:1.0", File: added.File}
+	applyModelEvents([]ModelEvent{removed})
+	assert.True(t, IsModelRemoved("private", "1.0"))
+	_, ok = ReloadedModel("Private", "1.0")
+	assert.False(t, ok)
+	_, err = FindModel("Private:1.0")
+	assert.Error(t, err)
+	assert.Equal(t, []ModelEvent{added, updated, removed}, notified)
+	assert.Equal(t, []string{"unregister 7.0.0", "register >=7.0.0", "unregister >=7.0.0"}, registry.calls)
+
+	applyModelEvents([]ModelEvent{added})
+	assert.False(t, IsModelRemoved("Private", "1.0"))
+}